@@ -0,0 +1,300 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"multinic-agent/internal/application/usecases"
+	"multinic-agent/internal/domain/entities"
+)
+
+const commandsUsage = `usage: multinic-agent [--dry-run] [command]
+
+Without a command the agent runs the polling loop.
+
+commands:
+  reconcile --once     run a single reconcile cycle and exit
+  plan [--json]        print the changes the next cycle would make without applying them
+  status [--json]      show managed interfaces with their DB row, config file and live link state
+  render --mac <mac>   print the config file the agent would write for an interface
+  doctor [--json]      run node pre-flight checks (host mounts, nsenter, network tooling, database)
+  names <command>      manage MAC-to-name allocations`
+
+// 운영자 명령의 종료 코드
+const (
+	exitOK       = 0
+	exitFailure  = 1  // 명령 실패 (DB 조회 실패, doctor 점검 실패 등)
+	exitDegraded = 2  // 사이클은 끝났지만 일부 인터페이스 처리 실패 또는 삭제 차단
+	exitUsage    = 64 // 잘못된 명령 또는 인자
+)
+
+// runCommand는 운영자 명령을 실행하고 프로세스 종료 코드를 반환합니다
+func runCommand(ctx context.Context, app *Application, args []string, out, errOut io.Writer) int {
+	var (
+		code int
+		err  error
+	)
+
+	switch args[0] {
+	case "reconcile":
+		code, err = runReconcileCommand(ctx, app, args[1:], out)
+	case "plan":
+		code, err = runPlanCommand(ctx, app, args[1:], out)
+	case "status":
+		code, err = runStatusCommand(ctx, app, args[1:], out)
+	case "render":
+		code, err = runRenderCommand(ctx, app, args[1:], out, errOut)
+	case "doctor":
+		code, err = runDoctorCommand(ctx, app, args[1:], out)
+	case "names":
+		code = exitOK
+		if err = runNamesCommand(ctx, app.container.GetNamingService(), args[1:], out); err != nil {
+			code = exitFailure
+		}
+	default:
+		code, err = exitUsage, fmt.Errorf("unknown command %q\n%s", args[0], commandsUsage)
+	}
+
+	if err != nil {
+		fmt.Fprintln(errOut, err)
+	}
+	return code
+}
+
+// newCommandFlagSet은 명령별 플래그 파서를 생성합니다 (파싱 에러는 호출자가 출력)
+func newCommandFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+// runReconcileCommand는 설정/삭제 사이클을 한 번 실행하고 결과를 출력합니다
+// --dry-run과 함께 실행하면 적용 대신 계획을 출력합니다
+func runReconcileCommand(ctx context.Context, app *Application, args []string, out io.Writer) (int, error) {
+	fs := newCommandFlagSet("reconcile")
+	once := fs.Bool("once", false, "run a single cycle and exit")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage, fmt.Errorf("%v\n%s", err, commandsUsage)
+	}
+	if !*once {
+		return exitUsage, fmt.Errorf("reconcile requires --once (run without a command for the polling loop)")
+	}
+
+	dryRun := app.container.GetConfig().Agent.DryRun
+	cycle, err := app.runCycle(ctx, dryRun)
+	if err != nil {
+		return exitFailure, err
+	}
+	nodeName, configOutput, deleteOutput := cycle.Plan.NodeName, cycle.Configure, cycle.Delete
+
+	if dryRun {
+		if err := printPlan(out, cycle.Plan); err != nil {
+			return exitFailure, err
+		}
+	} else {
+		fmt.Fprintf(out, "node %s: configured %d, failed %d of %d; deleted %d, quarantined %d, restored %d, released links %d\n",
+			nodeName, configOutput.ProcessedCount, configOutput.FailedCount, configOutput.TotalCount,
+			deleteOutput.TotalDeleted, len(deleteOutput.QuarantinedInterfaces), len(deleteOutput.RestoredInterfaces), len(deleteOutput.ReleasedLinks))
+	}
+	for _, delErr := range deleteOutput.Errors {
+		fmt.Fprintf(out, "delete error: %v\n", delErr)
+	}
+	if len(deleteOutput.BlockedInterfaces) > 0 {
+		fmt.Fprintf(out, "orphan deletion blocked: %s (%s)\n", strings.Join(deleteOutput.BlockedInterfaces, ", "), deleteOutput.BlockReason)
+	}
+
+	if configOutput.FailedCount > 0 || len(deleteOutput.Errors) > 0 || len(deleteOutput.BlockedInterfaces) > 0 {
+		return exitDegraded, nil
+	}
+	return exitOK, nil
+}
+
+// runPlanCommand는 노드를 변경하지 않고 다음 사이클의 변경 계획을 출력합니다
+func runPlanCommand(ctx context.Context, app *Application, args []string, out io.Writer) (int, error) {
+	fs := newCommandFlagSet("plan")
+	asJSON := fs.Bool("json", false, "print the plan as JSON")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage, fmt.Errorf("%v\n%s", err, commandsUsage)
+	}
+
+	cycle, err := app.runCycle(ctx, true)
+	if err != nil {
+		return exitFailure, err
+	}
+	plan := cycle.Plan
+
+	if *asJSON {
+		return exitOK, writeJSON(out, plan)
+	}
+	if err := printPlan(out, plan); err != nil {
+		return exitFailure, err
+	}
+	if cycle.Configure.FailedCount > 0 {
+		fmt.Fprintf(out, "%d interface(s) cannot be planned; see the log for details\n", cycle.Configure.FailedCount)
+		return exitDegraded, nil
+	}
+	return exitOK, nil
+}
+
+// printPlan은 계획을 표 형식으로 출력합니다 (필드 차이는 항목 아래에 표시)
+func printPlan(out io.Writer, plan entities.ReconcilePlan) error {
+	if len(plan.Changes) == 0 {
+		fmt.Fprintf(out, "node %s: no changes\n", plan.NodeName)
+		return nil
+	}
+
+	fmt.Fprintf(out, "node %s: %d to create, %d to update, %d to delete\n", plan.NodeName,
+		plan.Count(entities.PlanActionCreate), plan.Count(entities.PlanActionUpdate), plan.Count(entities.PlanActionDelete))
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ACTION\tINTERFACE\tMAC\tREASON\tCONFIG")
+	for _, change := range plan.Changes {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", change.Action, change.InterfaceName, change.MacAddress, change.Reason, change.ConfigPath)
+		for _, diff := range change.Diffs {
+			fmt.Fprintf(w, "\t  %s: %q -> %q\t\t\t\n", diff.Field, diff.Current, diff.Desired)
+		}
+	}
+	return w.Flush()
+}
+
+// runStatusCommand는 관리 대상 인터페이스의 DB 행, 설정 파일, 커널 링크 상태를 출력합니다
+func runStatusCommand(ctx context.Context, app *Application, args []string, out io.Writer) (int, error) {
+	fs := newCommandFlagSet("status")
+	asJSON := fs.Bool("json", false, "print the status as JSON")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage, fmt.Errorf("%v\n%s", err, commandsUsage)
+	}
+
+	nodeName, err := app.nodeName()
+	if err != nil {
+		return exitFailure, err
+	}
+	output, err := app.container.GetNetworkStatusUseCase().Execute(ctx, usecases.NetworkStatusInput{NodeName: nodeName})
+	if err != nil {
+		return exitFailure, err
+	}
+
+	views := make([]usecases.InterfaceStatusView, 0, len(output.Interfaces))
+	for _, status := range output.Interfaces {
+		views = append(views, status.View())
+	}
+
+	if *asJSON {
+		return exitOK, writeJSON(out, views)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tINTERFACE\tMAC\tDB\tFILE\tLINK\tDRIFT")
+	for _, view := range views {
+		link := "-"
+		if view.LinkName != "" {
+			link = view.LinkName + " " + view.LinkState
+		}
+		drift := strings.Join(append(append([]string{}, view.FileDrifts...), view.LinkDrifts...), ",")
+		if view.Error != "" {
+			drift = "error: " + view.Error
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n", view.InterfaceID, orDash(view.InterfaceName), view.MacAddress,
+			view.DBStatus, orDash(view.FileState), link, orDash(drift))
+	}
+	return exitOK, w.Flush()
+}
+
+// runRenderCommand는 인터페이스에 대해 에이전트가 쓸 설정 파일 내용을 적용하지 않고 출력합니다
+// 파일 내용만 표준 출력으로 내보내고 경로는 에러 출력으로 보냅니다
+func runRenderCommand(ctx context.Context, app *Application, args []string, out, errOut io.Writer) (int, error) {
+	fs := newCommandFlagSet("render")
+	mac := fs.String("mac", "", "MAC address of the interface to render")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage, fmt.Errorf("%v\n%s", err, commandsUsage)
+	}
+	if *mac == "" {
+		return exitUsage, fmt.Errorf("render requires --mac")
+	}
+
+	nodeName, err := app.nodeName()
+	if err != nil {
+		return exitFailure, err
+	}
+	ifaces, err := app.container.GetRepository().GetAllNodeInterfaces(ctx, nodeName)
+	if err != nil {
+		return exitFailure, err
+	}
+
+	for _, iface := range ifaces {
+		if !strings.EqualFold(iface.MacAddress, *mac) {
+			continue
+		}
+		if err := iface.Validate(); err != nil {
+			return exitFailure, err
+		}
+		name, err := app.container.GetNamingService().PreviewNameForInterface(iface)
+		if err != nil {
+			return exitFailure, err
+		}
+		path, content, err := app.container.GetConfigRenderer().RenderConfig(iface, name)
+		if err != nil {
+			return exitFailure, err
+		}
+		fmt.Fprintf(errOut, "# %s\n", path)
+		_, err = out.Write(content)
+		return exitOK, err
+	}
+
+	return exitFailure, fmt.Errorf("no interface with MAC %s is assigned to node %s", *mac, nodeName)
+}
+
+// runDoctorCommand는 노드 사전 점검을 실행하고 통과하지 못한 항목의 조치 방법을 출력합니다
+func runDoctorCommand(ctx context.Context, app *Application, args []string, out io.Writer) (int, error) {
+	fs := newCommandFlagSet("doctor")
+	asJSON := fs.Bool("json", false, "print the report as JSON")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage, fmt.Errorf("%v\n%s", err, commandsUsage)
+	}
+
+	report := app.diagnose(ctx)
+	code := exitOK
+	if !report.Healthy() {
+		code = exitFailure
+	}
+
+	if *asJSON {
+		return code, writeJSON(out, report)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CHECK\tSTATUS\tMESSAGE")
+	for _, check := range report.Checks {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", check.Name, strings.ToUpper(string(check.Status)), check.Message)
+		if check.Remedy != "" {
+			fmt.Fprintf(w, "\t\t  -> %s\n", check.Remedy)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return exitFailure, err
+	}
+
+	fmt.Fprintf(out, "node %s: %d passed, %d warnings, %d failed, %d skipped\n", report.NodeName,
+		report.Count(entities.DiagnosticPass), report.Count(entities.DiagnosticWarn),
+		report.Count(entities.DiagnosticFail), report.Count(entities.DiagnosticSkip))
+	return code, nil
+}
+
+// writeJSON은 값을 들여쓴 JSON으로 출력합니다
+func writeJSON(out io.Writer, v interface{}) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// orDash는 빈 값을 표에서 "-"로 표시합니다
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
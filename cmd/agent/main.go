@@ -2,19 +2,24 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
-	"strings"
 	"syscall"
 	"time"
 
 	"multinic-agent/internal/application/polling"
 	"multinic-agent/internal/application/usecases"
+	"multinic-agent/internal/domain/entities"
 	"multinic-agent/internal/domain/interfaces"
+	"multinic-agent/internal/domain/services"
+	"multinic-agent/internal/infrastructure/admin"
 	"multinic-agent/internal/infrastructure/config"
 	"multinic-agent/internal/infrastructure/container"
+	"multinic-agent/internal/infrastructure/health"
 	"multinic-agent/internal/infrastructure/metrics"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -22,33 +27,41 @@ import (
 )
 
 func main() {
+	// 명령행 플래그 (환경 변수 설정보다 우선)
+	dryRun := flag.Bool("dry-run", false, "compute and report the reconcile plan without changing the node (overrides DRY_RUN)")
+	configFile := flag.String("config", os.Getenv("CONFIG_FILE"), "YAML config file; environment variables override its settings (default $CONFIG_FILE)")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), commandsUsage)
+		fmt.Fprintln(flag.CommandLine.Output(), "\nflags:")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
 	// 로거 초기화
 	logger := logrus.New()
 	logger.SetFormatter(&logrus.JSONFormatter{})
 
-	// LOG_LEVEL 환경 변수 설정
-	logLevelStr := os.Getenv("LOG_LEVEL")
-	if logLevelStr != "" {
-		logLevel, err := logrus.ParseLevel(logLevelStr)
-		if err != nil {
-			logger.WithError(err).Warnf("Unknown LOG_LEVEL value: %s. Using default Info level.", logLevelStr)
-			logger.SetLevel(logrus.InfoLevel) // Fallback to Info
-		} else {
-			logger.SetLevel(logLevel)
-		}
-	} else {
-		logger.SetLevel(logrus.InfoLevel) // Default to Info if not set
-	}
+	logger.SetLevel(logrus.InfoLevel)
 
-	// 설정 로드
-	configLoader := config.NewEnvironmentConfigLoader()
+	// 설정 로드 (설정 파일이 지정되면 파일 + 환경 변수, 아니면 환경 변수만)
+	var configLoader config.ConfigLoader = config.NewEnvironmentConfigLoader()
+	if *configFile != "" {
+		configLoader = config.NewFileConfigLoader(*configFile)
+	}
+	configLoader = flagOverrideLoader{ConfigLoader: configLoader, dryRun: *dryRun}
 	cfg, err := configLoader.Load()
 	if err != nil {
 		logger.WithError(err).Fatal("Failed to load configuration")
 	}
+	setLogLevel(logger, cfg.Agent.LogLevel)
 
 	// 의존성 주입 컨테이너 생성
-	appContainer, err := container.NewContainer(cfg, logger)
+	// doctor 명령은 점검 대상인 DB 연결이나 OS 감지가 실패해도 실행되도록 진단용 컨테이너 사용
+	newContainer := container.NewContainer
+	if args := flag.Args(); len(args) > 0 && args[0] == "doctor" {
+		newContainer = container.NewDiagnosticContainer
+	}
+	appContainer, err := newContainer(cfg, logger)
 	if err != nil {
 		logger.WithError(err).Fatal("Failed to create dependency injection container")
 	}
@@ -58,21 +71,51 @@ func main() {
 		}
 	}()
 
-	// 애플리케이션 시작
 	app := NewApplication(appContainer, logger)
+
+	// 설정 파일 변경 감지 (재시작 없이 적용 가능한 설정만 반영)
+	if *configFile != "" && cfg.Agent.ConfigReloadInterval > 0 {
+		app.configWatcher = config.NewWatcher(configLoader, *configFile, cfg.Agent.ConfigReloadInterval, logger)
+	}
+
+	// 운영자 명령: 한 번 실행하고 종료 코드와 함께 종료
+	if args := flag.Args(); len(args) > 0 {
+		code := runCommand(context.Background(), app, args, os.Stdout, os.Stderr)
+		appContainer.Close()
+		os.Exit(code)
+	}
+
+	// 애플리케이션 시작
 	if err := app.Run(); err != nil {
+		if errors.Is(err, errNodeIdentityChanged) {
+			// 정상 종료 코드로 끝내 kubelet이 크래시로 집계하지 않고 컨테이너를 다시 시작하도록 함
+			logger.WithError(err).Info("Exiting to restart with the new node identity")
+			return
+		}
 		logger.WithError(err).Fatal("Failed to run application")
 	}
 }
 
+// errNodeIdentityChanged는 시작 후 다른 노드 이름 후보에 인터페이스 행이 생겨 새 식별자로 다시 시작해야 함을 나타냅니다.
+// 에러가 아닌 정상 종료(종료 코드 0)로 처리되어 크래시로 집계되지 않습니다
+var errNodeIdentityChanged = errors.New("node identity changed after startup - restarting to use it")
+
 // Application은 메인 애플리케이션 구조체입니다
 type Application struct {
 	container        *container.Container
 	logger           *logrus.Logger
-	configureUseCase *usecases.ConfigureNetworkUseCase
-	deleteUseCase    *usecases.DeleteNetworkUseCase
+	reconcileUseCase *usecases.ReconcileNetworkUseCase
 	healthServer     *http.Server
 	osType           interfaces.OSType
+	poller           *polling.PollingController
+	configWatcher    *config.Watcher  // 설정 파일 미사용 또는 재로드 비활성화 시 nil
+	unpublished      *publishSnapshot // 게시에 실패해 변경이 없는 사이클에서 다시 게시할 마지막 결과 (없으면 nil)
+}
+
+// publishSnapshot은 노드 상태와 보조 네트워크 정의 게시에 사용한 사이클 결과입니다
+type publishSnapshot struct {
+	nodeName string
+	results  []entities.InterfaceResult
 }
 
 // NewApplication은 새로운 Application을 생성합니다
@@ -80,8 +123,7 @@ func NewApplication(container *container.Container, logger *logrus.Logger) *Appl
 	return &Application{
 		container:        container,
 		logger:           logger,
-		configureUseCase: container.GetConfigureNetworkUseCase(),
-		deleteUseCase:    container.GetDeleteNetworkUseCase(),
+		reconcileUseCase: container.GetReconcileNetworkUseCase(),
 	}
 }
 
@@ -98,15 +140,14 @@ func (a *Application) Run() error {
 	a.osType = osType
 	a.logger.WithField("os_type", osType).Info("Operating system detected")
 
+	if cfg.Agent.DryRun {
+		a.logger.Warn("Dry-run mode enabled - changes are planned and reported but not applied")
+	}
+
 	// 에이전트 정보 메트릭 설정
 	hostname, _ := os.Hostname()
 	metrics.SetAgentInfo("0.5.0", string(osType), hostname)
 
-	// 헬스체크 서버 시작
-	if err := a.startHealthServer(cfg.Health.Port); err != nil {
-		return err
-	}
-
 	// 컨텍스트 및 시그널 핸들링 설정
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -114,29 +155,20 @@ func (a *Application) Run() error {
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
-	// 폴링 전략 설정
-	var strategy polling.Strategy
-	if cfg.Agent.Backoff.Enabled {
-		// 지수 백오프 전략 사용
-		strategy = polling.NewExponentialBackoffStrategy(
-			cfg.Agent.PollInterval,        // 기본 간격
-			cfg.Agent.Backoff.MaxInterval, // 최대 간격
-			cfg.Agent.Backoff.Multiplier,  // 지수 계수
-			a.logger,
-		)
-		a.logger.WithFields(logrus.Fields{
-			"base_interval": cfg.Agent.PollInterval,
-			"max_interval":  cfg.Agent.Backoff.MaxInterval,
-			"multiplier":    cfg.Agent.Backoff.Multiplier,
-		}).Info("Exponential backoff polling enabled")
-	} else {
-		// 고정 간격 폴링 (기존 방식)
-		strategy = &fixedIntervalStrategy{interval: cfg.Agent.PollInterval}
-		a.logger.WithField("interval", cfg.Agent.PollInterval).Info("Fixed interval polling enabled")
+	// 폴링 컨트롤러 생성 (liveness는 폴링 루프 진행 시각으로 판단)
+	a.poller = polling.NewPollingController(a.newPollingStrategy(cfg.Agent), a.logger)
+	a.container.GetHealthService().SetLoopProgress(a.poller.LastProgress)
+
+	// 설정 파일 변경 감지 시작
+	if a.configWatcher != nil {
+		go a.configWatcher.Run(ctx, cfg, a.applyConfig)
+		a.logger.WithField("interval", cfg.Agent.ConfigReloadInterval).Info("Config file hot reload enabled")
 	}
 
-	// 폴링 컨트롤러 생성
-	pollingController := polling.NewPollingController(strategy, a.logger)
+	// 헬스체크 서버 시작 (관리 API가 폴링 컨트롤러를 사용하므로 생성 후 시작)
+	if err := a.startHealthServer(cfg.Health.Port); err != nil {
+		return err
+	}
 
 	a.logger.Info("MultiNIC agent started")
 
@@ -148,8 +180,15 @@ func (a *Application) Run() error {
 	}()
 
 	// 폴링 시작
-	return pollingController.Start(ctx, func(ctx context.Context) error {
+	var restartErr error
+	err = a.poller.Start(ctx, func(ctx context.Context) error {
 		err := a.processNetworkConfigurations(ctx)
+		if errors.Is(err, errNodeIdentityChanged) {
+			// 노드 이름으로 구성된 컴포넌트를 새 식별자로 다시 만들도록 정상 종료 (컨테이너 재시작)
+			restartErr = err
+			cancel()
+			return err
+		}
 		if err != nil {
 			a.logger.WithError(err).Error("Failed to process network configurations")
 			a.container.GetHealthService().UpdateDBHealth(false, err)
@@ -160,6 +199,74 @@ func (a *Application) Run() error {
 		metrics.SetDBConnectionStatus(true)
 		return nil
 	})
+	if restartErr != nil {
+		// 진행 중인 헬스체크/관리 API 요청을 마친 뒤 종료
+		if err := a.shutdown(); err != nil {
+			a.logger.WithError(err).Error("Failed to shutdown application")
+		}
+		return restartErr
+	}
+	return err
+}
+
+// newPollingStrategy는 설정에 맞는 폴링 전략을 생성합니다
+func (a *Application) newPollingStrategy(agent config.AgentConfig) polling.Strategy {
+	if agent.Backoff.Enabled {
+		// 지수 백오프 전략 사용
+		a.logger.WithFields(logrus.Fields{
+			"base_interval": agent.PollInterval,
+			"max_interval":  agent.Backoff.MaxInterval,
+			"multiplier":    agent.Backoff.Multiplier,
+		}).Info("Exponential backoff polling enabled")
+		return polling.NewExponentialBackoffStrategy(
+			agent.PollInterval,        // 기본 간격
+			agent.Backoff.MaxInterval, // 최대 간격
+			agent.Backoff.Multiplier,  // 지수 계수
+			a.logger,
+		)
+	}
+
+	// 고정 간격 폴링 (기존 방식)
+	a.logger.WithField("interval", agent.PollInterval).Info("Fixed interval polling enabled")
+	return &fixedIntervalStrategy{interval: agent.PollInterval}
+}
+
+// applyConfig는 다시 읽은 설정 중 재시작 없이 적용할 수 있는 항목을 반영합니다
+// (폴링 간격과 백오프, 로그 레벨, 동시 처리 수, probe 기준)
+func (a *Application) applyConfig(cfg *config.Config) {
+	setLogLevel(a.logger, cfg.Agent.LogLevel)
+	a.poller.SetStrategy(a.newPollingStrategy(cfg.Agent))
+	a.container.GetConfigureNetworkUseCase().SetMaxConcurrentTasks(cfg.Agent.MaxConcurrentTasks)
+	a.container.GetHealthService().SetProbeThresholds(health.ProbeThresholds{
+		LivenessStaleAfter: cfg.Health.LivenessStaleAfter,
+		ReadinessDBGrace:   cfg.Health.ReadinessDBGrace,
+	})
+}
+
+// setLogLevel은 로그 레벨을 설정합니다 (검증된 설정이므로 알 수 없는 값은 무시)
+func setLogLevel(logger *logrus.Logger, level string) {
+	if logLevel, err := logrus.ParseLevel(level); err == nil {
+		logger.SetLevel(logLevel)
+	}
+}
+
+// flagOverrideLoader는 설정 위에 명령행 플래그를 적용하는 로더입니다
+// 설정 파일을 다시 읽을 때도 플래그가 유지되도록 재로드에도 같은 로더를 사용합니다
+type flagOverrideLoader struct {
+	config.ConfigLoader
+	dryRun bool
+}
+
+// Load는 설정을 읽고 플래그를 적용합니다
+func (l flagOverrideLoader) Load() (*config.Config, error) {
+	cfg, err := l.ConfigLoader.Load()
+	if err != nil {
+		return nil, err
+	}
+	if l.dryRun {
+		cfg.Agent.DryRun = true
+	}
+	return cfg, nil
 }
 
 // startHealthServer는 헬스체크 서버를 시작합니다
@@ -169,7 +276,26 @@ func (a *Application) startHealthServer(port string) error {
 	// HTTP 핸들러 설정
 	mux := http.NewServeMux()
 	mux.Handle("/", healthService)
+	mux.Handle("/livez", healthService.LivenessHandler())
+	mux.Handle("/readyz", healthService.ReadinessHandler())
 	mux.Handle("/metrics", promhttp.Handler())
+	mux.Handle("/plan", healthService.PlanHandler())
+	mux.Handle("/doctor", healthService.DoctorHandler(a.diagnose))
+
+	// 관리 API (토큰이 설정된 경우에만)
+	if token := a.container.GetConfig().Health.AdminToken; token != "" {
+		adminAPI := admin.NewAPI(
+			token,
+			a.container.GetNetworkStatusUseCase(),
+			a.container.GetRepository(),
+			a.container.GetNamingService(),
+			fullReconcileController{PollingController: a.poller, tracker: a.container.GetChangeTracker()},
+			a.nodeName,
+			a.logger,
+		)
+		mux.Handle("/admin/", adminAPI.Handler())
+		a.logger.Info("Admin API enabled on the health check server")
+	}
 
 	a.healthServer = &http.Server{
 		Addr:    ":" + port,
@@ -186,57 +312,81 @@ func (a *Application) startHealthServer(port string) error {
 	return nil
 }
 
+// fullReconcileController는 즉시 실행 요청 시 변경 감지와 관계없이 전체 reconcile을 실행하는 관리 API용 컨트롤러입니다
+type fullReconcileController struct {
+	*polling.PollingController
+	tracker *services.ChangeTracker // 변경 감지 비활성화 시 nil
+}
+
+// Trigger는 다음 사이클이 전체 reconcile을 실행하도록 한 뒤 즉시 실행을 요청합니다
+func (c fullReconcileController) Trigger() bool {
+	if c.tracker != nil {
+		c.tracker.Invalidate()
+	}
+	return c.PollingController.Trigger()
+}
+
 // processNetworkConfigurations는 네트워크 설정을 처리합니다
 func (a *Application) processNetworkConfigurations(ctx context.Context) error {
 	startTime := time.Now()
+	dryRun := a.container.GetConfig().Agent.DryRun
 
-	// 호스트네임 가져오기
-	hostname, err := os.Hostname()
-	if err != nil {
+	// 시작 시 인터페이스 행이 있는 노드 이름 후보가 없었다면, 행이 생겼는지 다시 확인
+	if changed, err := a.container.RefreshNodeIdentity(ctx); err != nil {
 		return err
+	} else if changed {
+		return errNodeIdentityChanged
 	}
 
-	// .novalocal 또는 다른 도메인 접미사 제거
-	originalHostname := hostname
-	if idx := strings.Index(hostname, "."); idx != -1 {
-		hostname = hostname[:idx]
-	}
-
-	// 호스트명 변경사항 디버그 로그
-	if originalHostname != hostname {
-		a.logger.WithFields(logrus.Fields{
-			"original_hostname": originalHostname,
-			"cleaned_hostname":  hostname,
-		}).Debug("Hostname domain suffix removed")
-	}
-
-	// 1. 네트워크 설정 유스케이스 실행 (생성/수정)
-	configInput := usecases.ConfigureNetworkInput{
-		NodeName: hostname,
+	// 저장소의 변경 토큰이 그대로이면 전체 reconcile 생략 (변경 감지 활성화 시)
+	tracker := a.container.GetChangeTracker()
+	var decision services.ReconcileDecision
+	if tracker != nil {
+		hostname, err := a.nodeName()
+		if err != nil {
+			return err
+		}
+		if decision, err = tracker.Check(ctx, hostname); err != nil {
+			return err
+		}
+		if !decision.Full {
+			a.logger.Debug("Interface source unchanged - skipping reconcile")
+			a.retryPublish(ctx)
+			metrics.RecordReconcileCycle("skipped")
+			metrics.RecordPollingCycle(time.Since(startTime).Seconds())
+			return nil
+		}
+		a.logger.WithField("reason", decision.Reason).Debug("Running full reconcile")
 	}
+	metrics.RecordReconcileCycle("full")
 
-	configOutput, err := a.configureUseCase.Execute(ctx, configInput)
+	cycle, err := a.runCycle(ctx, dryRun)
 	if err != nil {
 		return err
 	}
+	hostname, configOutput, deleteOutput := cycle.Plan.NodeName, cycle.Configure, cycle.Delete
 
-	// 2. 네트워크 삭제 유스케이스 실행 (고아 인터페이스 정리)
-	deleteInput := usecases.DeleteNetworkInput{
-		NodeName: hostname,
-	}
-
-	deleteOutput, err := a.deleteUseCase.Execute(ctx, deleteInput)
-	if err != nil {
-		a.logger.WithError(err).Error("Failed to process orphaned interface deletion")
-		// 삭제 실패는 치명적이지 않으므로 빈 결과로 초기화
-		deleteOutput = &usecases.DeleteNetworkOutput{
-			TotalDeleted: 0,
-			Errors:       []error{err},
+	// 수렴한 사이클만 기록하고, 재시도나 확인 사이클이 남아 있으면 다음 사이클도 전체 reconcile
+	if tracker != nil {
+		if reconcileConverged(configOutput, deleteOutput) {
+			tracker.Record(decision.Token)
+		} else {
+			tracker.Invalidate()
 		}
 	}
 
 	// 헬스체크 통계 업데이트 (설정 관련)
 	healthService := a.container.GetHealthService()
+	healthService.UpdateOrphanDeletionSafety(deleteOutput.BlockedInterfaces, deleteOutput.DeferredInterfaces, deleteOutput.BlockReason)
+	healthService.UpdateInterfaceResults(configOutput.Results)
+
+	// dry-run이면 적용 결과 대신 계획을 보고
+	if dryRun {
+		a.reportPlan(cycle.Plan, configOutput, deleteOutput)
+		metrics.RecordPollingCycle(time.Since(startTime).Seconds())
+		return nil
+	}
+
 	for i := 0; i < configOutput.ProcessedCount; i++ {
 		healthService.IncrementProcessedVMs()
 	}
@@ -244,21 +394,26 @@ func (a *Application) processNetworkConfigurations(ctx context.Context) error {
 		healthService.IncrementFailedConfigs()
 	}
 
-	// 실제로 처리된 것이 있을 때만 로그 출력
-	if configOutput.ProcessedCount > 0 || configOutput.FailedCount > 0 || (deleteOutput != nil && deleteOutput.TotalDeleted > 0) {
-		deletedTotal := 0
-		deleteErrors := 0
-		if deleteOutput != nil {
-			deletedTotal = deleteOutput.TotalDeleted
-			deleteErrors = len(deleteOutput.Errors)
-		}
+	// 노드 상태와 보조 네트워크 정의 게시 (실패하면 변경이 없는 사이클에서 이 결과로 다시 게시)
+	a.unpublished = nil
+	if !a.publishResults(ctx, hostname, configOutput.Results, deleteOutput.DeletedInterfaces) {
+		a.unpublished = &publishSnapshot{nodeName: hostname, results: settledResults(configOutput.Results)}
+	}
 
+	// 실제로 처리된 것이 있을 때만 로그 출력
+	if configOutput.ProcessedCount > 0 || configOutput.FailedCount > 0 ||
+		deleteOutput.TotalDeleted > 0 || len(deleteOutput.QuarantinedInterfaces) > 0 || len(deleteOutput.RestoredInterfaces) > 0 ||
+		len(deleteOutput.ReleasedLinks) > 0 || len(deleteOutput.RepairPendingInterfaces) > 0 {
 		a.logger.WithFields(logrus.Fields{
-			"config_processed": configOutput.ProcessedCount,
-			"config_failed":    configOutput.FailedCount,
-			"config_total":     configOutput.TotalCount,
-			"deleted_total":    deletedTotal,
-			"delete_errors":    deleteErrors,
+			"config_processed":  configOutput.ProcessedCount,
+			"config_failed":     configOutput.FailedCount,
+			"config_total":      configOutput.TotalCount,
+			"deleted_total":     deleteOutput.TotalDeleted,
+			"quarantined_total": len(deleteOutput.QuarantinedInterfaces),
+			"restored_total":    len(deleteOutput.RestoredInterfaces),
+			"released_links":    len(deleteOutput.ReleasedLinks),
+			"repair_pending":    len(deleteOutput.RepairPendingInterfaces),
+			"delete_errors":     len(deleteOutput.Errors),
 		}).Info("Network processing completed")
 	}
 
@@ -275,6 +430,130 @@ func (a *Application) processNetworkConfigurations(ctx context.Context) error {
 	return nil
 }
 
+// publishResults는 사이클 결과를 노드 오브젝트와 보조 네트워크 정의로 게시하고, 모든 게시에 성공했는지 반환합니다
+func (a *Application) publishResults(ctx context.Context, hostname string, results []entities.InterfaceResult, deletedInterfaces []string) bool {
+	published := true
+
+	// 노드 오브젝트에 이벤트와 NIC 준비 상태 게시 (활성화된 경우)
+	if publisher := a.container.GetPublishNodeStatusUseCase(); publisher != nil {
+		output := publisher.Execute(ctx, usecases.PublishNodeStatusInput{
+			NodeName:          a.kubernetesNodeName(hostname),
+			Results:           results,
+			DeletedInterfaces: deletedInterfaces,
+		})
+		published = published && len(output.Errors) == 0
+	}
+
+	// 설정이 완료된 인터페이스의 Multus 보조 네트워크 정의 게시 (활성화된 경우)
+	if publisher := a.container.GetPublishNetworkAttachmentsUseCase(); publisher != nil {
+		output := publisher.Execute(ctx, usecases.PublishNetworkAttachmentsInput{Results: results})
+		published = published && len(output.Errors) == 0
+	}
+
+	return published
+}
+
+// retryPublish는 저장소가 바뀌지 않아 reconcile을 생략한 사이클에서 마지막으로 실패한 게시를 다시 시도합니다
+// 게시 유스케이스는 바뀐 상태만 다시 보내므로 이미 성공한 부분은 반복되지 않습니다
+func (a *Application) retryPublish(ctx context.Context) {
+	if a.unpublished == nil {
+		return
+	}
+	if a.publishResults(ctx, a.unpublished.nodeName, a.unpublished.results, nil) {
+		a.logger.Info("Published the last cycle results after an earlier failure")
+		a.unpublished = nil
+	}
+}
+
+// settledResults는 다시 게시할 결과에서 이번 사이클에 설정된 인터페이스를 동기화된 상태로 바꿉니다
+// 재시도 때 같은 설정 완료 이벤트가 반복 게시되지 않도록 합니다
+func settledResults(results []entities.InterfaceResult) []entities.InterfaceResult {
+	settled := make([]entities.InterfaceResult, len(results))
+	for i, result := range results {
+		if result.Result == entities.ResultConfigured {
+			result.Result = entities.ResultInSync
+		}
+		settled[i] = result
+	}
+	return settled
+}
+
+// reconcileConverged는 저장소가 바뀌지 않는 한 전체 reconcile을 다시 실행할 필요가 없는지 확인합니다
+// 실패한 인터페이스 재시도와 고아 삭제의 확인 사이클/유예 시간은 저장소가 그대로여도 사이클마다 진행되어야 함
+func reconcileConverged(configOutput *usecases.ConfigureNetworkOutput, deleteOutput *usecases.DeleteNetworkOutput) bool {
+	return configOutput.FailedCount == 0 &&
+		len(deleteOutput.Errors) == 0 &&
+		len(deleteOutput.DeferredInterfaces) == 0 &&
+		len(deleteOutput.BlockedInterfaces) == 0 &&
+		len(deleteOutput.RepairPendingInterfaces) == 0 &&
+		len(deleteOutput.RestoredInterfaces) == 0
+}
+
+// runCycle은 노드 이름을 확인하고 한 번의 스냅샷으로 삭제/설정 reconcile을 실행합니다
+// 삭제 실패는 치명적이지 않으므로 결과의 Delete.Errors로 반환합니다
+func (a *Application) runCycle(ctx context.Context, dryRun bool) (*usecases.ReconcileNetworkOutput, error) {
+	hostname, err := a.nodeName()
+	if err != nil {
+		return nil, err
+	}
+
+	return a.reconcileUseCase.Execute(ctx, usecases.ReconcileNetworkInput{
+		NodeName: hostname,
+		DryRun:   dryRun,
+	})
+}
+
+// nodeName은 확정된 노드 식별자의 노드 이름을 반환합니다 (NODE_IDENTITY_SOURCES 순서로 확인, 행이 있는 후보가 없으면 첫 후보)
+func (a *Application) nodeName() (string, error) {
+	return a.container.GetNodeIdentityResolver().NodeName()
+}
+
+// kubernetesNodeName은 노드 오브젝트 이름을 반환합니다 (NODE_NAME 미설정 시 에이전트의 노드 이름)
+func (a *Application) kubernetesNodeName(nodeName string) string {
+	if name := a.container.GetConfig().Kubernetes.NodeName; name != "" {
+		return name
+	}
+	return nodeName
+}
+
+// diagnose는 노드 사전 점검을 실행합니다
+func (a *Application) diagnose(ctx context.Context) entities.DiagnosticReport {
+	input := usecases.NodeDoctorInput{}
+	if nodeName, err := a.nodeName(); err == nil {
+		input.NodeName = nodeName
+	}
+	return a.container.GetNodeDoctorUseCase().Execute(ctx, input)
+}
+
+// reportPlan은 dry-run 계획을 로그로 남기고 헬스체크 서버의 /plan으로 노출합니다
+func (a *Application) reportPlan(plan entities.ReconcilePlan, configOutput *usecases.ConfigureNetworkOutput, deleteOutput *usecases.DeleteNetworkOutput) {
+	a.container.GetHealthService().UpdatePlan(plan)
+
+	for _, change := range plan.Changes {
+		a.logger.WithFields(logrus.Fields{
+			"action":         change.Action,
+			"interface_id":   change.InterfaceID,
+			"interface_name": change.InterfaceName,
+			"mac_address":    change.MacAddress,
+			"config_path":    change.ConfigPath,
+			"reason":         change.Reason,
+			"diffs":          change.Diffs,
+		}).Info("Dry-run planned change")
+	}
+
+	a.logger.WithFields(logrus.Fields{
+		"node_name":      plan.NodeName,
+		"create":         plan.Count(entities.PlanActionCreate),
+		"update":         plan.Count(entities.PlanActionUpdate),
+		"delete":         plan.Count(entities.PlanActionDelete),
+		"config_failed":  configOutput.FailedCount,
+		"delete_blocked": len(deleteOutput.BlockedInterfaces),
+		"unowned_files":  len(deleteOutput.UnownedFiles),
+		"tampered_files": len(deleteOutput.TamperedFiles),
+		"foreign_files":  len(deleteOutput.ForeignFiles),
+	}).Info("Dry-run reconcile plan computed")
+}
+
 // shutdown은 애플리케이션을 정리하고 종료합니다
 func (a *Application) shutdown() error {
 	// 헬스체크 서버 정리
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"multinic-agent/internal/domain/services"
+)
+
+const namesUsage = `usage: multinic-agent names <command>
+
+commands:
+  list                 show MAC-to-name allocations
+  pin <mac> <name>     pin a name to a MAC address
+  unpin <mac>          turn a pinned allocation back into a regular one
+  release <mac>        drop the allocation so the name can be reused immediately`
+
+// runNamesCommand는 MAC별 인터페이스 이름 할당을 조회하거나 변경하는 운영자 명령을 실행합니다
+func runNamesCommand(ctx context.Context, namingService *services.InterfaceNamingService, args []string, out io.Writer) error {
+	allocator := namingService.Allocator()
+	if allocator == nil {
+		return fmt.Errorf("name allocation store is disabled (NAME_ALLOCATION_STORE=none)")
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("%s", namesUsage)
+	}
+
+	switch args[0] {
+	case "list":
+		allocations, err := allocator.List(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "MAC\tNAME\tPINNED\tALLOCATED\tRELEASED")
+		for _, allocation := range allocations {
+			released := "-"
+			if allocation.ReleasedAt != nil {
+				released = allocation.ReleasedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%t\t%s\t%s\n", allocation.MacAddress, allocation.Name, allocation.Pinned,
+				allocation.AllocatedAt.Format(time.RFC3339), released)
+		}
+		return w.Flush()
+
+	case "pin":
+		if len(args) != 3 {
+			return fmt.Errorf("%s", namesUsage)
+		}
+		if err := namingService.PinName(ctx, args[1], args[2]); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "pinned %s to %s\n", args[2], args[1])
+		return nil
+
+	case "unpin":
+		if len(args) != 2 {
+			return fmt.Errorf("%s", namesUsage)
+		}
+		if err := allocator.Unpin(ctx, args[1]); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "unpinned %s\n", args[1])
+		return nil
+
+	case "release":
+		if len(args) != 2 {
+			return fmt.Errorf("%s", namesUsage)
+		}
+		if err := allocator.Forget(ctx, args[1]); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "released name allocation of %s\n", args[1])
+		return nil
+
+	default:
+		return fmt.Errorf("unknown names command %q\n%s", args[0], namesUsage)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"math"
 	"multinic-agent/internal/infrastructure/metrics"
+	"sync/atomic"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -169,6 +170,10 @@ type PollingController struct {
 	strategy Strategy
 	ticker   *time.Ticker
 	logger   *logrus.Logger
+	trigger  chan struct{} // 즉시 실행 요청 (버퍼 1, 중복 요청은 합쳐짐)
+	replace  chan Strategy // 교체할 폴링 전략 (버퍼 1, 마지막 요청만 유지)
+	paused   atomic.Bool   // 유지보수 동결 중에는 주기 실행을 건너뜀
+	progress atomic.Int64  // 루프가 마지막으로 진행된 시각 (UnixNano, 0이면 아직 시작 전)
 }
 
 // NewPollingController는 새로운 폴링 컨트롤러를 생성합니다
@@ -176,35 +181,123 @@ func NewPollingController(strategy Strategy, logger *logrus.Logger) *PollingCont
 	return &PollingController{
 		strategy: strategy,
 		logger:   logger,
+		trigger:  make(chan struct{}, 1),
+		replace:  make(chan Strategy, 1),
 	}
 }
 
+// SetStrategy는 실행 중인 폴링 루프의 전략을 교체합니다 (설정 재로드 시 사용)
+// 새 전략은 루프에서 적용되며, 다음 실행까지의 대기 시간도 새 전략의 기본 간격으로 재설정됩니다
+func (c *PollingController) SetStrategy(strategy Strategy) {
+	for {
+		select {
+		case c.replace <- strategy:
+			return
+		default:
+		}
+		// 아직 적용되지 않은 이전 요청은 버림
+		select {
+		case <-c.replace:
+		default:
+		}
+	}
+}
+
+// Trigger는 다음 주기를 기다리지 않고 작업을 즉시 실행하도록 요청합니다
+// 이미 대기 중인 요청이 있으면 합쳐지며, 일시 중지 상태에서는 요청하지 않고 false를 반환합니다
+func (c *PollingController) Trigger() bool {
+	if c.Paused() {
+		return false
+	}
+	select {
+	case c.trigger <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// Pause는 주기 실행을 중지합니다 (실행 중인 작업은 끝까지 진행)
+func (c *PollingController) Pause() {
+	c.paused.Store(true)
+}
+
+// Resume은 주기 실행을 재개합니다
+func (c *PollingController) Resume() {
+	c.paused.Store(false)
+}
+
+// Paused는 주기 실행이 중지되었는지 반환합니다
+func (c *PollingController) Paused() bool {
+	return c.paused.Load()
+}
+
+// LastProgress는 루프가 마지막으로 진행된 시각을 반환합니다 (시작 전이면 zero time)
+// 작업이 끝나거나 일시 중지 중 주기를 건너뛸 때 갱신되므로, 오래 갱신되지 않으면 루프가 멈춘 것입니다
+func (c *PollingController) LastProgress() time.Time {
+	nanos := c.progress.Load()
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos)
+}
+
+// markProgress는 루프 진행 시각을 기록합니다
+func (c *PollingController) markProgress() {
+	c.progress.Store(time.Now().UnixNano())
+}
+
 // Start는 폴링을 시작합니다
 func (c *PollingController) Start(ctx context.Context, task func(context.Context) error) error {
 	// 초기 간격으로 ticker 생성
 	initialInterval := c.strategy.NextInterval(true)
 	c.ticker = time.NewTicker(initialInterval)
 	defer c.ticker.Stop()
+	c.markProgress()
 
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
 
-		case <-c.ticker.C:
-			// 작업 실행
-			err := task(ctx)
-			success := err == nil
-
-			// 다음 간격 계산
-			nextInterval := c.strategy.NextInterval(success)
+		case <-c.trigger:
+			if c.Paused() {
+				continue
+			}
+			c.logger.Info("Running polling task on request")
+			c.run(ctx, task)
 
-			// ticker 재설정
-			c.ticker.Reset(nextInterval)
+		case strategy := <-c.replace:
+			c.strategy = strategy
+			interval := c.strategy.NextInterval(true)
+			c.ticker.Reset(interval)
+			c.logger.WithField("interval", interval).Info("Polling strategy updated")
 
-			if err != nil {
-				c.logger.WithError(err).Error("Polling task failed")
+		case <-c.ticker.C:
+			if c.Paused() {
+				c.logger.Debug("Polling paused, skipping task")
+				c.markProgress()
+				continue
 			}
+			c.run(ctx, task)
 		}
 	}
 }
+
+// run은 작업을 실행하고 결과에 따라 다음 간격으로 ticker를 재설정합니다
+func (c *PollingController) run(ctx context.Context, task func(context.Context) error) {
+	// 작업 실행
+	err := task(ctx)
+	success := err == nil
+
+	c.markProgress()
+
+	// 다음 간격 계산
+	nextInterval := c.strategy.NextInterval(success)
+
+	// ticker 재설정
+	c.ticker.Reset(nextInterval)
+
+	if err != nil {
+		c.logger.WithError(err).Error("Polling task failed")
+	}
+}
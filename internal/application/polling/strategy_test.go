@@ -1,6 +1,7 @@
 package polling
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -181,3 +182,89 @@ func TestAdaptiveStrategy(t *testing.T) {
 		assert.Equal(t, 120*time.Second, interval)
 	})
 }
+
+// fixedStrategy는 항상 같은 간격을 반환하는 테스트용 전략입니다
+type fixedStrategy struct {
+	interval time.Duration
+}
+
+func (s *fixedStrategy) NextInterval(bool) time.Duration { return s.interval }
+func (s *fixedStrategy) Reset()                          {}
+
+func TestPollingController_TriggerAndPause(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	// 주기 실행이 일어나지 않도록 긴 간격 사용
+	controller := NewPollingController(&fixedStrategy{interval: time.Hour}, logger)
+	runs := make(chan struct{}, 10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go controller.Start(ctx, func(context.Context) error {
+		runs <- struct{}{}
+		return nil
+	})
+
+	t.Run("즉시 실행 요청", func(t *testing.T) {
+		before := time.Now()
+		assert.True(t, controller.Trigger())
+		select {
+		case <-runs:
+		case <-time.After(time.Second):
+			t.Fatal("triggered task did not run")
+		}
+		// 작업이 끝나면 루프 진행 시각 갱신
+		assert.Eventually(t, func() bool {
+			return !controller.LastProgress().Before(before)
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("일시 중지 중에는 요청 거부", func(t *testing.T) {
+		controller.Pause()
+		assert.True(t, controller.Paused())
+		assert.False(t, controller.Trigger())
+		select {
+		case <-runs:
+			t.Fatal("task ran while paused")
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
+	t.Run("재개 후 다시 실행", func(t *testing.T) {
+		controller.Resume()
+		assert.False(t, controller.Paused())
+		assert.True(t, controller.Trigger())
+		select {
+		case <-runs:
+		case <-time.After(time.Second):
+			t.Fatal("triggered task did not run after resume")
+		}
+	})
+}
+
+func TestPollingController_SetStrategy(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	// 긴 간격으로 시작한 뒤 짧은 간격 전략으로 교체하면 새 간격으로 주기 실행
+	controller := NewPollingController(&fixedStrategy{interval: time.Hour}, logger)
+	runs := make(chan struct{}, 10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go controller.Start(ctx, func(context.Context) error {
+		runs <- struct{}{}
+		return nil
+	})
+
+	// 적용 전 요청은 마지막 전략만 유지
+	controller.SetStrategy(&fixedStrategy{interval: time.Hour})
+	controller.SetStrategy(&fixedStrategy{interval: 20 * time.Millisecond})
+
+	select {
+	case <-runs:
+	case <-time.After(time.Second):
+		t.Fatal("task did not run at the new interval")
+	}
+}
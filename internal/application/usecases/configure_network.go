@@ -1,7 +1,6 @@
 package usecases
 
 import (
-	"bufio"
 	"context"
 	"fmt"
 	"multinic-agent/internal/domain/entities"
@@ -9,44 +8,29 @@ import (
 	"multinic-agent/internal/domain/interfaces"
 	"multinic-agent/internal/domain/services"
 	"multinic-agent/internal/infrastructure/metrics"
-	"net"
-	"path/filepath"
-	"strconv"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/sirupsen/logrus"
-	"gopkg.in/yaml.v3"
 )
 
-// NetplanYAML represents the Netplan configuration structure
-type NetplanYAML struct {
-	Network struct {
-		Ethernets map[string]struct {
-			DHCP4     bool     `yaml:"dhcp4"`
-			MTU       int      `yaml:"mtu,omitempty"`
-			Addresses []string `yaml:"addresses,omitempty"`
-			Match     struct {
-				MACAddress string `yaml:"macaddress"`
-			} `yaml:"match"`
-			SetName string `yaml:"set-name"`
-		} `yaml:"ethernets"`
-		Version int `yaml:"version"`
-	} `yaml:"network"`
-}
-
 // ConfigureNetworkUseCase는 네트워크 설정을 처리하는 유스케이스입니다
 type ConfigureNetworkUseCase struct {
 	repository         interfaces.NetworkInterfaceRepository
 	configurer         interfaces.NetworkConfigurer
 	rollbacker         interfaces.NetworkRollbacker
+	configReader       interfaces.ConfigReader
+	linkManager        interfaces.LinkManager // nil이면 런타임 드리프트 검사 비활성화
 	namingService      *services.InterfaceNamingService
 	fileSystem         interfaces.FileSystem // 파일 시스템 의존성 추가
 	osDetector         interfaces.OSDetector
+	agentID            string   // 소유권 헤더의 에이전트 ID가 다른 파일은 덮어쓰지 않음 (빈 값이면 비교하지 않음)
+	agentAliases       []string // agentID 외에 이 에이전트의 것으로 인정하는 소유권 헤더의 에이전트 ID
 	logger             *logrus.Logger
-	maxConcurrentTasks int
+	maxConcurrentTasks atomic.Int64 // 설정 재로드로 실행 중에 바뀔 수 있음
 }
 
 // NewConfigureNetworkUseCase는 새로운 ConfigureNetworkUseCase를 생성합니다
@@ -54,27 +38,46 @@ func NewConfigureNetworkUseCase(
 	repo interfaces.NetworkInterfaceRepository,
 	configurer interfaces.NetworkConfigurer,
 	rollbacker interfaces.NetworkRollbacker,
+	configReader interfaces.ConfigReader,
+	linkManager interfaces.LinkManager,
 	naming *services.InterfaceNamingService,
 	fs interfaces.FileSystem, // 파일 시스템 의존성 추가
 	osDetector interfaces.OSDetector,
+	agentID string,
 	logger *logrus.Logger,
 	maxConcurrentTasks int,
 ) *ConfigureNetworkUseCase {
-	return &ConfigureNetworkUseCase{
-		repository:         repo,
-		configurer:         configurer,
-		rollbacker:         rollbacker,
-		namingService:      naming,
-		fileSystem:         fs,
-		osDetector:         osDetector,
-		logger:             logger,
-		maxConcurrentTasks: maxConcurrentTasks,
-	}
+	uc := &ConfigureNetworkUseCase{
+		repository:    repo,
+		configurer:    configurer,
+		rollbacker:    rollbacker,
+		configReader:  configReader,
+		linkManager:   linkManager,
+		namingService: naming,
+		fileSystem:    fs,
+		osDetector:    osDetector,
+		agentID:       agentID,
+		logger:        logger,
+	}
+	uc.SetMaxConcurrentTasks(maxConcurrentTasks)
+	return uc
+}
+
+// SetMaxConcurrentTasks는 동시에 처리할 최대 인터페이스 수를 변경합니다 (다음 사이클부터 적용)
+func (uc *ConfigureNetworkUseCase) SetMaxConcurrentTasks(maxConcurrentTasks int) {
+	uc.maxConcurrentTasks.Store(int64(maxConcurrentTasks))
+}
+
+// SetAgentAliases는 agentID 외에 이 에이전트의 것으로 인정할 소유권 헤더의 에이전트 ID를 설정합니다 (실행 전에 호출)
+func (uc *ConfigureNetworkUseCase) SetAgentAliases(aliases []string) {
+	uc.agentAliases = aliases
 }
 
 // ConfigureNetworkInput은 유스케이스의 입력 파라미터입니다
 type ConfigureNetworkInput struct {
 	NodeName string
+	DryRun   bool          // 설정 파일 쓰기, 명령 실행, DB 상태 갱신 없이 변경 계획만 계산
+	Snapshot *NodeSnapshot // 사이클 스냅샷 (nil이면 OS 감지와 저장소 조회를 직접 수행)
 }
 
 // ConfigureNetworkOutput은 유스케이스의 출력 결과입니다
@@ -82,20 +85,16 @@ type ConfigureNetworkOutput struct {
 	ProcessedCount int
 	FailedCount    int
 	TotalCount     int
+	Plan           []entities.PlannedChange   // dry-run일 때 적용했을 생성/수정 계획
+	Results        []entities.InterfaceResult // 인터페이스별 처리 결과 (ID 순)
 }
 
 // Execute는 네트워크 설정 유스케이스를 실행합니다
 func (uc *ConfigureNetworkUseCase) Execute(ctx context.Context, input ConfigureNetworkInput) (*ConfigureNetworkOutput, error) {
-	// OS 타입 감지
-	osType, err := uc.osDetector.DetectOS()
+	// 1. OS 타입과 해당 노드의 모든 활성 인터페이스 조회 (netplan_success 상태 무관)
+	osType, allInterfaces, err := uc.loadNodeState(ctx, input)
 	if err != nil {
-		return nil, errors.NewSystemError("failed to detect OS type", err)
-	}
-
-	// 1. 해당 노드의 모든 활성 인터페이스 조회 (netplan_success 상태 무관)
-	allInterfaces, err := uc.repository.GetAllNodeInterfaces(ctx, input.NodeName)
-	if err != nil {
-		return nil, errors.NewSystemError("failed to get node interfaces", err)
+		return nil, err
 	}
 
 	uc.logger.WithFields(logrus.Fields{
@@ -105,20 +104,35 @@ func (uc *ConfigureNetworkUseCase) Execute(ctx context.Context, input ConfigureN
 	}).Debug("Retrieved interfaces from database")
 
 	// 병렬 처리를 위한 설정
-	maxWorkers := uc.maxConcurrentTasks
+	maxWorkers := int(uc.maxConcurrentTasks.Load())
 	if maxWorkers <= 0 {
 		maxWorkers = 1 // 최소 1개는 처리
 	}
 
 	var (
-		processedCount int32
-		failedCount    int32
-		wg             sync.WaitGroup
-		semaphore      = make(chan struct{}, maxWorkers) // 동시 실행 제한
+		results   = &resultRecorder{}
+		wg        sync.WaitGroup
+		semaphore = make(chan struct{}, maxWorkers) // 동시 실행 제한
 	)
 
+	// dry-run이면 적용 대신 계획을 모음
+	var plan *planRecorder
+	if input.DryRun {
+		plan = &planRecorder{}
+	}
+
+	// DB에서 같은 이름을 지정한 인터페이스끼리는 어느 쪽이 이름을 가져야 할지 알 수 없으므로 모두 실패 처리
+	duplicateNames := findDuplicateDesiredNames(allInterfaces)
+
 	// 2. 각 인터페이스를 병렬로 처리
 	for _, iface := range allInterfaces {
+		if duplicateNames[iface.DesiredName] {
+			err := errors.NewValidationError(fmt.Sprintf("desired interface name %s is assigned to multiple interfaces", iface.DesiredName), nil)
+			uc.handleInterfaceError("interface name generation", iface.ID, iface.MacAddress, err)
+			results.fail(iface, "", "", err)
+			continue
+		}
+
 		wg.Add(1)
 		go func(iface entities.NetworkInterface) {
 			defer wg.Done()
@@ -135,7 +149,7 @@ func (uc *ConfigureNetworkUseCase) Execute(ctx context.Context, input ConfigureN
 				metrics.SetConcurrentTasks(float64(len(semaphore)))
 			}()
 
-			if err := uc.processInterfaceWithCheck(ctx, iface, osType, &processedCount, &failedCount); err != nil {
+			if err := uc.processInterfaceWithCheck(ctx, iface, osType, plan, results); err != nil {
 				uc.logger.WithError(err).Error("Critical error processing interface")
 			}
 		}(iface)
@@ -144,11 +158,34 @@ func (uc *ConfigureNetworkUseCase) Execute(ctx context.Context, input ConfigureN
 	// 모든 처리가 완료될 때까지 대기
 	wg.Wait()
 
-	return &ConfigureNetworkOutput{
-		ProcessedCount: int(atomic.LoadInt32(&processedCount)),
-		FailedCount:    int(atomic.LoadInt32(&failedCount)),
+	output := &ConfigureNetworkOutput{
+		ProcessedCount: results.count(entities.ResultConfigured),
+		FailedCount:    results.count(entities.ResultFailed),
 		TotalCount:     len(allInterfaces),
-	}, nil
+		Results:        results.list(),
+	}
+	if plan != nil {
+		output.Plan = plan.list()
+	}
+	return output, nil
+}
+
+// findDuplicateDesiredNames는 여러 인터페이스에 지정된 DB 지정 이름을 찾습니다
+func findDuplicateDesiredNames(ifaces []entities.NetworkInterface) map[string]bool {
+	counts := make(map[string]int)
+	for _, iface := range ifaces {
+		if iface.DesiredName != "" {
+			counts[iface.DesiredName]++
+		}
+	}
+
+	duplicates := make(map[string]bool)
+	for name, count := range counts {
+		if count > 1 {
+			duplicates[name] = true
+		}
+	}
+	return duplicates
 }
 
 // processInterface는 개별 인터페이스를 처리합니다
@@ -246,332 +283,336 @@ func (uc *ConfigureNetworkUseCase) performRollback(ctx context.Context, interfac
 	return nil
 }
 
-// isDrifted는 Netplan 설정 파일과 DB 데이터 간의 드리프트를 감지합니다.
-func (uc *ConfigureNetworkUseCase) isDrifted(ctx context.Context, dbIface entities.NetworkInterface, configPath string) bool {
-	// 파일이 존재하지 않으면 드리프트로 간주 (새로 생성해야 함)
-	if !uc.fileSystem.Exists(configPath) {
-		uc.logger.WithFields(logrus.Fields{
-			"interface_id": dbIface.ID,
-			"mac_address":  dbIface.MacAddress,
-			"config_path":  configPath,
-		}).Debug("Configuration file not found, detected as configuration change")
-		return true
+// detectFileDrift는 설정 파일과 DB 데이터 간의 드리프트 유형과 파일에서 읽은 설정을 반환합니다
+// 파일 형식은 OS 어댑터의 ConfigReader가 해석하므로 모든 OS에서 같은 기준으로 비교합니다
+// 에이전트가 생성했다는 증거가 없는 파일은 덮어쓰지 않도록 에러를 반환합니다
+func (uc *ConfigureNetworkUseCase) detectFileDrift(dbIface entities.NetworkInterface, configPath string) ([]string, *entities.InterfaceConfig, error) {
+	fileConfig, err := uc.configReader.ReadConfig(configPath)
+	if err != nil {
+		uc.logger.WithError(err).WithField("file", configPath).Warn("Failed to read configuration file, treating as configuration mismatch")
+		return []string{planReasonConfigUnreadable}, nil, nil // 파일 읽기/파싱 실패 시 드리프트로 간주하여 재설정 시도
 	}
 
-	content, err := uc.fileSystem.ReadFile(configPath)
+	ownershipDrifts, err := services.DetectOwnershipDrift(dbIface, fileConfig, uc.agentID, uc.agentAliases...)
+	if err == services.ErrConfigForeign {
+		return nil, nil, errors.NewValidationError(fmt.Sprintf("refusing to overwrite %s (owned by agent %s)", configPath, fileConfig.Ownership.AgentID), err)
+	}
 	if err != nil {
-		uc.logger.WithError(err).WithField("file", configPath).Warn("Failed to read Netplan file, treating as configuration mismatch")
-		return true // 파일 읽기 실패 시 드리프트로 간주하여 재설정 시도
+		return nil, nil, errors.NewValidationError(fmt.Sprintf("refusing to overwrite %s (file MAC %s)", configPath, fileConfig.MacAddress), err)
+	}
+	if fileConfig.Tampered {
+		uc.logger.WithFields(logrus.Fields{
+			"config_path":  configPath,
+			"interface_id": dbIface.ID,
+			"agent_id":     fileConfig.Ownership.AgentID,
+		}).Warn("Managed configuration file was modified manually - restoring rendered content")
 	}
 
-	netplanData, err := uc.parseNetplanFile(content)
-	if err != nil {
-		uc.logger.WithError(err).WithField("file", configPath).Warn("Failed to parse Netplan YAML, treating as configuration mismatch")
-		return true
+	drifts := append(ownershipDrifts, services.DetectConfigDrift(dbIface, fileConfig)...)
+	if len(drifts) == 0 {
+		return nil, fileConfig, nil
 	}
 
-	// Netplan 파일에서 설정 추출
-	fileConfig := uc.extractNetplanConfig(netplanData)
+	uc.logDriftDetails(dbIface, drifts, logrus.Fields{
+		"config_path":  configPath,
+		"file_mac":     fileConfig.MacAddress,
+		"file_address": fileConfig.Address,
+		"file_cidr":    fileConfig.CIDR,
+		"file_mtu":     fileConfig.MTU,
+	})
 
-	// MAC 주소 검증
-	if fileConfig.macAddress != dbIface.MacAddress {
-		uc.logger.WithFields(logrus.Fields{
-			"db_mac":   dbIface.MacAddress,
-			"file_mac": fileConfig.macAddress,
-		}).Warn("MAC address mismatch, treating as configuration change")
-		return true
+	// 드리프트 타입별 메트릭 기록
+	for _, drift := range drifts {
+		metrics.RecordDrift(drift)
 	}
 
-	// 드리프트 체크
-	return uc.checkConfigDrift(dbIface, fileConfig)
+	return drifts, fileConfig, nil
 }
 
-// netplanFileConfig는 Netplan 파일에서 추출한 설정을 담는 구조체입니다
-type netplanFileConfig struct {
-	macAddress   string
-	address      string
-	cidr         string
-	mtu          int
-	hasAddresses bool
-}
+// loadNodeState는 OS 타입과 노드 인터페이스를 스냅샷에서 가져오거나, 스냅샷이 없으면 직접 조회합니다
+// 설정 파일 내용과 링크 상태는 같은 사이클의 삭제 단계가 바꿀 수 있으므로 인터페이스별 처리 시점에 다시 확인합니다
+func (uc *ConfigureNetworkUseCase) loadNodeState(ctx context.Context, input ConfigureNetworkInput) (interfaces.OSType, []entities.NetworkInterface, error) {
+	if input.Snapshot != nil {
+		return input.Snapshot.OSType, input.Snapshot.Interfaces, nil
+	}
 
-// parseNetplanFile은 Netplan YAML을 파싱합니다
-func (uc *ConfigureNetworkUseCase) parseNetplanFile(content []byte) (*NetplanYAML, error) {
-	var netplanData NetplanYAML
-	if err := yaml.Unmarshal(content, &netplanData); err != nil {
-		return nil, err
+	osType, err := uc.osDetector.DetectOS()
+	if err != nil {
+		return "", nil, errors.NewSystemError("failed to detect OS type", err)
 	}
-	return &netplanData, nil
-}
 
-// extractNetplanConfig는 Netplan 데이터에서 설정을 추출합니다
-func (uc *ConfigureNetworkUseCase) extractNetplanConfig(netplanData *NetplanYAML) netplanFileConfig {
-	config := netplanFileConfig{}
-
-	for _, eth := range netplanData.Network.Ethernets {
-		config.macAddress = eth.Match.MACAddress
-		config.hasAddresses = len(eth.Addresses) > 0
-		config.mtu = eth.MTU
-
-		if config.hasAddresses {
-			// Parse the full CIDR from the file
-			ip, ipNet, err := net.ParseCIDR(eth.Addresses[0])
-			if err == nil {
-				config.address = ip.String() // Get the actual IP address
-				config.cidr = ipNet.String() // Get the network CIDR
-			} else {
-				// If parsing fails, use the raw address
-				config.address = eth.Addresses[0]
-				config.cidr = ""
-			}
-		}
-		break // Assuming one ethernet per file
+	allInterfaces, err := uc.repository.GetAllNodeInterfaces(ctx, input.NodeName)
+	if err != nil {
+		return "", nil, errors.NewSystemError("failed to get node interfaces", err)
 	}
 
-	return config
+	return osType, allInterfaces, nil
 }
 
-// checkConfigDrift는 DB와 파일 설정 간의 드리프트를 체크합니다
-func (uc *ConfigureNetworkUseCase) checkConfigDrift(dbIface entities.NetworkInterface, fileConfig netplanFileConfig) bool {
-	// 드리프트 감지 - 간단한 OR 조건으로 유지
-	isDrifted := (!fileConfig.hasAddresses && dbIface.Address != "") ||
-		(dbIface.Address != fileConfig.address) ||
-		(dbIface.CIDR != fileConfig.cidr) ||
-		(dbIface.MTU != fileConfig.mtu)
-
-	if isDrifted {
-		uc.logDriftDetails("netplan", dbIface, logrus.Fields{
-			"file_address":    fileConfig.address,
-			"file_cidr":       fileConfig.cidr,
-			"file_mtu":        fileConfig.mtu,
-			"config_change_1": (!fileConfig.hasAddresses && dbIface.Address != ""),
-			"config_change_2": (dbIface.Address != fileConfig.address),
-			"config_change_3": (dbIface.CIDR != fileConfig.cidr),
-			"config_change_4": (dbIface.MTU != fileConfig.mtu),
-		})
-
-		// 드리프트 타입별 메트릭 기록
-		if !fileConfig.hasAddresses && dbIface.Address != "" {
-			metrics.RecordDrift("missing_address")
-		}
-		if dbIface.Address != fileConfig.address {
-			metrics.RecordDrift("ip_address")
-		}
-		if dbIface.CIDR != fileConfig.cidr {
-			metrics.RecordDrift("cidr")
-		}
-		if dbIface.MTU != fileConfig.mtu {
-			metrics.RecordDrift("mtu")
+// processInterfaceWithCheck는 개별 인터페이스를 처리하기 전에 필요성을 검사합니다
+// plan이 nil이 아니면 변경을 적용하지 않고 계획 항목만 기록합니다 (dry-run)
+func (uc *ConfigureNetworkUseCase) processInterfaceWithCheck(ctx context.Context, iface entities.NetworkInterface, osType interfaces.OSType, plan *planRecorder, results *resultRecorder) error {
+	// DB 지정 이름으로 바뀌는 경우 이전 이름의 설정 파일을 정리하기 위해 현재 이름을 먼저 확인
+	previousName := ""
+	if iface.DesiredName != "" {
+		name, err := uc.namingService.CurrentNameForInterface(ctx, iface)
+		if err != nil {
+			uc.logger.WithError(err).WithField("mac_address", iface.MacAddress).Warn("Failed to look up current interface name")
 		}
+		previousName = name
 	}
 
-	return isDrifted
-}
-
-// findIfcfgFile는 해당 인터페이스의 ifcfg 파일을 찾습니다
-func (uc *ConfigureNetworkUseCase) findIfcfgFile(interfaceName string) string {
-	configDir := uc.configurer.GetConfigDir()
-	fileName := "ifcfg-" + interfaceName
-	filePath := filepath.Join(configDir, fileName)
-
-	if uc.fileSystem.Exists(filePath) {
-		return filePath
+	// 인터페이스 이름 생성 (기존에 할당된 이름이 있다면 재사용, dry-run이면 할당을 저장하지 않음)
+	generateName := uc.namingService.GenerateNameForInterface
+	if plan != nil {
+		generateName = uc.namingService.PreviewNameForInterface
 	}
-
-	return ""
-}
-
-// isIfcfgDrifted는 ifcfg 파일과 DB 데이터 간의 드리프트를 감지합니다
-func (uc *ConfigureNetworkUseCase) isIfcfgDrifted(ctx context.Context, dbIface entities.NetworkInterface, configPath string) bool {
-	content, err := uc.fileSystem.ReadFile(configPath)
+	interfaceName, err := generateName(iface)
 	if err != nil {
-		uc.logger.WithError(err).WithField("file", configPath).Warn("Failed to read ifcfg file, treating as configuration mismatch")
-		return true
+		uc.handleInterfaceError("interface name generation", iface.ID, iface.MacAddress, err)
+		results.fail(iface, "", "", err)
+		return nil // 다음 인터페이스 처리를 위해 에러 반환하지 않음
 	}
 
-	// ifcfg 파일 파싱
-	fileConfig := uc.parseIfcfgFile(content)
+	// 처리 필요성 검사
+	change, err := uc.checkNeedProcessing(ctx, iface, interfaceName)
+	if err != nil {
+		uc.handleInterfaceError("config ownership check", iface.ID, iface.MacAddress, err)
+		results.fail(iface, interfaceName.String(), "", err)
+		return nil
+	}
+	result := entities.InterfaceResult{
+		InterfaceID:   iface.ID,
+		InterfaceName: interfaceName.String(),
+		MacAddress:    iface.MacAddress,
+		Address:       iface.Address,
+		CIDR:          iface.CIDR,
+		Result:        entities.ResultInSync,
+	}
+	if change == nil {
+		results.add(result)
+		return nil
+	}
+	result.Drift = change.Reason
+
+	renamed := previousName != "" && previousName != interfaceName.String()
+	if plan != nil {
+		result.Result = entities.ResultPlanned
+		results.add(result)
+		plan.add(*change)
+		if renamed {
+			uc.planRenamedConfig(plan, iface, previousName)
+		}
+		return nil
+	}
 
-	// MAC 주소 검증
-	if fileConfig.macAddress != strings.ToLower(dbIface.MacAddress) {
-		uc.logger.WithFields(logrus.Fields{
-			"db_mac":   dbIface.MacAddress,
-			"file_mac": fileConfig.macAddress,
-		}).Warn("MAC address mismatch in ifcfg file")
-		return true
+	uc.logger.WithFields(logrus.Fields{
+		"interface_id":   iface.ID,
+		"interface_name": interfaceName.String(),
+		"mac_address":    iface.MacAddress,
+		"status":         iface.Status,
+		"os_type":        osType,
+		"config_path":    change.ConfigPath,
+		"reason":         change.Reason,
+	}).Debug("Processing interface")
+
+	if hasDrift(change.Reason, services.DriftTypeRuntimeName) {
+		uc.prepareLinkRename(ctx, iface, interfaceName.String())
+	}
+
+	if err := uc.processInterface(ctx, iface, interfaceName); err != nil {
+		uc.handleProcessingError(ctx, iface, interfaceName, err)
+		// 설정 적용/검증 단계의 실패(네트워크 에러)는 항상 롤백을 거침
+		result.Result = entities.ResultFailed
+		result.Error = err.Error()
+		result.RolledBack = errors.IsNetworkError(err)
+		results.add(result)
+	} else {
+		result.Result = entities.ResultConfigured
+		results.add(result)
+		if renamed {
+			uc.removeRenamedConfig(ctx, previousName, interfaceName.String())
+		}
 	}
 
-	// 드리프트 체크
-	return uc.checkIfcfgDrift(dbIface, fileConfig)
+	return nil
 }
 
-// ifcfgFileConfig는 ifcfg 파일에서 추출한 설정을 담는 구조체입니다
-type ifcfgFileConfig struct {
-	macAddress string
-	ipAddress  string
-	prefix     string
-	mtu        int
+// hasDrift는 쉼표로 연결된 변경 사유에 해당 드리프트 유형이 있는지 확인합니다
+func hasDrift(reason, driftType string) bool {
+	return slices.Contains(strings.Split(reason, ","), driftType)
 }
 
-// parseIfcfgFile은 ifcfg 파일을 파싱합니다
-func (uc *ConfigureNetworkUseCase) parseIfcfgFile(content []byte) ifcfgFileConfig {
-	config := ifcfgFileConfig{}
-
-	scanner := bufio.NewScanner(strings.NewReader(string(content)))
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" || strings.HasPrefix(line, "#") {
-			continue
-		}
-
-		parts := strings.SplitN(line, "=", 2)
-		if len(parts) != 2 {
-			continue
-		}
+// prepareLinkRename은 커널 링크 이름이 원하는 이름과 다를 때 재적용 전에 링크를 비활성화하고 이름을 변경합니다
+// netplan apply와 NetworkManager는 활성화된 링크의 이름을 바꾸지 못하므로 그대로 재적용하면 매 사이클 드리프트가 반복됩니다
+// 재적용이 링크를 다시 활성화하므로 실패는 경고만 남깁니다
+func (uc *ConfigureNetworkUseCase) prepareLinkRename(ctx context.Context, iface entities.NetworkInterface, interfaceName string) {
+	state, err := uc.findLinkState(ctx, iface.MacAddress, interfaceName)
+	if err != nil || state == nil || state.Name == interfaceName {
+		return
+	}
 
-		key := strings.TrimSpace(parts[0])
-		value := strings.TrimSpace(parts[1])
-
-		switch key {
-		case "HWADDR":
-			config.macAddress = strings.ToLower(value)
-		case "IPADDR":
-			config.ipAddress = value
-		case "PREFIX":
-			config.prefix = value
-		case "MTU":
-			if mtu, err := strconv.Atoi(value); err == nil {
-				config.mtu = mtu
-			}
-		}
+	fields := logrus.Fields{
+		"link_name":      state.Name,
+		"interface_name": interfaceName,
+		"mac_address":    iface.MacAddress,
+	}
+	if err := uc.linkManager.SetLinkDown(ctx, state.Name); err != nil {
+		uc.logger.WithFields(fields).WithError(err).Warn("Failed to bring down link before rename")
+		return
+	}
+	if err := uc.linkManager.RenameLink(ctx, state.Name, interfaceName); err != nil {
+		uc.logger.WithFields(fields).WithError(err).Warn("Failed to rename link before re-applying configuration")
+		return
 	}
+	uc.logger.WithFields(fields).Info("Renamed link to expected interface name before re-applying configuration")
+}
 
-	return config
+// planRenamedConfig는 DB 지정 이름으로 바뀔 때 제거될 이전 이름의 설정 파일을 계획에 추가합니다
+func (uc *ConfigureNetworkUseCase) planRenamedConfig(plan *planRecorder, iface entities.NetworkInterface, previousName string) {
+	configPath := uc.configReader.FindConfigPath(previousName)
+	if configPath == "" {
+		return
+	}
+	plan.add(entities.PlannedChange{
+		Action:        entities.PlanActionDelete,
+		InterfaceID:   iface.ID,
+		InterfaceName: previousName,
+		MacAddress:    iface.MacAddress,
+		ConfigPath:    configPath,
+		Reason:        planReasonRenamed,
+	})
 }
 
-// checkIfcfgDrift는 DB와 ifcfg 파일 설정 간의 드리프트를 체크합니다
-func (uc *ConfigureNetworkUseCase) checkIfcfgDrift(dbIface entities.NetworkInterface, fileConfig ifcfgFileConfig) bool {
-	// 드리프트 체크 - 각 항목을 확인
-	var dbPrefix string
-	if dbIface.CIDR != "" {
-		if parts := strings.Split(dbIface.CIDR, "/"); len(parts) == 2 {
-			dbPrefix = parts[1]
-		}
+// removeRenamedConfig는 DB 지정 이름으로 바뀐 인터페이스의 이전 이름 설정 파일을 제거합니다
+// 같은 MAC을 가리키는 설정 파일이 두 개 남으면 재부팅 시 어느 이름이 적용될지 보장되지 않음
+func (uc *ConfigureNetworkUseCase) removeRenamedConfig(ctx context.Context, previousName, newName string) {
+	if uc.configReader.FindConfigPath(previousName) == "" {
+		return
 	}
 
-	isDrifted := (dbIface.Address != fileConfig.ipAddress) ||
-		(dbPrefix != "" && fileConfig.prefix != "" && dbPrefix != fileConfig.prefix) ||
-		(dbIface.MTU != fileConfig.mtu)
-
-	if isDrifted {
-		uc.logDriftDetails("ifcfg", dbIface, logrus.Fields{
-			"file_address": fileConfig.ipAddress,
-			"file_prefix":  fileConfig.prefix,
-			"file_mtu":     fileConfig.mtu,
-		})
+	fields := logrus.Fields{
+		"previous_name":  previousName,
+		"interface_name": newName,
 	}
-
-	return isDrifted
+	if err := uc.rollbacker.Rollback(ctx, previousName); err != nil {
+		uc.logger.WithFields(fields).WithError(err).Warn("Failed to remove configuration of previous interface name")
+		return
+	}
+	uc.logger.WithFields(fields).Info("Removed configuration of previous interface name")
 }
 
-// findNetplanFileForInterface는 해당 인터페이스의 실제 netplan 파일을 찾습니다
-func (uc *ConfigureNetworkUseCase) findNetplanFileForInterface(interfaceName string) string {
-	configDir := uc.configurer.GetConfigDir()
-	files, err := uc.fileSystem.ListFiles(configDir)
-	if err != nil {
-		uc.logger.WithError(err).Warn("Failed to scan Netplan directory")
-		return ""
+// checkNeedProcessing는 인터페이스 처리 필요성을 검사하고, 처리가 필요하면 변경 내용을 반환합니다 (필요 없으면 nil)
+func (uc *ConfigureNetworkUseCase) checkNeedProcessing(ctx context.Context, iface entities.NetworkInterface, interfaceName entities.InterfaceName) (*entities.PlannedChange, error) {
+	desired := entities.NewInterfaceConfig(iface, interfaceName.String())
+	change := &entities.PlannedChange{
+		Action:        entities.PlanActionUpdate,
+		InterfaceID:   iface.ID,
+		InterfaceName: interfaceName.String(),
+		MacAddress:    iface.MacAddress,
+		ConfigPath:    uc.configReader.FindConfigPath(interfaceName.String()),
 	}
 
-	// 해당 인터페이스 이름을 포함하는 파일 찾기
-	for _, file := range files {
-		if strings.Contains(file, interfaceName) && strings.HasSuffix(file, ".yaml") {
-			return filepath.Join(configDir, file)
-		}
+	// 파일이 없으면 새로 생성할 경로 설정
+	if change.ConfigPath == "" {
+		change.Action = entities.PlanActionCreate
+		change.ConfigPath = uc.configReader.ConfigPath(interfaceName.String())
+		change.Reason = planReasonConfigMissing
+		change.Diffs = services.DiffInterfaceConfig(desired, nil)
+		return change, nil
 	}
 
-	return ""
-}
-
-// processInterfaceWithCheck는 개별 인터페이스를 처리하기 전에 필요성을 검사합니다
-func (uc *ConfigureNetworkUseCase) processInterfaceWithCheck(ctx context.Context, iface entities.NetworkInterface, osType interfaces.OSType, processedCount, failedCount *int32) error {
-	// 인터페이스 이름 생성 (기존에 할당된 이름이 있다면 재사용)
-	interfaceName, err := uc.namingService.GenerateNextNameForMAC(iface.MacAddress)
+	drifts, fileConfig, err := uc.detectFileDrift(iface, change.ConfigPath)
 	if err != nil {
-		uc.handleInterfaceError("interface name generation", iface.ID, iface.MacAddress, err)
-		atomic.AddInt32(failedCount, 1)
-		return nil // 다음 인터페이스 처리를 위해 에러 반환하지 않음
+		return nil, err
+	}
+	if len(drifts) > 0 {
+		change.Reason = strings.Join(drifts, ",")
+		change.Diffs = services.DiffInterfaceConfig(desired, fileConfig)
+		return change, nil
 	}
 
-	// OS별로 처리 필요성 검사
-	shouldProcess, configPath := uc.checkNeedProcessing(ctx, iface, interfaceName, osType)
+	// 아직 설정되지 않은 경우 처리
+	if iface.Status == entities.StatusPending {
+		change.Reason = planReasonStatusPending
+		return change, nil
+	}
 
-	if shouldProcess {
-		uc.logger.WithFields(logrus.Fields{
-			"interface_id":   iface.ID,
-			"interface_name": interfaceName.String(),
-			"mac_address":    iface.MacAddress,
-			"status":         iface.Status,
-			"os_type":        osType,
-			"config_path":    configPath,
-		}).Debug("Processing interface")
-
-		if err := uc.processInterface(ctx, iface, interfaceName); err != nil {
-			uc.handleProcessingError(ctx, iface, interfaceName, err)
-			atomic.AddInt32(failedCount, 1)
-		} else {
-			atomic.AddInt32(processedCount, 1)
+	// 파일이 DB와 일치하더라도 커널 상태가 수동으로 변경되었을 수 있으므로 런타임 드리프트 검사
+	if uc.linkManager != nil {
+		if drifts, state := uc.detectRuntimeDrift(ctx, iface, interfaceName.String()); len(drifts) > 0 {
+			change.Reason = strings.Join(drifts, ",")
+			change.Diffs = services.DiffLinkState(desired, state)
+			return change, nil
 		}
 	}
 
-	return nil
+	return nil, nil
 }
 
-// checkNeedProcessing는 인터페이스 처리 필요성을 검사합니다
-func (uc *ConfigureNetworkUseCase) checkNeedProcessing(ctx context.Context, iface entities.NetworkInterface, interfaceName entities.InterfaceName, osType interfaces.OSType) (bool, string) {
-	if osType == interfaces.OSTypeRHEL {
-		return uc.checkRHELNeedProcessing(ctx, iface, interfaceName)
+// detectRuntimeDrift는 커널 링크의 실제 주소, MTU, 활성 상태, 이름이 DB와 다른지 감지하여 드리프트 유형과 링크 상태를 반환합니다
+func (uc *ConfigureNetworkUseCase) detectRuntimeDrift(ctx context.Context, dbIface entities.NetworkInterface, interfaceName string) ([]string, *entities.LinkState) {
+	state, err := uc.findLinkState(ctx, dbIface.MacAddress, interfaceName)
+	if err != nil {
+		uc.logger.WithError(err).WithField("interface_name", interfaceName).Warn("Failed to read live link state, skipping runtime drift check")
+		return nil, nil
+	}
+	if state == nil {
+		// 포트가 분리되어 링크가 없으면 재적용으로 복구할 수 없음
+		uc.logger.WithFields(logrus.Fields{
+			"interface_name": interfaceName,
+			"mac_address":    dbIface.MacAddress,
+		}).Debug("No live link found for interface, skipping runtime drift check")
+		return nil, nil
 	}
-	return uc.checkNetplanNeedProcessing(ctx, iface, interfaceName)
-}
 
-// checkRHELNeedProcessing는 RHEL 시스템에서 인터페이스 처리 필요성을 검사합니다
-func (uc *ConfigureNetworkUseCase) checkRHELNeedProcessing(ctx context.Context, iface entities.NetworkInterface, interfaceName entities.InterfaceName) (bool, string) {
-	configPath := uc.findIfcfgFile(interfaceName.String())
-	fileExists := configPath != ""
+	drifts := services.DetectRuntimeDrift(dbIface, interfaceName, state)
+	if len(drifts) == 0 {
+		return nil, state
+	}
 
-	isDrifted := false
-	if fileExists {
-		isDrifted = uc.isIfcfgDrifted(ctx, iface, configPath)
+	uc.logDriftDetails(dbIface, drifts, logrus.Fields{
+		"link_name":      state.Name,
+		"link_addresses": state.Addresses,
+		"link_mtu":       state.MTU,
+		"link_operstate": state.OperState,
+		"link_admin_up":  state.AdminUp,
+	})
+	for _, drift := range drifts {
+		metrics.RecordDrift(drift)
 	}
 
-	// 파일이 없거나, 드리프트가 있거나, 아직 설정되지 않은 경우 처리
-	shouldProcess := !fileExists || isDrifted || iface.Status == entities.StatusPending
-	return shouldProcess, configPath
+	return drifts, state
 }
 
-// checkNetplanNeedProcessing는 Ubuntu 시스템에서 인터페이스 처리 필요성을 검사합니다
-func (uc *ConfigureNetworkUseCase) checkNetplanNeedProcessing(ctx context.Context, iface entities.NetworkInterface, interfaceName entities.InterfaceName) (bool, string) {
-	configPath := uc.findNetplanFileForInterface(interfaceName.String())
-	if configPath == "" {
-		// 파일이 없으면 새로 생성할 경로 설정
-		configPath = filepath.Join(uc.configurer.GetConfigDir(), fmt.Sprintf("9%d-%s.yaml", extractInterfaceIndex(interfaceName.String()), interfaceName.String()))
+// findLinkState는 MAC 주소에 해당하는 커널 링크 상태를 찾습니다 (없으면 nil)
+// 기대하는 이름의 링크를 먼저 조회하고, 없거나 MAC이 다르면 전체 링크에서 MAC으로 찾습니다
+func (uc *ConfigureNetworkUseCase) findLinkState(ctx context.Context, macAddress, interfaceName string) (*entities.LinkState, error) {
+	state, err := uc.linkManager.GetLinkState(ctx, interfaceName)
+	if err != nil && !errors.IsNotFoundError(err) {
+		return nil, err
+	}
+	if err == nil && strings.EqualFold(state.MacAddress, macAddress) {
+		return state, nil
 	}
 
-	// 파일이 존재하지 않거나, 드리프트가 발생했거나, 아직 설정되지 않은 경우 처리
-	fileExists := uc.fileSystem.Exists(configPath)
-	isDrifted := false
-	if fileExists {
-		isDrifted = uc.isDrifted(ctx, iface, configPath)
+	links, err := uc.linkManager.ListLinks(ctx)
+	if err != nil {
+		return nil, err
 	}
-	shouldProcess := !fileExists || isDrifted || iface.Status == entities.StatusPending
-	return shouldProcess, configPath
+	for i := range links {
+		if strings.EqualFold(links[i].MacAddress, macAddress) {
+			return &links[i], nil
+		}
+	}
+
+	return nil, nil
 }
 
-// extractInterfaceIndex는 인터페이스 이름에서 인덱스를 추출합니다
 // logDriftDetails는 드리프트 상세 정보를 로깅합니다
-func (uc *ConfigureNetworkUseCase) logDriftDetails(configType string, dbIface entities.NetworkInterface, fileFields logrus.Fields) {
+func (uc *ConfigureNetworkUseCase) logDriftDetails(dbIface entities.NetworkInterface, drifts []string, fileFields logrus.Fields) {
 	fields := logrus.Fields{
+		"drift_types":  drifts,
 		"interface_id": dbIface.ID,
 		"mac_address":  dbIface.MacAddress,
 		"db_address":   dbIface.Address,
@@ -584,7 +625,7 @@ func (uc *ConfigureNetworkUseCase) logDriftDetails(configType string, dbIface en
 		fields[k] = v
 	}
 
-	uc.logger.WithFields(fields).Debug(configType + " configuration drift detected")
+	uc.logger.WithFields(fields).Debug("Configuration drift detected")
 }
 
 // handleInterfaceError는 인터페이스 처리 에러를 기록합니다
@@ -639,14 +680,3 @@ func (uc *ConfigureNetworkUseCase) getErrorType(err error) string {
 		return "unknown"
 	}
 }
-
-func extractInterfaceIndex(name string) int {
-	// multinic0 -> 0, multinic1 -> 1 등
-	if strings.HasPrefix(name, "multinic") {
-		indexStr := strings.TrimPrefix(name, "multinic")
-		if index, err := strconv.Atoi(indexStr); err == nil {
-			return index
-		}
-	}
-	return 0
-}
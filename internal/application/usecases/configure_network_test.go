@@ -68,6 +68,24 @@ func (m *MockNetworkConfigurer) Validate(ctx context.Context, name entities.Inte
 	return args.Error(0)
 }
 
+func (m *MockNetworkConfigurer) ConfigPath(name string) string {
+	args := m.Called(name)
+	return args.String(0)
+}
+
+func (m *MockNetworkConfigurer) FindConfigPath(name string) string {
+	args := m.Called(name)
+	return args.String(0)
+}
+
+func (m *MockNetworkConfigurer) ReadConfig(path string) (*entities.InterfaceConfig, error) {
+	args := m.Called(path)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.InterfaceConfig), args.Error(1)
+}
+
 func (m *MockNetworkConfigurer) GetConfigDir() string {
 	args := m.Called()
 	return args.String(0)
@@ -111,6 +129,16 @@ func (m *MockFileSystem) Remove(path string) error {
 	return args.Error(0)
 }
 
+func (m *MockFileSystem) Rename(oldPath, newPath string) error {
+	args := m.Called(oldPath, newPath)
+	return args.Error(0)
+}
+
+func (m *MockFileSystem) WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	args := m.Called(path, data, perm)
+	return args.Error(0)
+}
+
 func (m *MockFileSystem) ListFiles(path string) ([]string, error) {
 	args := m.Called(path)
 	return args.Get(0).([]string), args.Error(1)
@@ -136,6 +164,52 @@ func (m *MockCommandExecutor) ExecuteWithTimeout(ctx context.Context, timeout ti
 	return mockArgs.Get(0).([]byte), mockArgs.Error(1)
 }
 
+// MockLinkManager는 LinkManager 인터페이스의 목 구현체입니다
+type MockLinkManager struct {
+	mock.Mock
+}
+
+func (m *MockLinkManager) SetLinkUp(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
+func (m *MockLinkManager) SetLinkDown(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
+func (m *MockLinkManager) FlushLink(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
+func (m *MockLinkManager) RenameLink(ctx context.Context, name, newName string) error {
+	args := m.Called(ctx, name, newName)
+	return args.Error(0)
+}
+
+func (m *MockLinkManager) GetKernelName(ctx context.Context, name string) (string, error) {
+	args := m.Called(ctx, name)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockLinkManager) ListLinks(ctx context.Context) ([]entities.LinkState, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.LinkState), args.Error(1)
+}
+
+func (m *MockLinkManager) GetLinkState(ctx context.Context, name string) (*entities.LinkState, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.LinkState), args.Error(1)
+}
+
 // MockOSDetector는 OSDetector 인터페이스의 목 구현체입니다
 type MockOSDetector struct {
 	mock.Mock
@@ -196,9 +270,8 @@ func TestConfigureNetworkUseCase_Execute(t *testing.T) {
 				}
 
 				// 설정 파일 경로 검색
-				configurer.On("GetConfigDir").Return("/etc/netplan")
-				fs.On("ListFiles", "/etc/netplan").Return([]string{}, nil)
-				fs.On("Exists", "/etc/netplan/90-multinic0.yaml").Return(false)
+				configurer.On("FindConfigPath", "multinic0").Return("")
+				configurer.On("ConfigPath", "multinic0").Return("/etc/netplan/90-multinic0.yaml")
 
 				// 네트워크 설정 성공
 				configurer.On("Configure", mock.Anything, testInterface, mock.MatchedBy(func(name entities.InterfaceName) bool {
@@ -246,9 +319,8 @@ func TestConfigureNetworkUseCase_Execute(t *testing.T) {
 				}
 
 				// 설정 파일 경로 검색
-				configurer.On("GetConfigDir").Return("/etc/netplan")
-				fs.On("ListFiles", "/etc/netplan").Return([]string{}, nil)
-				fs.On("Exists", "/etc/netplan/90-multinic0.yaml").Return(false)
+				configurer.On("FindConfigPath", "multinic0").Return("")
+				configurer.On("ConfigPath", "multinic0").Return("/etc/netplan/90-multinic0.yaml")
 
 				// 네트워크 설정 실패
 				configurer.On("Configure", mock.Anything, testInterface, mock.MatchedBy(func(name entities.InterfaceName) bool {
@@ -294,9 +366,8 @@ func TestConfigureNetworkUseCase_Execute(t *testing.T) {
 				}
 
 				// 설정 파일 경로 검색
-				configurer.On("GetConfigDir").Return("/etc/netplan")
-				fs.On("ListFiles", "/etc/netplan").Return([]string{}, nil)
-				fs.On("Exists", "/etc/netplan/90-multinic0.yaml").Return(false)
+				configurer.On("FindConfigPath", "multinic0").Return("")
+				configurer.On("ConfigPath", "multinic0").Return("/etc/netplan/90-multinic0.yaml")
 
 				// 네트워크 설정 성공
 				configurer.On("Configure", mock.Anything, testInterface, mock.MatchedBy(func(name entities.InterfaceName) bool {
@@ -358,24 +429,16 @@ func TestConfigureNetworkUseCase_Execute(t *testing.T) {
 					fs.On("Exists", fmt.Sprintf("/sys/class/net/multinic%d", i)).Return(false).Maybe()
 				}
 
-				// 설정 파일 경로 설정
-				configurer.On("GetConfigDir").Return("/etc/netplan")
-
-				// 3. A netplan file on disk with drifted data
-				fileName := "90-multinic0.yaml"
-				fullPath := "/etc/netplan/" + fileName
-				// Note: The address in YAML has the prefix, but the DB Address field does not.
-				driftedYAML := `network:
-  version: 2
-  ethernets:
-    multinic0:
-      match:
-        macaddress: 00:11:22:33:44:55
-      addresses: ["1.1.1.2/24"] # Drifted IP
-      mtu: 1400` // Drifted MTU
-				fs.On("ListFiles", "/etc/netplan").Return([]string{fileName}, nil)
-				fs.On("Exists", fullPath).Return(true)
-				fs.On("ReadFile", fullPath).Return([]byte(driftedYAML), nil)
+				// 드리프트가 있는 설정 파일 (IP, MTU 변경)
+				configPath := "/etc/netplan/90-multinic0.yaml"
+				configurer.On("FindConfigPath", "multinic0").Return(configPath)
+				configurer.On("ReadConfig", configPath).Return(&entities.InterfaceConfig{
+					Name:       "multinic0",
+					MacAddress: "00:11:22:33:44:55",
+					Address:    "1.1.1.2",
+					CIDR:       "1.1.1.0/24",
+					MTU:        1400,
+				}, nil)
 
 				// 4. Expect Configure to be called with the correct DB data to fix the drift
 				configurer.On("Configure", mock.Anything, dbIface, mock.MatchedBy(func(name entities.InterfaceName) bool {
@@ -421,7 +484,7 @@ func TestConfigureNetworkUseCase_Execute(t *testing.T) {
 			mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "nmcli", "-t", "-f", "NAME", "c", "show").Return([]byte(""), nil).Maybe()
 
 			// 네이밍 서비스 생성
-			namingService := services.NewInterfaceNamingService(mockFS, mockExecutor)
+			namingService := services.NewInterfaceNamingService(mockFS, mockExecutor, entities.DefaultNamingScheme(), nil)
 
 			// 로거 생성
 			logger := logrus.New()
@@ -436,9 +499,12 @@ func TestConfigureNetworkUseCase_Execute(t *testing.T) {
 				mockRepo,
 				mockConfigurer,
 				mockRollbacker,
+				mockConfigurer,
+				nil, // 런타임 드리프트 검사 비활성화
 				namingService,
 				mockFS,
 				mockOSDetector,
+				"test-node",
 				logger,
 				5, // maxConcurrentTasks
 			)
@@ -509,7 +575,7 @@ func TestConfigureNetworkUseCase_processInterface(t *testing.T) {
 			mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "nmcli", "-t", "-f", "NAME", "c", "show").Return([]byte(""), nil).Maybe()
 
 			// 네이밍 서비스 생성
-			namingService := services.NewInterfaceNamingService(mockFS, mockExecutor)
+			namingService := services.NewInterfaceNamingService(mockFS, mockExecutor, entities.DefaultNamingScheme(), nil)
 
 			// 로거 생성
 			logger := logrus.New()
@@ -520,9 +586,12 @@ func TestConfigureNetworkUseCase_processInterface(t *testing.T) {
 				mockRepo,
 				mockConfigurer,
 				mockRollbacker,
+				mockConfigurer,
+				nil, // 런타임 드리프트 검사 비활성화
 				namingService,
 				mockFS,
 				mockOSDetector,
+				"test-node",
 				logger,
 				5, // maxConcurrentTasks
 			)
@@ -552,3 +621,363 @@ func TestConfigureNetworkUseCase_processInterface(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigureNetworkUseCase_Execute_RuntimeDrift(t *testing.T) {
+	dbIface := entities.NetworkInterface{
+		ID:               1,
+		MacAddress:       "00:11:22:33:44:55",
+		AttachedNodeName: "test-node",
+		Address:          "1.1.1.1",
+		CIDR:             "1.1.1.0/24",
+		MTU:              1500,
+		Status:           entities.StatusConfigured,
+	}
+	fileConfig := &entities.InterfaceConfig{
+		Name:       "multinic0",
+		MacAddress: "00:11:22:33:44:55",
+		Address:    "1.1.1.1",
+		CIDR:       "1.1.1.0/24",
+		MTU:        1500,
+		Ownership:  &entities.FileOwnership{AgentID: "test-node", InterfaceID: 1, MacAddress: "00:11:22:33:44:55"},
+	}
+
+	tests := []struct {
+		name          string
+		linkState     *entities.LinkState
+		wantProcessed int
+	}{
+		{
+			name:          "커널 상태가 DB와 일치하면 재적용하지 않음",
+			linkState:     &entities.LinkState{Name: "multinic0", MacAddress: "00:11:22:33:44:55", MTU: 1500, AdminUp: true, Addresses: []string{"1.1.1.1/24"}},
+			wantProcessed: 0,
+		},
+		{
+			name:          "ip link set mtu로 변경된 MTU 감지 후 재적용",
+			linkState:     &entities.LinkState{Name: "multinic0", MacAddress: "00:11:22:33:44:55", MTU: 9000, AdminUp: true, Addresses: []string{"1.1.1.1/24"}},
+			wantProcessed: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockNetworkInterfaceRepository)
+			mockConfigurer := new(MockNetworkConfigurer)
+			mockRollbacker := new(MockNetworkRollbacker)
+			mockFS := new(MockFileSystem)
+			mockOSDetector := new(MockOSDetector)
+			mockLinkManager := new(MockLinkManager)
+			mockExecutor := new(MockCommandExecutor)
+
+			mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+			for i := 0; i < 10; i++ {
+				mockFS.On("Exists", fmt.Sprintf("/sys/class/net/multinic%d", i)).Return(false).Maybe()
+			}
+
+			mockOSDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil)
+			mockRepo.On("GetAllNodeInterfaces", mock.Anything, "test-node").Return([]entities.NetworkInterface{dbIface}, nil)
+			mockConfigurer.On("FindConfigPath", "multinic0").Return("/etc/netplan/90-multinic0.yaml")
+			mockConfigurer.On("ReadConfig", "/etc/netplan/90-multinic0.yaml").Return(fileConfig, nil)
+			mockLinkManager.On("GetLinkState", mock.Anything, "multinic0").Return(tt.linkState, nil)
+
+			if tt.wantProcessed > 0 {
+				mockConfigurer.On("Configure", mock.Anything, dbIface, mock.Anything).Return(nil)
+				mockConfigurer.On("Validate", mock.Anything, mock.Anything).Return(nil)
+				mockRepo.On("UpdateInterfaceStatus", mock.Anything, 1, entities.StatusConfigured).Return(nil)
+			}
+
+			logger := logrus.New()
+			logger.SetLevel(logrus.FatalLevel)
+			namingService := services.NewInterfaceNamingService(mockFS, mockExecutor, entities.DefaultNamingScheme(), nil)
+			useCase := NewConfigureNetworkUseCase(mockRepo, mockConfigurer, mockRollbacker, mockConfigurer, mockLinkManager, namingService, mockFS, mockOSDetector, "test-node", logger, 1)
+
+			result, err := useCase.Execute(context.Background(), ConfigureNetworkInput{NodeName: "test-node"})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantProcessed, result.ProcessedCount)
+			mockConfigurer.AssertExpectations(t)
+			mockLinkManager.AssertExpectations(t)
+		})
+	}
+}
+
+func TestConfigureNetworkUseCase_Execute_RuntimeNameDrift(t *testing.T) {
+	dbIface := entities.NetworkInterface{
+		ID:               1,
+		MacAddress:       "00:11:22:33:44:55",
+		AttachedNodeName: "test-node",
+		Address:          "1.1.1.1",
+		CIDR:             "1.1.1.0/24",
+		MTU:              1500,
+		Status:           entities.StatusConfigured,
+	}
+	fileConfig := &entities.InterfaceConfig{
+		Name:       "multinic0",
+		MacAddress: "00:11:22:33:44:55",
+		Address:    "1.1.1.1",
+		CIDR:       "1.1.1.0/24",
+		MTU:        1500,
+		Ownership:  &entities.FileOwnership{AgentID: "test-node", InterfaceID: 1, MacAddress: "00:11:22:33:44:55"},
+	}
+	renamed := entities.LinkState{Name: "ens5", MacAddress: "00:11:22:33:44:55", MTU: 1500, AdminUp: true, Addresses: []string{"1.1.1.1/24"}}
+
+	mockRepo := new(MockNetworkInterfaceRepository)
+	mockConfigurer := new(MockNetworkConfigurer)
+	mockRollbacker := new(MockNetworkRollbacker)
+	mockFS := new(MockFileSystem)
+	mockOSDetector := new(MockOSDetector)
+	mockLinkManager := new(MockLinkManager)
+	mockExecutor := new(MockCommandExecutor)
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+	for i := 0; i < 10; i++ {
+		mockFS.On("Exists", fmt.Sprintf("/sys/class/net/multinic%d", i)).Return(false).Maybe()
+	}
+
+	mockOSDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil)
+	mockRepo.On("GetAllNodeInterfaces", mock.Anything, "test-node").Return([]entities.NetworkInterface{dbIface}, nil)
+	mockConfigurer.On("FindConfigPath", "multinic0").Return("/etc/netplan/90-multinic0.yaml")
+	mockConfigurer.On("ReadConfig", "/etc/netplan/90-multinic0.yaml").Return(fileConfig, nil)
+	mockLinkManager.On("GetLinkState", mock.Anything, "multinic0").Return(nil, domainErrors.NewNotFoundError("link does not exist"))
+	mockLinkManager.On("ListLinks", mock.Anything).Return([]entities.LinkState{renamed}, nil)
+
+	// netplan apply는 활성화된 링크의 이름을 바꾸지 못하므로 재적용 전에 비활성화하고 이름 변경
+	mockLinkManager.On("SetLinkDown", mock.Anything, "ens5").Return(nil).Once()
+	mockLinkManager.On("RenameLink", mock.Anything, "ens5", "multinic0").Return(nil).Once()
+	mockConfigurer.On("Configure", mock.Anything, dbIface, mock.Anything).Return(nil)
+	mockConfigurer.On("Validate", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("UpdateInterfaceStatus", mock.Anything, 1, entities.StatusConfigured).Return(nil)
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+	namingService := services.NewInterfaceNamingService(mockFS, mockExecutor, entities.DefaultNamingScheme(), nil)
+	useCase := NewConfigureNetworkUseCase(mockRepo, mockConfigurer, mockRollbacker, mockConfigurer, mockLinkManager, namingService, mockFS, mockOSDetector, "test-node", logger, 1)
+
+	result, err := useCase.Execute(context.Background(), ConfigureNetworkInput{NodeName: "test-node"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.ProcessedCount)
+	mockConfigurer.AssertExpectations(t)
+	mockLinkManager.AssertExpectations(t)
+}
+
+func TestConfigureNetworkUseCase_Execute_DesiredName(t *testing.T) {
+	storage := entities.NetworkInterface{ID: 1, MacAddress: "fa:16:3e:00:00:01", AttachedNodeName: "test-node", DesiredName: "storage0"}
+	tenantA := entities.NetworkInterface{ID: 2, MacAddress: "fa:16:3e:00:00:02", AttachedNodeName: "test-node", DesiredName: "tenant-a"}
+	tenantB := entities.NetworkInterface{ID: 3, MacAddress: "fa:16:3e:00:00:03", AttachedNodeName: "test-node", DesiredName: "tenant-a"}
+
+	mockRepo := new(MockNetworkInterfaceRepository)
+	mockConfigurer := new(MockNetworkConfigurer)
+	mockRollbacker := new(MockNetworkRollbacker)
+	mockFS := new(MockFileSystem)
+	mockOSDetector := new(MockOSDetector)
+	mockExecutor := new(MockCommandExecutor)
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+	// storage0으로 지정된 NIC은 현재 multinic0 이름으로 설정되어 있음
+	mockFS.On("Exists", "/sys/class/net/multinic0").Return(true)
+	for i := 1; i < 10; i++ {
+		mockFS.On("Exists", fmt.Sprintf("/sys/class/net/multinic%d", i)).Return(false).Maybe()
+	}
+	mockFS.On("Exists", "/sys/class/net/storage0").Return(false)
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "ip", "addr", "show", "multinic0").
+		Return([]byte("link/ether fa:16:3e:00:00:01 brd ff:ff:ff:ff:ff:ff"), nil)
+
+	mockOSDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil)
+	mockRepo.On("GetAllNodeInterfaces", mock.Anything, "test-node").Return([]entities.NetworkInterface{storage, tenantA, tenantB}, nil)
+	mockConfigurer.On("FindConfigPath", "storage0").Return("")
+	mockConfigurer.On("ConfigPath", "storage0").Return("/etc/netplan/99-storage0.yaml")
+	mockConfigurer.On("Configure", mock.Anything, storage, mock.MatchedBy(func(name entities.InterfaceName) bool {
+		return name.String() == "storage0"
+	})).Return(nil)
+	mockConfigurer.On("Validate", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("UpdateInterfaceStatus", mock.Anything, 1, entities.StatusConfigured).Return(nil)
+	// 이름이 바뀐 뒤 같은 MAC을 가리키는 이전 설정 파일 제거
+	mockConfigurer.On("FindConfigPath", "multinic0").Return("/etc/netplan/90-multinic0.yaml")
+	mockRollbacker.On("Rollback", mock.Anything, "multinic0").Return(nil)
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+	namingService := services.NewInterfaceNamingService(mockFS, mockExecutor, entities.DefaultNamingScheme(), nil)
+	useCase := NewConfigureNetworkUseCase(mockRepo, mockConfigurer, mockRollbacker, mockConfigurer, nil, namingService, mockFS, mockOSDetector, "test-node", logger, 1)
+
+	result, err := useCase.Execute(context.Background(), ConfigureNetworkInput{NodeName: "test-node"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.ProcessedCount)
+	assert.Equal(t, 2, result.FailedCount, "같은 이름이 지정된 인터페이스는 모두 실패")
+	require.Len(t, result.Results, 3)
+	assert.Equal(t, entities.InterfaceResult{
+		InterfaceID:   1,
+		InterfaceName: "storage0",
+		MacAddress:    "fa:16:3e:00:00:01",
+		Result:        entities.ResultConfigured,
+		Drift:         "config_missing",
+	}, result.Results[0])
+	for _, failed := range result.Results[1:] {
+		assert.Equal(t, entities.ResultFailed, failed.Result)
+		assert.Contains(t, failed.Error, "assigned to multiple interfaces")
+	}
+	mockConfigurer.AssertExpectations(t)
+	mockRollbacker.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "UpdateInterfaceStatus", mock.Anything, 2, mock.Anything)
+}
+
+func TestConfigureNetworkUseCase_Execute_ConfigOwnership(t *testing.T) {
+	dbIface := entities.NetworkInterface{
+		ID:               1,
+		MacAddress:       "00:11:22:33:44:55",
+		AttachedNodeName: "test-node",
+		MTU:              1500,
+		Status:           entities.StatusConfigured,
+	}
+	owned := &entities.FileOwnership{AgentID: "test-node", InterfaceID: 1, MacAddress: "00:11:22:33:44:55"}
+
+	tests := []struct {
+		name          string
+		fileConfig    *entities.InterfaceConfig
+		wantProcessed int
+		wantFailed    int
+	}{
+		{
+			name:          "소유권 헤더가 있고 내용이 같으면 그대로 둠",
+			fileConfig:    &entities.InterfaceConfig{Name: "multinic0", MacAddress: "00:11:22:33:44:55", MTU: 1500, Ownership: owned},
+			wantProcessed: 0,
+		},
+		{
+			name:          "수동으로 수정된 파일은 다시 생성",
+			fileConfig:    &entities.InterfaceConfig{Name: "multinic0", MacAddress: "00:11:22:33:44:55", MTU: 1500, Ownership: owned, Tampered: true},
+			wantProcessed: 1,
+		},
+		{
+			name:          "헤더 없는 이전 버전 파일은 같은 MAC이면 다시 생성하여 소유권 기록",
+			fileConfig:    &entities.InterfaceConfig{Name: "multinic0", MacAddress: "00:11:22:33:44:55", MTU: 1500},
+			wantProcessed: 1,
+		},
+		{
+			name:       "헤더 없는 다른 MAC의 파일은 덮어쓰지 않음",
+			fileConfig: &entities.InterfaceConfig{Name: "multinic0", MacAddress: "00:11:22:33:44:66", MTU: 1500},
+			wantFailed: 1,
+		},
+		{
+			name: "다른 에이전트의 헤더가 있는 파일은 수정되었어도 덮어쓰지 않음",
+			fileConfig: &entities.InterfaceConfig{
+				Name: "multinic0", MacAddress: "00:11:22:33:44:55", MTU: 1500, Tampered: true,
+				Ownership: &entities.FileOwnership{AgentID: "other-agent", InterfaceID: 1, MacAddress: "00:11:22:33:44:55"},
+			},
+			wantFailed: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockNetworkInterfaceRepository)
+			mockConfigurer := new(MockNetworkConfigurer)
+			mockFS := new(MockFileSystem)
+			mockOSDetector := new(MockOSDetector)
+			mockExecutor := new(MockCommandExecutor)
+
+			mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+			for i := 0; i < 10; i++ {
+				mockFS.On("Exists", fmt.Sprintf("/sys/class/net/multinic%d", i)).Return(false).Maybe()
+			}
+
+			mockOSDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil)
+			mockRepo.On("GetAllNodeInterfaces", mock.Anything, "test-node").Return([]entities.NetworkInterface{dbIface}, nil)
+			mockConfigurer.On("FindConfigPath", "multinic0").Return("/etc/netplan/90-multinic0.yaml")
+			mockConfigurer.On("ReadConfig", "/etc/netplan/90-multinic0.yaml").Return(tt.fileConfig, nil)
+			if tt.wantProcessed > 0 {
+				mockConfigurer.On("Configure", mock.Anything, dbIface, mock.Anything).Return(nil)
+				mockConfigurer.On("Validate", mock.Anything, mock.Anything).Return(nil)
+				mockRepo.On("UpdateInterfaceStatus", mock.Anything, 1, entities.StatusConfigured).Return(nil)
+			}
+
+			logger := logrus.New()
+			logger.SetLevel(logrus.FatalLevel)
+			namingService := services.NewInterfaceNamingService(mockFS, mockExecutor, entities.DefaultNamingScheme(), nil)
+			useCase := NewConfigureNetworkUseCase(mockRepo, mockConfigurer, new(MockNetworkRollbacker), mockConfigurer, nil, namingService, mockFS, mockOSDetector, "test-node", logger, 1)
+
+			result, err := useCase.Execute(context.Background(), ConfigureNetworkInput{NodeName: "test-node"})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantProcessed, result.ProcessedCount)
+			assert.Equal(t, tt.wantFailed, result.FailedCount)
+			mockConfigurer.AssertExpectations(t)
+		})
+	}
+}
+
+func TestConfigureNetworkUseCase_Execute_DryRun(t *testing.T) {
+	created := entities.NetworkInterface{ID: 1, MacAddress: "fa:16:3e:00:00:01", AttachedNodeName: "test-node", Address: "192.168.1.10", CIDR: "192.168.1.0/24", MTU: 1500, Status: entities.StatusPending}
+	updated := entities.NetworkInterface{ID: 2, MacAddress: "fa:16:3e:00:00:02", AttachedNodeName: "test-node", Address: "192.168.2.10", CIDR: "192.168.2.0/24", MTU: 1500, Status: entities.StatusConfigured}
+
+	mockRepo := new(MockNetworkInterfaceRepository)
+	mockConfigurer := new(MockNetworkConfigurer)
+	mockRollbacker := new(MockNetworkRollbacker)
+	mockFS := new(MockFileSystem)
+	mockOSDetector := new(MockOSDetector)
+	mockExecutor := new(MockCommandExecutor)
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+	mockFS.On("Exists", "/sys/class/net/multinic0").Return(true)
+	for i := 1; i < 10; i++ {
+		mockFS.On("Exists", fmt.Sprintf("/sys/class/net/multinic%d", i)).Return(false).Maybe()
+	}
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "ip", "addr", "show", "multinic0").
+		Return([]byte("link/ether fa:16:3e:00:00:02 brd ff:ff:ff:ff:ff:ff"), nil)
+
+	mockOSDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil)
+	mockRepo.On("GetAllNodeInterfaces", mock.Anything, "test-node").Return([]entities.NetworkInterface{created, updated}, nil)
+	mockConfigurer.On("FindConfigPath", "multinic1").Return("")
+	mockConfigurer.On("ConfigPath", "multinic1").Return("/etc/netplan/91-multinic1.yaml")
+	mockConfigurer.On("FindConfigPath", "multinic0").Return("/etc/netplan/90-multinic0.yaml")
+	mockConfigurer.On("ReadConfig", "/etc/netplan/90-multinic0.yaml").Return(&entities.InterfaceConfig{
+		Name:       "multinic0",
+		MacAddress: "fa:16:3e:00:00:02",
+		Address:    "192.168.2.20",
+		CIDR:       "192.168.2.0/24",
+		MTU:        1500,
+		Ownership:  &entities.FileOwnership{AgentID: "test-node", InterfaceID: 2, MacAddress: "fa:16:3e:00:00:02"},
+	}, nil)
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+	namingService := services.NewInterfaceNamingService(mockFS, mockExecutor, entities.DefaultNamingScheme(), nil)
+	useCase := NewConfigureNetworkUseCase(mockRepo, mockConfigurer, mockRollbacker, mockConfigurer, nil, namingService, mockFS, mockOSDetector, "test-node", logger, 2)
+
+	result, err := useCase.Execute(context.Background(), ConfigureNetworkInput{NodeName: "test-node", DryRun: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.ProcessedCount)
+	assert.Equal(t, 0, result.FailedCount)
+	assert.Equal(t, []entities.PlannedChange{
+		{
+			Action:        entities.PlanActionUpdate,
+			InterfaceID:   2,
+			InterfaceName: "multinic0",
+			MacAddress:    "fa:16:3e:00:00:02",
+			ConfigPath:    "/etc/netplan/90-multinic0.yaml",
+			Reason:        services.DriftTypeIPAddress,
+			Diffs:         []entities.FieldDiff{{Field: "address", Current: "192.168.2.20", Desired: "192.168.2.10"}},
+		},
+		{
+			Action:        entities.PlanActionCreate,
+			InterfaceID:   1,
+			InterfaceName: "multinic1",
+			MacAddress:    "fa:16:3e:00:00:01",
+			ConfigPath:    "/etc/netplan/91-multinic1.yaml",
+			Reason:        "config_missing",
+			Diffs: []entities.FieldDiff{
+				{Field: "name", Desired: "multinic1"},
+				{Field: "mac_address", Desired: "fa:16:3e:00:00:01"},
+				{Field: "address", Desired: "192.168.1.10"},
+				{Field: "cidr", Desired: "192.168.1.0/24"},
+				{Field: "mtu", Desired: "1500"},
+			},
+		},
+	}, result.Plan)
+
+	// 설정 적용, 롤백, DB 상태 갱신 없음
+	mockConfigurer.AssertNotCalled(t, "Configure", mock.Anything, mock.Anything, mock.Anything)
+	mockRollbacker.AssertNotCalled(t, "Rollback", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "UpdateInterfaceStatus", mock.Anything, mock.Anything, mock.Anything)
+}
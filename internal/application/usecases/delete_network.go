@@ -3,9 +3,12 @@ package usecases
 import (
 	"context"
 	"fmt"
+	"multinic-agent/internal/domain/constants"
+	"multinic-agent/internal/domain/entities"
 	"multinic-agent/internal/domain/interfaces"
 	"multinic-agent/internal/domain/services"
 	"multinic-agent/internal/infrastructure/metrics"
+	"path/filepath"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -15,13 +18,35 @@ import (
 // DeleteNetworkInput은 네트워크 삭제 유스케이스의 입력 데이터입니다
 type DeleteNetworkInput struct {
 	NodeName string
+	DryRun   bool          // 설정 파일 삭제, 명령 실행, DB 상태 갱신 없이 삭제 계획만 계산
+	Snapshot *NodeSnapshot // 사이클 스냅샷 (nil이면 DB 행, 설정 파일, 링크 목록을 직접 조회)
 }
 
 // DeleteNetworkOutput은 네트워크 삭제 유스케이스의 출력 데이터입니다
 type DeleteNetworkOutput struct {
-	DeletedInterfaces []string
-	TotalDeleted      int
-	Errors            []error
+	DeletedInterfaces       []string
+	TotalDeleted            int
+	QuarantinedInterfaces   []string // 이번 사이클에 격리된 고아 인터페이스
+	RestoredInterfaces      []string // MAC이 DB에 다시 나타나 격리에서 복원된 인터페이스
+	DeferredInterfaces      []string // 확인 사이클/유예 시간 대기 중인 고아 인터페이스
+	BlockedInterfaces       []string // 안전 규칙에 의해 삭제가 차단된 고아 인터페이스
+	BlockReason             string
+	ReleasedLinks           []string                     // 설정 파일 없이 남아 있다가 해제된 링크
+	RepairPendingInterfaces []string                     // 설정 파일 없이 링크만 남아 재적용 대상으로 표시된 인터페이스
+	LinkCleanups            []services.LinkCleanupResult // 삭제된 인터페이스의 커널 링크 정리 결과
+	UnownedFiles            []string                     // 이름은 관리 대상 형식이지만 소유권 헤더가 없어 건드리지 않은 설정 파일
+	TamperedFiles           []string                     // 소유권 헤더의 해시와 내용이 달라 수동 수정으로 보고된 설정 파일
+	ForeignFiles            []string                     // 다른 에이전트의 소유권 헤더가 있어 건드리지 않은 설정 파일
+	Plan                    []entities.PlannedChange     // dry-run일 때 적용했을 삭제/복원 계획
+	Errors                  []error
+}
+
+// orphanCandidate는 DB에 MAC이 없는 삭제 후보 인터페이스입니다
+type orphanCandidate struct {
+	fileName      string
+	interfaceName string
+	macAddress    string
+	liveOnly      bool // 설정 파일 없이 커널에만 존재하는 링크
 }
 
 // DeleteNetworkUseCase는 고아 인터페이스를 감지하고 삭제하는 유스케이스입니다
@@ -31,6 +56,12 @@ type DeleteNetworkUseCase struct {
 	namingService *services.InterfaceNamingService
 	repository    interfaces.NetworkInterfaceRepository
 	fileSystem    interfaces.FileSystem
+	linkManager   interfaces.LinkManager
+	linkCleaner   *services.LinkCleaner
+	guard         *services.OrphanDeletionGuard
+	quarantine    *services.QuarantineStore // nil이면 격리 없이 즉시 삭제
+	agentID       string                    // 소유권 헤더의 에이전트 ID가 다른 파일은 삭제하지 않음 (빈 값이면 비교하지 않음)
+	agentAliases  []string                  // agentID 외에 이 에이전트의 것으로 인정하는 소유권 헤더의 에이전트 ID
 	logger        *logrus.Logger
 }
 
@@ -41,6 +72,11 @@ func NewDeleteNetworkUseCase(
 	namingService *services.InterfaceNamingService,
 	repository interfaces.NetworkInterfaceRepository,
 	fileSystem interfaces.FileSystem,
+	linkManager interfaces.LinkManager,
+	linkCleaner *services.LinkCleaner,
+	guard *services.OrphanDeletionGuard,
+	quarantine *services.QuarantineStore,
+	agentID string,
 	logger *logrus.Logger,
 ) *DeleteNetworkUseCase {
 	return &DeleteNetworkUseCase{
@@ -49,145 +85,743 @@ func NewDeleteNetworkUseCase(
 		namingService: namingService,
 		repository:    repository,
 		fileSystem:    fileSystem,
+		linkManager:   linkManager,
+		linkCleaner:   linkCleaner,
+		guard:         guard,
+		quarantine:    quarantine,
+		agentID:       agentID,
 		logger:        logger,
 	}
 }
 
+// SetAgentAliases는 agentID 외에 이 에이전트의 것으로 인정할 소유권 헤더의 에이전트 ID를 설정합니다 (실행 전에 호출)
+func (uc *DeleteNetworkUseCase) SetAgentAliases(aliases []string) {
+	uc.agentAliases = aliases
+}
+
 // Execute는 고아 인터페이스 삭제 유스케이스를 실행합니다
 func (uc *DeleteNetworkUseCase) Execute(ctx context.Context, input DeleteNetworkInput) (*DeleteNetworkOutput, error) {
 	// 삭제 프로세스 시작 로그는 실제 삭제가 있을 때만 출력
 
-	osType, err := uc.osDetector.DetectOS()
+	osType, err := uc.detectOS(input)
 	if err != nil {
 		return nil, fmt.Errorf("failed to detect OS: %w", err)
 	}
 
-	switch osType {
-	case interfaces.OSTypeUbuntu:
-		return uc.executeNetplanCleanup(ctx, input)
-	case interfaces.OSTypeRHEL:
-		return uc.executeIfcfgCleanup(ctx, input)
-	default:
+	configDir := configDirForOS(osType)
+	if configDir == "" {
 		uc.logger.WithField("os_type", osType).Warn("Skipping orphaned interface cleanup for unsupported OS type")
 		return &DeleteNetworkOutput{}, nil
 	}
+
+	// 현재 노드의 모든 활성 인터페이스 가져오기 (DB에서)
+	activeInterfaces, err := uc.getActiveInterfaces(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := newDeleteNetworkOutput()
+
+	// 격리된 설정 파일의 복원/영구 삭제 처리
+	var quarantinedMACs map[string]bool
+	if uc.quarantine != nil {
+		quarantinedMACs = uc.processQuarantine(ctx, input, configDir, activeInterfaces, output)
+	}
+
+	if osType == interfaces.OSTypeUbuntu {
+		err = uc.executeNetplanCleanup(ctx, input, activeInterfaces, quarantinedMACs, output)
+	} else {
+		err = uc.executeIfcfgCleanup(ctx, input, activeInterfaces, quarantinedMACs, output)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// detectOS는 스냅샷의 OS 타입을 반환하고, 스냅샷이 없으면 직접 감지합니다
+func (uc *DeleteNetworkUseCase) detectOS(input DeleteNetworkInput) (interfaces.OSType, error) {
+	if input.Snapshot != nil {
+		return input.Snapshot.OSType, nil
+	}
+	return uc.osDetector.DetectOS()
+}
+
+// getActiveInterfaces는 현재 노드에 할당된 모든 인터페이스를 스냅샷 또는 DB에서 조회합니다
+func (uc *DeleteNetworkUseCase) getActiveInterfaces(ctx context.Context, input DeleteNetworkInput) ([]entities.NetworkInterface, error) {
+	if input.Snapshot != nil {
+		return input.Snapshot.Interfaces, nil
+	}
+
+	activeInterfaces, err := uc.repository.GetAllNodeInterfaces(ctx, input.NodeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active interfaces: %w", err)
+	}
+
+	return activeInterfaces, nil
+}
+
+// listConfigFiles는 설정 디렉토리의 파일 목록을 스냅샷 또는 파일 시스템에서 조회합니다
+func (uc *DeleteNetworkUseCase) listConfigFiles(input DeleteNetworkInput, configDir string) ([]string, error) {
+	if input.Snapshot != nil && input.Snapshot.ConfigDir == configDir {
+		return input.Snapshot.ConfigFiles, input.Snapshot.configFilesErr
+	}
+	return uc.namingService.ListNetplanFiles(configDir)
+}
+
+// listLinks는 커널 링크 목록을 스냅샷 또는 링크 관리자에서 조회합니다
+func (uc *DeleteNetworkUseCase) listLinks(ctx context.Context, input DeleteNetworkInput) ([]entities.LinkState, error) {
+	if input.Snapshot != nil {
+		return input.Snapshot.Links, input.Snapshot.linksErr
+	}
+	return uc.linkManager.ListLinks(ctx)
 }
 
 // executeNetplanCleanup은 Netplan (Ubuntu) 환경의 고아 인터페이스를 정리합니다
-func (uc *DeleteNetworkUseCase) executeNetplanCleanup(ctx context.Context, input DeleteNetworkInput) (*DeleteNetworkOutput, error) {
-	output := &DeleteNetworkOutput{
-		DeletedInterfaces: []string{},
-		Errors:            []error{},
+func (uc *DeleteNetworkUseCase) executeNetplanCleanup(ctx context.Context, input DeleteNetworkInput, activeInterfaces []entities.NetworkInterface, quarantinedMACs map[string]bool, output *DeleteNetworkOutput) error {
+	netplanDir := constants.NetplanConfigDir
+
+	orphans, managedCount, err := uc.findOrphanedNetplanFiles(input, activeInterfaces, output)
+	if err != nil {
+		return fmt.Errorf("failed to find orphaned netplan files: %w", err)
 	}
 
-	orphanedFiles, err := uc.findOrphanedNetplanFiles(ctx)
+	linkOrphans := uc.findOrphanedLinks(ctx, input, netplanDir, activeInterfaces, quarantinedMACs, output)
+	uc.cleanupOrphans(ctx, input, netplanDir, orphans, linkOrphans, managedCount, uc.deleteNetplanFile, output)
+	return nil
+}
+
+// executeIfcfgCleanup은 ifcfg (RHEL) 환경의 고아 인터페이스를 정리합니다
+func (uc *DeleteNetworkUseCase) executeIfcfgCleanup(ctx context.Context, input DeleteNetworkInput, activeInterfaces []entities.NetworkInterface, quarantinedMACs map[string]bool, output *DeleteNetworkOutput) error {
+	// ifcfg 파일 디렉토리
+	ifcfgDir := constants.RHELNetworkScriptsDir
+
+	// 디렉토리의 파일 목록 가져오기
+	files, err := uc.listConfigFiles(input, ifcfgDir)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find orphaned netplan files: %w", err)
+		return fmt.Errorf("failed to list ifcfg files: %w", err)
 	}
 
-	if len(orphanedFiles) == 0 {
-		// 삭제할 파일이 없으면 조용히 종료
-		return output, nil
+	// 고아 파일 찾기
+	orphans, managedCount := uc.findOrphanedIfcfgFiles(activeInterfaces, files, ifcfgDir, output)
+
+	linkOrphans := uc.findOrphanedLinks(ctx, input, ifcfgDir, activeInterfaces, quarantinedMACs, output)
+	uc.cleanupOrphans(ctx, input, ifcfgDir, orphans, linkOrphans, managedCount, uc.deleteIfcfgFile, output)
+	return nil
+}
+
+// cleanupOrphans는 설정 파일 고아와 설정 파일 없는 링크 고아를 함께 안전 규칙으로 평가하고 정리합니다
+// 안전 규칙의 관찰 기록이 사이클마다 한 번만 갱신되도록 모든 후보를 한 번에 평가합니다
+func (uc *DeleteNetworkUseCase) cleanupOrphans(
+	ctx context.Context,
+	input DeleteNetworkInput,
+	configDir string,
+	fileOrphans []orphanCandidate,
+	linkOrphans []orphanCandidate,
+	managedCount int,
+	deleteFn func(ctx context.Context, fileName, interfaceName string) error,
+	output *DeleteNetworkOutput,
+) {
+	orphans := append(fileOrphans, linkOrphans...)
+	managedCount += len(linkOrphans)
+
+	if len(orphans) == 0 {
+		// 삭제할 대상이 없으면 조용히 종료
+		uc.logger.Debug("No orphaned interfaces to delete")
+		uc.applySafetyGuard(orphans, managedCount, input.DryRun)
+		return
 	}
 
 	uc.logger.WithFields(logrus.Fields{
 		"node_name":      input.NodeName,
-		"orphaned_files": len(orphanedFiles),
-	}).Info("Orphaned netplan files detected - starting cleanup process")
+		"orphaned_files": len(fileOrphans),
+		"orphaned_links": len(linkOrphans),
+	}).Info("Orphaned interfaces detected - starting cleanup process")
+
+	uc.deleteOrphans(ctx, input, configDir, orphans, managedCount, deleteFn, output)
+}
+
+// findOrphanedLinks는 설정 파일 없이 커널에만 존재하는 multinic 링크를 찾습니다
+// MAC이 DB에 있으면 설정 파일을 다시 생성하도록 재적용 대상으로 표시하고, 없으면 해제 후보로 반환합니다
+// 격리 중인 MAC의 링크는 복원을 위해 그대로 둡니다
+func (uc *DeleteNetworkUseCase) findOrphanedLinks(ctx context.Context, input DeleteNetworkInput, configDir string, activeInterfaces []entities.NetworkInterface, quarantinedMACs map[string]bool, output *DeleteNetworkOutput) []orphanCandidate {
+	links, err := uc.listLinks(ctx, input)
+	if err != nil {
+		uc.logger.WithError(err).Warn("Failed to list live links for orphan detection")
+		return nil
+	}
+
+	files, err := uc.listConfigFiles(input, configDir)
+	if err != nil {
+		uc.logger.WithError(err).Warn("Failed to list config files for live link orphan detection")
+		return nil
+	}
+
+	configuredNames := make(map[string]bool, len(files))
+	for _, fileName := range files {
+		if name := uc.interfaceNameFromConfigFile(fileName); name != "" {
+			configuredNames[name] = true
+		}
+	}
+
+	activeByMAC := make(map[string]entities.NetworkInterface, len(activeInterfaces))
+	for _, iface := range activeInterfaces {
+		activeByMAC[strings.ToLower(iface.MacAddress)] = iface
+	}
+
+	var orphans []orphanCandidate
+	for _, link := range links {
+		if !uc.namingService.IsManagedName(link.Name) || configuredNames[link.Name] {
+			continue
+		}
 
-	for _, fileName := range orphanedFiles {
-		interfaceName := uc.extractInterfaceNameFromFile(fileName)
-		if err := uc.deleteNetplanFile(ctx, fileName, interfaceName); err != nil {
+		mac := strings.ToLower(link.MacAddress)
+		if quarantinedMACs[mac] {
+			continue
+		}
+
+		fields := logrus.Fields{
+			"interface_name": link.Name,
+			"mac_address":    link.MacAddress,
+		}
+
+		if dbIface, ok := activeByMAC[mac]; ok {
+			output.RepairPendingInterfaces = append(output.RepairPendingInterfaces, link.Name)
+			uc.logger.WithFields(fields).Warn("Live link has no config file - marking interface for re-apply")
+			// dry-run에서는 설정 유스케이스가 설정 파일 생성을 계획하므로 상태만 갱신하지 않음
+			if dbIface.Status == entities.StatusConfigured && !input.DryRun {
+				if err := uc.repository.UpdateInterfaceStatus(ctx, dbIface.ID, entities.StatusPending); err != nil {
+					output.Errors = append(output.Errors, fmt.Errorf("failed to mark interface %s for re-apply: %w", link.Name, err))
+				}
+			}
+			continue
+		}
+
+		uc.logger.WithFields(fields).Info("Found orphaned live link without config file")
+		orphans = append(orphans, orphanCandidate{
+			interfaceName: link.Name,
+			macAddress:    link.MacAddress,
+			liveOnly:      true,
+		})
+	}
+
+	return orphans
+}
+
+// interfaceNameFromConfigFile은 multinic 설정 파일 이름에서 인터페이스 이름을 추출합니다
+func (uc *DeleteNetworkUseCase) interfaceNameFromConfigFile(fileName string) string {
+	if uc.isMultinicIfcfgFile(fileName) {
+		return uc.extractInterfaceNameFromIfcfgFile(fileName)
+	}
+	if uc.isMultinicNetplanFile(fileName) {
+		return uc.extractInterfaceNameFromFile(fileName)
+	}
+	return ""
+}
+
+// ownedConfigMAC은 에이전트 소유가 증명된 설정 파일의 MAC 주소를 소유권 헤더에서 읽습니다
+// 헤더가 없는 파일은 수동으로 만든 파일일 수 있으므로, 다른 에이전트의 헤더가 있는 파일은 다른 배포가 관리하므로 관리 대상에서 제외하고,
+// 헤더의 해시와 내용이 다른 파일은 수동 수정으로 보고합니다 (owned는 true지만 intact는 false)
+// reportUnowned가 false이면 헤더 없는 파일을 UnownedFiles에 기록하지 않습니다
+func (uc *DeleteNetworkUseCase) ownedConfigMAC(filePath, fileName string, reportUnowned bool, output *DeleteNetworkOutput) (macAddress string, owned, intact bool) {
+	content, err := uc.fileSystem.ReadFile(filePath)
+	if err != nil {
+		uc.logger.WithError(err).WithField("file_name", fileName).Warn("Failed to read config file for ownership check")
+		return "", false, false
+	}
+
+	ownership, intact := entities.ParseFileOwnership(content)
+	if ownership == nil {
+		uc.logger.WithField("file_name", fileName).Debug("Config file has no ownership header - not managed by the agent")
+		if reportUnowned {
+			output.UnownedFiles = append(output.UnownedFiles, fileName)
+		}
+		return "", false, false
+	}
+
+	if services.IsForeignOwnership(ownership, uc.agentID, uc.agentAliases...) {
+		uc.logger.WithFields(logrus.Fields{
+			"file_name":    fileName,
+			"agent_id":     ownership.AgentID,
+			"our_agent_id": uc.agentID,
+			"mac_address":  ownership.MacAddress,
+		}).Warn("Config file is owned by another agent - not managed by this agent")
+		output.ForeignFiles = append(output.ForeignFiles, fileName)
+		return "", false, false
+	}
+
+	if !intact {
+		uc.logger.WithFields(logrus.Fields{
+			"file_name":    fileName,
+			"agent_id":     ownership.AgentID,
+			"interface_id": ownership.InterfaceID,
+			"mac_address":  ownership.MacAddress,
+		}).Warn("Managed config file was modified manually - content hash mismatch")
+		output.TamperedFiles = append(output.TamperedFiles, fileName)
+		metrics.RecordDrift(services.DriftTypeTampered)
+	}
+
+	return ownership.MacAddress, true, intact
+}
+
+// newDeleteNetworkOutput은 빈 삭제 결과를 생성합니다
+func newDeleteNetworkOutput() *DeleteNetworkOutput {
+	return &DeleteNetworkOutput{
+		DeletedInterfaces:       []string{},
+		QuarantinedInterfaces:   []string{},
+		RestoredInterfaces:      []string{},
+		DeferredInterfaces:      []string{},
+		BlockedInterfaces:       []string{},
+		ReleasedLinks:           []string{},
+		RepairPendingInterfaces: []string{},
+		LinkCleanups:            []services.LinkCleanupResult{},
+		UnownedFiles:            []string{},
+		TamperedFiles:           []string{},
+		ForeignFiles:            []string{},
+		Plan:                    []entities.PlannedChange{},
+		Errors:                  []error{},
+	}
+}
+
+// deleteOrphans는 안전 규칙을 통과한 고아 후보만 삭제(또는 격리)합니다
+func (uc *DeleteNetworkUseCase) deleteOrphans(
+	ctx context.Context,
+	input DeleteNetworkInput,
+	configDir string,
+	orphans []orphanCandidate,
+	managedCount int,
+	deleteFn func(ctx context.Context, fileName, interfaceName string) error,
+	output *DeleteNetworkOutput,
+) {
+	decision := uc.applySafetyGuard(orphans, managedCount, input.DryRun)
+	approved := make(map[string]bool, len(decision.Approved))
+	for _, mac := range decision.Approved {
+		approved[strings.ToLower(mac)] = true
+	}
+	deferred := make(map[string]bool, len(decision.Deferred))
+	for _, mac := range decision.Deferred {
+		deferred[strings.ToLower(mac)] = true
+	}
+
+	if decision.IsBlocked() {
+		output.BlockReason = uc.guard.DescribeBlock(decision, managedCount)
+		for _, orphan := range orphans {
+			output.BlockedInterfaces = append(output.BlockedInterfaces, orphan.interfaceName)
+		}
+		uc.logger.WithFields(logrus.Fields{
+			"blocked_interfaces": output.BlockedInterfaces,
+			"managed_count":      managedCount,
+			"reason":             output.BlockReason,
+		}).Error("Orphan deletion blocked by safety rules - manual verification required")
+		return
+	}
+
+	for _, orphan := range orphans {
+		mac := strings.ToLower(orphan.macAddress)
+		if deferred[mac] {
+			output.DeferredInterfaces = append(output.DeferredInterfaces, orphan.interfaceName)
 			uc.logger.WithFields(logrus.Fields{
-				"file_name":      fileName,
-				"interface_name": interfaceName,
+				"file_name":      orphan.fileName,
+				"interface_name": orphan.interfaceName,
+				"mac_address":    orphan.macAddress,
+			}).Info("Orphan deletion deferred until confirmation cycles/grace period pass")
+			continue
+		}
+		if !approved[mac] {
+			continue
+		}
+
+		if input.DryRun {
+			uc.planOrphanDeletion(configDir, orphan, output)
+			continue
+		}
+
+		if orphan.liveOnly {
+			uc.releaseLink(ctx, orphan, output)
+			continue
+		}
+
+		if uc.quarantine != nil {
+			if err := uc.quarantineOrphan(ctx, configDir, orphan); err != nil {
+				uc.logger.WithFields(logrus.Fields{
+					"file_name":      orphan.fileName,
+					"interface_name": orphan.interfaceName,
+					"error":          err.Error(),
+				}).Error("Failed to quarantine orphaned interface configuration")
+				output.Errors = append(output.Errors, fmt.Errorf("failed to quarantine config file %s: %w", orphan.fileName, err))
+			} else {
+				uc.guard.Forget(orphan.macAddress)
+				output.QuarantinedInterfaces = append(output.QuarantinedInterfaces, orphan.interfaceName)
+			}
+			continue
+		}
+
+		if err := deleteFn(ctx, orphan.fileName, orphan.interfaceName); err != nil {
+			uc.logger.WithFields(logrus.Fields{
+				"file_name":      orphan.fileName,
+				"interface_name": orphan.interfaceName,
 				"error":          err.Error(),
-			}).Error("Failed to delete netplan file")
-			output.Errors = append(output.Errors, fmt.Errorf("failed to delete netplan file %s: %w", fileName, err))
+			}).Error("Failed to delete orphaned interface configuration")
+			output.Errors = append(output.Errors, fmt.Errorf("failed to delete config file %s: %w", orphan.fileName, err))
 		} else {
-			output.DeletedInterfaces = append(output.DeletedInterfaces, interfaceName)
+			uc.guard.Forget(orphan.macAddress)
+			output.DeletedInterfaces = append(output.DeletedInterfaces, orphan.interfaceName)
 			output.TotalDeleted++
 			metrics.OrphanedInterfacesDeleted.Inc()
+			uc.cleanupLink(ctx, orphan.interfaceName, output)
+			uc.releaseName(ctx, orphan.interfaceName, orphan.macAddress, output)
 		}
 	}
-	return output, nil
 }
 
-// executeIfcfgCleanup은 ifcfg (RHEL) 환경의 고아 인터페이스를 정리합니다
-func (uc *DeleteNetworkUseCase) executeIfcfgCleanup(ctx context.Context, input DeleteNetworkInput) (*DeleteNetworkOutput, error) {
-	output := &DeleteNetworkOutput{
-		DeletedInterfaces: []string{},
-		Errors:            []error{},
+// planOrphanDeletion은 안전 규칙을 통과한 고아 후보의 삭제(또는 격리)를 계획에 추가합니다
+func (uc *DeleteNetworkUseCase) planOrphanDeletion(configDir string, orphan orphanCandidate, output *DeleteNetworkOutput) {
+	change := entities.PlannedChange{
+		Action:        entities.PlanActionDelete,
+		InterfaceName: orphan.interfaceName,
+		MacAddress:    orphan.macAddress,
+		Reason:        planReasonOrphaned,
+	}
+	switch {
+	case orphan.liveOnly:
+		change.Reason = planReasonOrphanedLink
+	case uc.quarantine != nil:
+		change.ConfigPath = filepath.Join(configDir, orphan.fileName)
+		change.Reason = planReasonQuarantine
+	default:
+		change.ConfigPath = filepath.Join(configDir, orphan.fileName)
 	}
+	output.Plan = append(output.Plan, change)
+}
 
-	// ifcfg 파일 디렉토리
-	ifcfgDir := "/etc/sysconfig/network-scripts"
+// releaseName은 삭제된 인터페이스의 이름 할당을 해제합니다
+// 해제된 이름은 재사용 대기 시간이 지난 뒤에만 다른 MAC에 할당됩니다
+func (uc *DeleteNetworkUseCase) releaseName(ctx context.Context, interfaceName, macAddress string, output *DeleteNetworkOutput) {
+	if err := uc.namingService.ReleaseName(ctx, macAddress); err != nil {
+		uc.logger.WithFields(logrus.Fields{
+			"interface_name": interfaceName,
+			"mac_address":    macAddress,
+		}).WithError(err).Warn("Failed to release interface name allocation")
+		output.Errors = append(output.Errors, fmt.Errorf("failed to release name %s: %w", interfaceName, err))
+	}
+}
 
-	// 디렉토리의 파일 목록 가져오기
-	files, err := uc.namingService.ListNetplanFiles(ifcfgDir)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list ifcfg files: %w", err)
+// cleanupLink는 설정 파일이 삭제된 인터페이스의 커널 링크 상태를 정리하고 결과를 기록합니다
+func (uc *DeleteNetworkUseCase) cleanupLink(ctx context.Context, interfaceName string, output *DeleteNetworkOutput) {
+	if interfaceName == "" {
+		return
 	}
 
-	// 고아 파일 찾기
-	orphanedFiles, err := uc.findOrphanedIfcfgFiles(ctx, files, ifcfgDir)
+	result := uc.linkCleaner.Cleanup(ctx, interfaceName)
+	uc.recordLinkCleanup(result, output)
+}
+
+// releaseLink는 설정 파일 없이 남은 고아 링크를 정리하고 원래 커널 이름으로 되돌려 이름을 해제합니다
+func (uc *DeleteNetworkUseCase) releaseLink(ctx context.Context, orphan orphanCandidate, output *DeleteNetworkOutput) {
+	result := uc.linkCleaner.Release(ctx, orphan.interfaceName)
+	if uc.recordLinkCleanup(result, output) {
+		uc.guard.Forget(orphan.macAddress)
+		output.ReleasedLinks = append(output.ReleasedLinks, orphan.interfaceName)
+		uc.releaseName(ctx, orphan.interfaceName, orphan.macAddress, output)
+	}
+}
+
+// recordLinkCleanup은 링크 정리 결과를 출력, 메트릭, 로그에 기록하고 성공 여부를 반환합니다
+func (uc *DeleteNetworkUseCase) recordLinkCleanup(result services.LinkCleanupResult, output *DeleteNetworkOutput) bool {
+	interfaceName := result.InterfaceName
+	output.LinkCleanups = append(output.LinkCleanups, result)
+
+	fields := logrus.Fields{
+		"interface_name": interfaceName,
+		"final_name":     result.FinalName,
+		"link_present":   result.LinkPresent,
+		"flushed":        result.Flushed,
+		"renamed":        result.Renamed,
+		"verified":       result.Verified,
+	}
+
+	switch {
+	case result.Error != "" || !result.Verified:
+		metrics.RecordLinkCleanup("failed")
+		uc.logger.WithFields(fields).WithField("error", result.Error).Error("Failed to clean up live link state of deleted interface")
+		output.Errors = append(output.Errors, fmt.Errorf("failed to clean up link %s: %s", interfaceName, result.Error))
+		return false
+	case !result.LinkPresent:
+		metrics.RecordLinkCleanup("absent")
+		uc.logger.WithFields(fields).Debug("Link of deleted interface no longer exists - nothing to clean up")
+	default:
+		metrics.RecordLinkCleanup("cleaned")
+		uc.logger.WithFields(fields).Info("Live link state of deleted interface cleaned up")
+	}
+	return true
+}
+
+// applySafetyGuard는 고아 후보를 안전 규칙으로 평가하고 결과를 메트릭에 기록합니다
+// dry-run이면 사이클 간 관찰 기록과 메트릭을 갱신하지 않습니다
+func (uc *DeleteNetworkUseCase) applySafetyGuard(orphans []orphanCandidate, managedCount int, dryRun bool) services.DeletionDecision {
+	macs := make([]string, 0, len(orphans))
+	for _, orphan := range orphans {
+		macs = append(macs, orphan.macAddress)
+	}
+
+	if dryRun {
+		return uc.guard.Preview(macs, managedCount)
+	}
+
+	decision := uc.guard.Evaluate(macs, managedCount)
+	if decision.IsBlocked() {
+		metrics.RecordOrphanDeletionBlocked(decision.BlockReason, len(decision.Blocked))
+	}
+	metrics.SetOrphanDeletionsPending(float64(len(decision.Deferred) + len(decision.Blocked)))
+
+	return decision
+}
+
+// quarantineOrphan은 고아 인터페이스를 비활성화하고 설정 파일을 격리 디렉토리로 옮깁니다
+func (uc *DeleteNetworkUseCase) quarantineOrphan(ctx context.Context, configDir string, orphan orphanCandidate) error {
+	if orphan.interfaceName == "" {
+		return fmt.Errorf("cannot determine interface name from %s", orphan.fileName)
+	}
+
+	entry, err := uc.quarantine.Quarantine(filepath.Join(configDir, orphan.fileName))
 	if err != nil {
-		return nil, fmt.Errorf("failed to find orphaned ifcfg files: %w", err)
+		return err
 	}
 
-	if len(orphanedFiles) == 0 {
-		uc.logger.Debug("No orphaned ifcfg files to delete")
-		return output, nil
+	// 링크가 이미 사라졌을 수 있으므로 비활성화 실패는 경고만 남김
+	linkDown := true
+	if err := uc.linkManager.SetLinkDown(ctx, orphan.interfaceName); err != nil {
+		linkDown = false
+		uc.logger.WithError(err).WithField("interface_name", orphan.interfaceName).Warn("Failed to bring down quarantined interface")
 	}
 
+	// 원본 설정 파일 삭제 및 네트워크 설정 재적용
+	if err := uc.rollbacker.Rollback(ctx, orphan.interfaceName); err != nil {
+		uc.abortQuarantine(ctx, entry, orphan.interfaceName, linkDown)
+		return fmt.Errorf("failed to remove original config after quarantine: %w", err)
+	}
+
+	metrics.QuarantineOperations.WithLabelValues("quarantined").Inc()
 	uc.logger.WithFields(logrus.Fields{
-		"node_name":      input.NodeName,
-		"orphaned_files": orphanedFiles,
-	}).Info("Orphaned ifcfg files detected - starting cleanup process")
+		"file_name":       orphan.fileName,
+		"interface_name":  orphan.interfaceName,
+		"mac_address":     orphan.macAddress,
+		"quarantine_path": entry.Path,
+	}).Info("Orphaned interface quarantined")
+
+	return nil
+}
+
+// abortQuarantine은 원본 설정 파일을 삭제하지 못한 격리를 되돌립니다
+// 원본이 그대로 남아 있으므로 격리 항목을 제거하고 비활성화한 링크를 다시 활성화합니다
+func (uc *DeleteNetworkUseCase) abortQuarantine(ctx context.Context, entry services.QuarantineEntry, interfaceName string, linkDown bool) {
+	if err := uc.quarantine.Discard(entry); err != nil {
+		uc.logger.WithError(err).WithField("quarantine_path", entry.Path).Error("Failed to discard incomplete quarantine entry")
+	}
 
-	// 고아 파일 삭제
-	for _, fileName := range orphanedFiles {
-		interfaceName := uc.extractInterfaceNameFromIfcfgFile(fileName)
-		if interfaceName == "" {
+	if linkDown {
+		if err := uc.linkManager.SetLinkUp(ctx, interfaceName); err != nil {
+			uc.logger.WithError(err).WithField("interface_name", interfaceName).Warn("Failed to bring up interface after aborted quarantine")
+		}
+	}
+}
+
+// expiredQuarantineEntry는 보존 기간이 지나 영구 삭제 후보가 된 격리 항목입니다
+type expiredQuarantineEntry struct {
+	entry         services.QuarantineEntry
+	interfaceName string
+	macAddress    string
+	fields        logrus.Fields
+}
+
+// processQuarantine은 격리 항목을 검사하여 MAC이 DB에 다시 나타나면 복원하고, 보존 기간이 지나면 영구 삭제합니다
+// 계속 격리 중이거나 이번 사이클에 복원된 항목의 MAC 주소 집합을 반환합니다
+// 복원된 설정 파일은 사이클 스냅샷의 파일 목록에 없으므로 설정 파일 없는 링크로 잘못 판단되지 않도록 함께 반환합니다
+// 보존 기간이 지난 항목은 고아 삭제 안전 규칙의 한 사이클 최대 삭제 수를 넘으면 모두 보류합니다
+func (uc *DeleteNetworkUseCase) processQuarantine(ctx context.Context, input DeleteNetworkInput, configDir string, activeInterfaces []entities.NetworkInterface, output *DeleteNetworkOutput) map[string]bool {
+	held := make(map[string]bool)
+
+	entries, err := uc.quarantine.List()
+	if err != nil {
+		uc.logger.WithError(err).Warn("Failed to list quarantined interfaces")
+		output.Errors = append(output.Errors, err)
+		return held
+	}
+
+	activeByMAC := make(map[string]entities.NetworkInterface, len(activeInterfaces))
+	for _, iface := range activeInterfaces {
+		activeByMAC[strings.ToLower(iface.MacAddress)] = iface
+	}
+
+	remaining := 0
+	var expired []expiredQuarantineEntry
+	for _, entry := range entries {
+		interfaceName, macAddress, err := uc.parseQuarantineEntry(entry)
+		if err != nil {
+			uc.logger.WithError(err).WithField("quarantine_path", entry.Path).Warn("Failed to parse quarantined config file")
+			remaining++
 			continue
 		}
 
-		if err := uc.rollbacker.Rollback(ctx, interfaceName); err != nil {
-			uc.logger.WithFields(logrus.Fields{
-				"file_name":      fileName,
-				"interface_name": interfaceName,
-				"error":          err,
-			}).Error("Failed to delete ifcfg file")
-			output.Errors = append(output.Errors, fmt.Errorf("failed to delete ifcfg file %s: %w", fileName, err))
-		} else {
-			output.DeletedInterfaces = append(output.DeletedInterfaces, interfaceName)
-			output.TotalDeleted++
-			metrics.OrphanedInterfacesDeleted.Inc()
+		fields := logrus.Fields{
+			"file_name":       entry.FileName,
+			"interface_name":  interfaceName,
+			"mac_address":     macAddress,
+			"quarantined_at":  entry.QuarantinedAt,
+			"quarantine_path": entry.Path,
+		}
+
+		if input.DryRun {
+			uc.planQuarantineEntry(configDir, entry, interfaceName, macAddress, activeByMAC, held, output)
+			remaining++
+			continue
+		}
+
+		if dbIface, ok := activeByMAC[strings.ToLower(macAddress)]; ok {
+			if err := uc.restoreQuarantined(ctx, configDir, entry, interfaceName, dbIface); err != nil {
+				uc.logger.WithFields(fields).WithError(err).Error("Failed to restore quarantined interface")
+				output.Errors = append(output.Errors, fmt.Errorf("failed to restore quarantined interface %s: %w", interfaceName, err))
+				remaining++
+				continue
+			}
+			held[strings.ToLower(macAddress)] = true
+			output.RestoredInterfaces = append(output.RestoredInterfaces, interfaceName)
+			metrics.QuarantineOperations.WithLabelValues("restored").Inc()
+			uc.logger.WithFields(fields).Info("MAC address reappeared in database - quarantined interface restored")
+			continue
 		}
+
+		if !uc.quarantine.IsExpired(entry) {
+			held[strings.ToLower(macAddress)] = true
+			remaining++
+			continue
+		}
+
+		expired = append(expired, expiredQuarantineEntry{entry: entry, interfaceName: interfaceName, macAddress: macAddress, fields: fields})
 	}
-	return output, nil
+
+	remaining += uc.purgeExpired(ctx, expired, held, output)
+
+	metrics.SetQuarantinedInterfaces(float64(remaining))
+	return held
 }
 
-// findOrphanedNetplanFiles는 DB에 없는 MAC 주소의 netplan 파일을 찾습니다
-func (uc *DeleteNetworkUseCase) findOrphanedNetplanFiles(ctx context.Context) ([]string, error) {
-	var orphanedFiles []string
+// purgeExpired는 보존 기간이 지난 격리 항목을 안전 규칙으로 평가한 뒤 영구 삭제하고, 격리 상태로 남은 항목 수를 반환합니다
+func (uc *DeleteNetworkUseCase) purgeExpired(ctx context.Context, expired []expiredQuarantineEntry, held map[string]bool, output *DeleteNetworkOutput) int {
+	macs := make([]string, 0, len(expired))
+	for _, item := range expired {
+		macs = append(macs, item.macAddress)
+	}
 
-	// /etc/netplan 디렉토리에서 multinic 관련 파일 스캔
-	netplanDir := "/etc/netplan"
-	files, err := uc.namingService.ListNetplanFiles(netplanDir)
-	if err != nil {
-		return nil, fmt.Errorf("failed to scan netplan directory: %w", err)
+	decision := uc.guard.EvaluatePurge(macs)
+	if decision.IsBlocked() {
+		metrics.RecordOrphanDeletionBlocked(decision.BlockReason, len(decision.Blocked))
+		reason := uc.guard.DescribeBlock(decision, 0)
+		if output.BlockReason == "" {
+			output.BlockReason = reason
+		}
+		for _, item := range expired {
+			held[strings.ToLower(item.macAddress)] = true
+			output.BlockedInterfaces = append(output.BlockedInterfaces, item.interfaceName)
+		}
+		uc.logger.WithFields(logrus.Fields{
+			"blocked_interfaces": len(expired),
+			"reason":             reason,
+		}).Error("Quarantine purge blocked by safety rules - manual verification required")
+		return len(expired)
 	}
 
-	// 현재 노드의 모든 활성 인터페이스 가져오기 (DB에서)
-	hostname, err := uc.namingService.GetHostname()
+	remaining := 0
+	for _, item := range expired {
+		if err := uc.quarantine.Purge(item.entry); err != nil {
+			uc.logger.WithFields(item.fields).WithError(err).Error("Failed to purge quarantined interface")
+			output.Errors = append(output.Errors, fmt.Errorf("failed to purge quarantined interface %s: %w", item.interfaceName, err))
+			held[strings.ToLower(item.macAddress)] = true
+			remaining++
+			continue
+		}
+
+		output.DeletedInterfaces = append(output.DeletedInterfaces, item.interfaceName)
+		output.TotalDeleted++
+		metrics.OrphanedInterfacesDeleted.Inc()
+		metrics.QuarantineOperations.WithLabelValues("purged").Inc()
+		uc.logger.WithFields(item.fields).Info("Quarantine retention expired - orphaned interface deleted")
+		uc.cleanupLink(ctx, item.interfaceName, output)
+		uc.releaseName(ctx, item.interfaceName, item.macAddress, output)
+	}
+	return remaining
+}
+
+// planQuarantineEntry는 격리 항목의 복원 또는 보존 기간 만료에 따른 영구 삭제를 계획에 추가합니다
+func (uc *DeleteNetworkUseCase) planQuarantineEntry(configDir string, entry services.QuarantineEntry, interfaceName, macAddress string, activeByMAC map[string]entities.NetworkInterface, held map[string]bool, output *DeleteNetworkOutput) {
+	change := entities.PlannedChange{
+		InterfaceName: interfaceName,
+		MacAddress:    macAddress,
+	}
+
+	switch dbIface, ok := activeByMAC[strings.ToLower(macAddress)]; {
+	case ok:
+		// 복원될 링크가 설정 파일 없는 링크로 중복 계획되지 않도록 격리 중으로 취급
+		held[strings.ToLower(macAddress)] = true
+		change.Action = entities.PlanActionCreate
+		change.InterfaceID = dbIface.ID
+		change.ConfigPath = filepath.Join(configDir, entry.FileName)
+		change.Reason = planReasonQuarantineRestore
+	case uc.quarantine.IsExpired(entry):
+		change.Action = entities.PlanActionDelete
+		change.ConfigPath = entry.Path
+		change.Reason = planReasonQuarantineExpired
+	default:
+		held[strings.ToLower(macAddress)] = true
+		return
+	}
+	output.Plan = append(output.Plan, change)
+}
+
+// restoreQuarantined는 격리된 설정 파일을 되돌리고 링크를 다시 활성화합니다
+// 파일을 되돌린 경우 다음 사이클에서 재적용/검증되도록 DB 상태를 대기 상태로 변경합니다
+func (uc *DeleteNetworkUseCase) restoreQuarantined(ctx context.Context, configDir string, entry services.QuarantineEntry, interfaceName string, dbIface entities.NetworkInterface) error {
+	restored, err := uc.quarantine.Restore(entry, configDir)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get hostname: %w", err)
+		return err
+	}
+
+	if err := uc.linkManager.SetLinkUp(ctx, interfaceName); err != nil {
+		uc.logger.WithError(err).WithField("interface_name", interfaceName).Warn("Failed to bring up restored interface")
 	}
 
-	activeInterfaces, err := uc.repository.GetAllNodeInterfaces(ctx, hostname)
+	if restored {
+		if err := uc.repository.UpdateInterfaceStatus(ctx, dbIface.ID, entities.StatusPending); err != nil {
+			return fmt.Errorf("failed to mark restored interface for re-apply: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// parseQuarantineEntry는 격리된 파일에서 인터페이스 이름과 MAC 주소를 추출합니다
+func (uc *DeleteNetworkUseCase) parseQuarantineEntry(entry services.QuarantineEntry) (string, string, error) {
+	// 격리 디렉토리에는 에이전트가 관리하던 파일만 있으므로 파일 형식만 구분
+	if strings.HasPrefix(entry.FileName, "ifcfg-") {
+		macAddress, err := uc.getMACAddressFromIfcfgFile(entry.Path)
+		return uc.extractInterfaceNameFromIfcfgFile(entry.FileName), macAddress, err
+	}
+
+	macAddress, err := uc.getMACAddressFromNetplanFile(entry.Path)
+	return uc.extractInterfaceNameFromFile(entry.FileName), macAddress, err
+}
+
+// findOrphanedNetplanFiles는 DB에 없는 MAC 주소의 netplan 파일과 관리 중인 multinic 파일 수를 반환합니다
+func (uc *DeleteNetworkUseCase) findOrphanedNetplanFiles(input DeleteNetworkInput, activeInterfaces []entities.NetworkInterface, output *DeleteNetworkOutput) ([]orphanCandidate, int, error) {
+	var orphans []orphanCandidate
+	managedCount := 0
+
+	// /etc/netplan 디렉토리에서 multinic 관련 파일 스캔
+	netplanDir := constants.NetplanConfigDir
+	files, err := uc.listConfigFiles(input, netplanDir)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get active interfaces: %w", err)
+		return nil, 0, fmt.Errorf("failed to scan netplan directory: %w", err)
 	}
 
 	// MAC 주소 맵 생성 (빠른 조회를 위해)
@@ -197,19 +831,22 @@ func (uc *DeleteNetworkUseCase) findOrphanedNetplanFiles(ctx context.Context) ([
 	}
 
 	for _, fileName := range files {
-		// multinic 파일만 처리 (9*-multinic*.yaml 패턴)
-		if !uc.isMultinicNetplanFile(fileName) {
+		// multinic 파일만 처리 (9*-<인터페이스 이름>.yaml 패턴)
+		schemeName := uc.isMultinicNetplanFile(fileName)
+		if !schemeName && !uc.isCustomNetplanFile(fileName) {
 			continue
 		}
 
-		// 파일의 MAC 주소 확인
+		// 소유권 헤더로 에이전트가 생성한 파일인지 확인하고 MAC 주소를 읽음
+		// 헤더 없는 파일은 이름 규칙으로 만든 이름일 때만 보고 (배포판 파일을 매 사이클 보고하지 않도록)
 		filePath := fmt.Sprintf("%s/%s", netplanDir, fileName)
-		macAddress, err := uc.getMACAddressFromNetplanFile(filePath)
-		if err != nil {
-			uc.logger.WithFields(logrus.Fields{
-				"file_name": fileName,
-				"error":     err.Error(),
-			}).Warn("Failed to extract MAC address from netplan file")
+		macAddress, owned, intact := uc.ownedConfigMAC(filePath, fileName, schemeName, output)
+		if !owned {
+			continue
+		}
+		managedCount++
+		if !intact {
+			// 수동으로 수정된 파일은 삭제하지 않음
 			continue
 		}
 
@@ -221,44 +858,51 @@ func (uc *DeleteNetworkUseCase) findOrphanedNetplanFiles(ctx context.Context) ([
 				"interface_name": interfaceName,
 				"mac_address":    macAddress,
 			}).Info("Found orphaned netplan file")
-			orphanedFiles = append(orphanedFiles, fileName)
+			orphans = append(orphans, orphanCandidate{
+				fileName:      fileName,
+				interfaceName: interfaceName,
+				macAddress:    macAddress,
+			})
 		}
 	}
 
-	return orphanedFiles, nil
+	return orphans, managedCount, nil
 }
 
-// isMultinicNetplanFile은 파일이 multinic 관련 netplan 파일인지 확인합니다
+// isMultinicNetplanFile은 파일 이름이 이름 규칙으로 만든 인터페이스의 netplan 파일 형식인지 확인합니다
+// 실제 소유 여부는 파일의 소유권 헤더로 확인합니다
 func (uc *DeleteNetworkUseCase) isMultinicNetplanFile(fileName string) bool {
-	// 9*-multinic*.yaml 패턴 매칭
-	return strings.Contains(fileName, "multinic") && strings.HasSuffix(fileName, ".yaml") &&
-		strings.HasPrefix(fileName, "9") && strings.Contains(fileName, "-")
+	return isNetplanFileName(fileName) && uc.namingService.IsManagedName(uc.extractInterfaceNameFromFile(fileName))
+}
+
+// isCustomNetplanFile은 파일 이름이 DB에서 지정한 이름 규칙 밖의 인터페이스 netplan 파일 형식일 수 있는지 확인합니다
+// 배포판 파일도 이 형식에 맞으므로 소유권 헤더가 있을 때만 관리 대상으로 봅니다
+func (uc *DeleteNetworkUseCase) isCustomNetplanFile(fileName string) bool {
+	return isNetplanFileName(fileName) && isCustomInterfaceName(uc.extractInterfaceNameFromFile(fileName))
+}
+
+// isNetplanFileName은 파일 이름이 9*-<인터페이스 이름>.yaml 패턴인지 확인합니다
+func isNetplanFileName(fileName string) bool {
+	return strings.HasSuffix(fileName, ".yaml") && strings.HasPrefix(fileName, "9") && strings.Contains(fileName, "-")
+}
+
+// isCustomInterfaceName은 이름이 DB에서 지정할 수 있는 유효한 인터페이스 이름인지 확인합니다
+func isCustomInterfaceName(name string) bool {
+	_, err := entities.NewCustomInterfaceName(name)
+	return err == nil
 }
 
 // extractInterfaceNameFromFile은 파일명에서 인터페이스 이름을 추출합니다
 func (uc *DeleteNetworkUseCase) extractInterfaceNameFromFile(fileName string) string {
 	// 예: "91-multinic1.yaml" -> "multinic1" 또는 "multinic1.yaml" -> "multinic1"
-	if !strings.Contains(fileName, "multinic") {
-		return ""
-	}
-
-	// .yaml 확장자 제거
-	nameWithoutExt := strings.TrimSuffix(fileName, ".yaml")
-
-	// "-"로 분할된 경우 (예: "91-multinic1")
-	parts := strings.Split(nameWithoutExt, "-")
-	for _, part := range parts {
-		if strings.HasPrefix(part, "multinic") {
-			return part
-		}
-	}
+	name := strings.TrimSuffix(fileName, ".yaml")
 
-	// 분할되지 않은 경우 전체가 multinic로 시작하는지 확인 (예: "multinic1")
-	if strings.HasPrefix(nameWithoutExt, "multinic") {
-		return nameWithoutExt
+	// 우선순위 접두사 제거 (예: "91-", "99-")
+	if priority, rest, found := strings.Cut(name, "-"); found && priority != "" && strings.Trim(priority, "0123456789") == "" {
+		name = rest
 	}
 
-	return ""
+	return name
 }
 
 // deleteNetplanFile은 고아 netplan 파일을 삭제하고 netplan을 재적용합니다
@@ -281,10 +925,27 @@ func (uc *DeleteNetworkUseCase) deleteNetplanFile(ctx context.Context, fileName,
 	return nil
 }
 
-// isMultinicIfcfgFile은 파일이 multinic 관련 ifcfg 파일인지 확인합니다
+// deleteIfcfgFile은 고아 ifcfg 파일을 삭제하고 NetworkManager를 재시작합니다
+func (uc *DeleteNetworkUseCase) deleteIfcfgFile(ctx context.Context, fileName, interfaceName string) error {
+	if interfaceName == "" {
+		return fmt.Errorf("cannot determine interface name from %s", fileName)
+	}
+
+	// Rollback 호출로 파일 삭제 및 NetworkManager 재시작
+	return uc.rollbacker.Rollback(ctx, interfaceName)
+}
+
+// isMultinicIfcfgFile은 파일 이름이 이름 규칙으로 만든 인터페이스의 ifcfg 파일 형식인지 확인합니다
+// 실제 소유 여부는 파일의 소유권 헤더로 확인합니다
 func (uc *DeleteNetworkUseCase) isMultinicIfcfgFile(fileName string) bool {
-	// ifcfg-multinic* 패턴 매칭
-	return strings.HasPrefix(fileName, "ifcfg-multinic")
+	// ifcfg-<인터페이스 이름> 패턴 매칭
+	return strings.HasPrefix(fileName, "ifcfg-") && uc.namingService.IsManagedName(strings.TrimPrefix(fileName, "ifcfg-"))
+}
+
+// isCustomIfcfgFile은 파일 이름이 DB에서 지정한 이름 규칙 밖의 인터페이스 ifcfg 파일 형식일 수 있는지 확인합니다
+// ifcfg-eth0 같은 배포판 파일도 이 형식에 맞으므로 소유권 헤더가 있을 때만 관리 대상으로 봅니다
+func (uc *DeleteNetworkUseCase) isCustomIfcfgFile(fileName string) bool {
+	return strings.HasPrefix(fileName, "ifcfg-") && isCustomInterfaceName(strings.TrimPrefix(fileName, "ifcfg-"))
 }
 
 // extractInterfaceNameFromIfcfgFile은 ifcfg 파일명에서 인터페이스 이름을 추출합니다
@@ -318,20 +979,10 @@ func (uc *DeleteNetworkUseCase) getMACAddressFromIfcfgFile(filePath string) (str
 	return "", fmt.Errorf("HWADDR not found in ifcfg file")
 }
 
-// findOrphanedIfcfgFiles는 DB에 없는 MAC 주소의 ifcfg 파일을 찾습니다
-func (uc *DeleteNetworkUseCase) findOrphanedIfcfgFiles(ctx context.Context, files []string, ifcfgDir string) ([]string, error) {
-	var orphanedFiles []string
-
-	// 현재 노드의 모든 활성 인터페이스 가져오기 (DB에서)
-	hostname, err := uc.namingService.GetHostname()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get hostname: %w", err)
-	}
-
-	activeInterfaces, err := uc.repository.GetAllNodeInterfaces(ctx, hostname)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get active interfaces: %w", err)
-	}
+// findOrphanedIfcfgFiles는 DB에 없는 MAC 주소의 ifcfg 파일과 관리 중인 multinic 파일 수를 반환합니다
+func (uc *DeleteNetworkUseCase) findOrphanedIfcfgFiles(activeInterfaces []entities.NetworkInterface, files []string, ifcfgDir string, output *DeleteNetworkOutput) ([]orphanCandidate, int) {
+	var orphans []orphanCandidate
+	managedCount := 0
 
 	// MAC 주소 맵 생성 (빠른 조회를 위해)
 	activeMACAddresses := make(map[string]bool)
@@ -343,25 +994,27 @@ func (uc *DeleteNetworkUseCase) findOrphanedIfcfgFiles(ctx context.Context, file
 	}
 
 	uc.logger.WithFields(logrus.Fields{
-		"node_name":       hostname,
 		"active_macs":     activeMACList,
 		"interface_count": len(activeInterfaces),
 	}).Debug("Active MAC addresses from database for orphan detection")
 
 	for _, fileName := range files {
-		// ifcfg-multinic* 파일만 처리
-		if !uc.isMultinicIfcfgFile(fileName) {
+		// ifcfg-<인터페이스 이름> 파일만 처리
+		schemeName := uc.isMultinicIfcfgFile(fileName)
+		if !schemeName && !uc.isCustomIfcfgFile(fileName) {
 			continue
 		}
 
-		// 파일의 MAC 주소 확인
+		// 소유권 헤더로 에이전트가 생성한 파일인지 확인하고 MAC 주소를 읽음
+		// 헤더 없는 파일은 이름 규칙으로 만든 이름일 때만 보고 (ifcfg-eth0 같은 배포판 파일을 매 사이클 보고하지 않도록)
 		filePath := fmt.Sprintf("%s/%s", ifcfgDir, fileName)
-		macAddress, err := uc.getMACAddressFromIfcfgFile(filePath)
-		if err != nil {
-			uc.logger.WithFields(logrus.Fields{
-				"file_name": fileName,
-				"error":     err.Error(),
-			}).Warn("Failed to extract MAC address from ifcfg file")
+		macAddress, owned, intact := uc.ownedConfigMAC(filePath, fileName, schemeName, output)
+		if !owned {
+			continue
+		}
+		managedCount++
+		if !intact {
+			// 수동으로 수정된 파일은 삭제하지 않음
 			continue
 		}
 
@@ -379,7 +1032,11 @@ func (uc *DeleteNetworkUseCase) findOrphanedIfcfgFiles(ctx context.Context, file
 				"interface_name": interfaceName,
 				"mac_address":    macAddress,
 			}).Info("Found orphaned ifcfg file")
-			orphanedFiles = append(orphanedFiles, fileName)
+			orphans = append(orphans, orphanCandidate{
+				fileName:      fileName,
+				interfaceName: interfaceName,
+				macAddress:    macAddress,
+			})
 		} else {
 			// DB에 있는 MAC 주소 - 정상 파일이므로 로그만 출력
 			uc.logger.WithFields(logrus.Fields{
@@ -389,7 +1046,7 @@ func (uc *DeleteNetworkUseCase) findOrphanedIfcfgFiles(ctx context.Context, file
 		}
 	}
 
-	return orphanedFiles, nil
+	return orphans, managedCount
 }
 
 // getMACAddressFromNetplanFile은 netplan 파일에서 MAC 주소를 추출합니다
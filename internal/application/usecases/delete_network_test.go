@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"multinic-agent/internal/domain/entities"
+	domainErrors "multinic-agent/internal/domain/errors"
 	"multinic-agent/internal/domain/interfaces"
 	"multinic-agent/internal/domain/services"
 
@@ -17,6 +19,33 @@ import (
 
 // All mock types are declared in configure_network_test.go
 
+// fixedClock은 테스트용 고정 시계입니다
+type fixedClock struct {
+	now time.Time
+}
+
+func (c *fixedClock) Now() time.Time {
+	return c.now
+}
+
+// newTestOrphanGuard는 고정 시계를 사용하는 테스트용 고아 삭제 가드를 생성합니다
+func newTestOrphanGuard(policy services.DeletionSafetyPolicy) *services.OrphanDeletionGuard {
+	return services.NewOrphanDeletionGuard(policy, &fixedClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)})
+}
+
+// ownedContent는 에이전트가 생성한 것처럼 소유권 헤더를 붙인 설정 파일 내용을 반환합니다
+func ownedContent(id int, mac, body string) []byte {
+	return entities.NewFileOwnership("test-node", entities.NetworkInterface{ID: id, MacAddress: mac}, []byte(body)).Render([]byte(body))
+}
+
+// newAbsentLinkManager는 삭제 대상 링크가 이미 커널에 없는 상태의 목 LinkManager를 생성합니다
+func newAbsentLinkManager() *MockLinkManager {
+	linkManager := new(MockLinkManager)
+	linkManager.On("GetLinkState", mock.Anything, mock.Anything).Return(nil, domainErrors.NewNotFoundError("link does not exist")).Maybe()
+	linkManager.On("ListLinks", mock.Anything).Return([]entities.LinkState{}, nil).Maybe()
+	return linkManager
+}
+
 func TestDeleteNetworkUseCase_Execute_NetplanFileCleanup_Success(t *testing.T) {
 	// Arrange
 	mockOSDetector := new(MockOSDetector)
@@ -31,17 +60,15 @@ func TestDeleteNetworkUseCase_Execute_NetplanFileCleanup_Success(t *testing.T) {
 	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
 	// RHEL nmcli 명령어 mocks (naming service에서 사용)
 	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "nmcli", "-t", "-f", "NAME", "c", "show").Return([]byte(""), nil).Maybe()
-	namingService := services.NewInterfaceNamingService(mockFileSystem, mockExecutor)
-	useCase := NewDeleteNetworkUseCase(mockOSDetector, mockRollbacker, namingService, mockRepository, mockFileSystem, logger)
+	namingService := services.NewInterfaceNamingService(mockFileSystem, mockExecutor, entities.DefaultNamingScheme(), nil)
+	linkManager := newAbsentLinkManager()
+	useCase := NewDeleteNetworkUseCase(mockOSDetector, mockRollbacker, namingService, mockRepository, mockFileSystem, linkManager, services.NewLinkCleaner(linkManager, false), newTestOrphanGuard(services.DeletionSafetyPolicy{}), nil, "test-node", logger)
 
 	ctx := context.Background()
 	input := DeleteNetworkInput{NodeName: "test-node"}
 
 	mockOSDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil)
 
-	// Setup hostname
-	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "hostname", mock.Anything).Return([]byte("test-node\n"), nil)
-
 	// Setup netplan files
 	netplanFiles := []string{"91-multinic1.yaml", "92-multinic2.yaml"}
 	mockFileSystem.On("ListFiles", "/etc/netplan").Return(netplanFiles, nil)
@@ -60,7 +87,7 @@ func TestDeleteNetworkUseCase_Execute_NetplanFileCleanup_Success(t *testing.T) {
         macaddress: fa:16:3e:11:11:11
       dhcp4: false
   version: 2`
-	mockFileSystem.On("ReadFile", "/etc/netplan/91-multinic1.yaml").Return([]byte(multinic1Content), nil)
+	mockFileSystem.On("ReadFile", "/etc/netplan/91-multinic1.yaml").Return(ownedContent(1, "fa:16:3e:11:11:11", multinic1Content), nil)
 
 	// Setup netplan file content for multinic2 (orphan)
 	multinic2Content := `network:
@@ -70,7 +97,7 @@ func TestDeleteNetworkUseCase_Execute_NetplanFileCleanup_Success(t *testing.T) {
         macaddress: fa:16:3e:22:22:22
       dhcp4: false
   version: 2`
-	mockFileSystem.On("ReadFile", "/etc/netplan/92-multinic2.yaml").Return([]byte(multinic2Content), nil)
+	mockFileSystem.On("ReadFile", "/etc/netplan/92-multinic2.yaml").Return(ownedContent(2, "fa:16:3e:22:22:22", multinic2Content), nil)
 
 	mockRollbacker.On("Rollback", ctx, "multinic2").Return(nil)
 
@@ -102,17 +129,15 @@ func TestDeleteNetworkUseCase_Execute_NmcliCleanup_Success(t *testing.T) {
 	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
 	// RHEL nmcli 명령어 mocks (naming service에서 사용)
 	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "nmcli", "-t", "-f", "NAME", "c", "show").Return([]byte(""), nil).Maybe()
-	namingService := services.NewInterfaceNamingService(mockFileSystem, mockExecutor)
-	useCase := NewDeleteNetworkUseCase(mockOSDetector, mockRollbacker, namingService, mockRepository, mockFileSystem, logger)
+	namingService := services.NewInterfaceNamingService(mockFileSystem, mockExecutor, entities.DefaultNamingScheme(), nil)
+	linkManager := newAbsentLinkManager()
+	useCase := NewDeleteNetworkUseCase(mockOSDetector, mockRollbacker, namingService, mockRepository, mockFileSystem, linkManager, services.NewLinkCleaner(linkManager, false), newTestOrphanGuard(services.DeletionSafetyPolicy{}), nil, "test-node", logger)
 
 	ctx := context.Background()
 	input := DeleteNetworkInput{NodeName: "rhel-node"}
 
 	mockOSDetector.On("DetectOS").Return(interfaces.OSTypeRHEL, nil)
 
-	// Setup hostname
-	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "hostname").Return([]byte("rhel-node\n"), nil)
-
 	// Mock GetActiveInterfaces for RHEL nmcli cleanup
 	activeInterfaces := []entities.NetworkInterface{
 		{
@@ -150,3 +175,530 @@ func TestDeleteNetworkUseCase_Execute_NmcliCleanup_Success(t *testing.T) {
 	mockExecutor.AssertExpectations(t)
 	mockRollbacker.AssertExpectations(t)
 }
+
+func TestDeleteNetworkUseCase_Execute_SafetyGuardBlocksMassDeletion(t *testing.T) {
+	// Arrange
+	mockOSDetector := new(MockOSDetector)
+	mockRollbacker := new(MockNetworkRollbacker)
+	mockFileSystem := new(MockFileSystem)
+	mockExecutor := new(MockCommandExecutor)
+	mockRepository := new(MockNetworkInterfaceRepository)
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+	namingService := services.NewInterfaceNamingService(mockFileSystem, mockExecutor, entities.DefaultNamingScheme(), nil)
+	guard := newTestOrphanGuard(services.DeletionSafetyPolicy{MaxDeletionPercent: 50})
+	linkManager := newAbsentLinkManager()
+	useCase := NewDeleteNetworkUseCase(mockOSDetector, mockRollbacker, namingService, mockRepository, mockFileSystem, linkManager, services.NewLinkCleaner(linkManager, false), guard, nil, "test-node", logger)
+
+	ctx := context.Background()
+	mockOSDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil)
+
+	// DB 조회 결과가 비어 있음 - 모든 파일이 고아 후보가 됨
+	mockFileSystem.On("ListFiles", "/etc/netplan").Return([]string{"91-multinic1.yaml", "92-multinic2.yaml"}, nil)
+	mockRepository.On("GetAllNodeInterfaces", ctx, "test-node").Return([]entities.NetworkInterface{}, nil)
+	for i, name := range []string{"91-multinic1.yaml", "92-multinic2.yaml"} {
+		content := fmt.Sprintf("network:\n  ethernets:\n    multinic%d:\n      match:\n        macaddress: fa:16:3e:00:00:0%d\n  version: 2", i+1, i+1)
+		mockFileSystem.On("ReadFile", "/etc/netplan/"+name).Return(ownedContent(i+1, fmt.Sprintf("fa:16:3e:00:00:0%d", i+1), content), nil)
+	}
+
+	// Act
+	output, err := useCase.Execute(ctx, DeleteNetworkInput{NodeName: "test-node"})
+
+	// Assert - 삭제가 차단되고 Rollback은 호출되지 않음
+	assert.NoError(t, err)
+	assert.Equal(t, 0, output.TotalDeleted)
+	assert.ElementsMatch(t, []string{"multinic1", "multinic2"}, output.BlockedInterfaces)
+	assert.NotEmpty(t, output.BlockReason)
+	mockRollbacker.AssertNotCalled(t, "Rollback", mock.Anything, mock.Anything)
+}
+
+func TestDeleteNetworkUseCase_Execute_QuarantineOrphan(t *testing.T) {
+	// Arrange
+	mockOSDetector := new(MockOSDetector)
+	mockRollbacker := new(MockNetworkRollbacker)
+	mockFileSystem := new(MockFileSystem)
+	mockExecutor := new(MockCommandExecutor)
+	mockRepository := new(MockNetworkInterfaceRepository)
+	mockLinkManager := new(MockLinkManager)
+	mockLinkManager.On("ListLinks", mock.Anything).Return([]entities.LinkState{}, nil).Maybe()
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+	namingService := services.NewInterfaceNamingService(mockFileSystem, mockExecutor, entities.DefaultNamingScheme(), nil)
+	clock := &fixedClock{now: time.Unix(1700000000, 0)}
+	quarantine := services.NewQuarantineStore(mockFileSystem, clock, "/var/lib/multinic/quarantine", time.Hour)
+	guard := services.NewOrphanDeletionGuard(services.DeletionSafetyPolicy{}, clock)
+	useCase := NewDeleteNetworkUseCase(mockOSDetector, mockRollbacker, namingService, mockRepository, mockFileSystem, mockLinkManager, services.NewLinkCleaner(mockLinkManager, false), guard, quarantine, "test-node", logger)
+
+	ctx := context.Background()
+	mockOSDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil)
+	mockRepository.On("GetAllNodeInterfaces", ctx, "test-node").Return([]entities.NetworkInterface{}, nil)
+
+	// 격리 디렉토리는 비어 있음
+	mockFileSystem.On("Exists", "/var/lib/multinic/quarantine").Return(false)
+
+	orphanContent := ownedContent(2, "fa:16:3e:22:22:22", "network:\n  ethernets:\n    multinic2:\n      match:\n        macaddress: fa:16:3e:22:22:22\n  version: 2")
+	mockFileSystem.On("ListFiles", "/etc/netplan").Return([]string{"92-multinic2.yaml"}, nil)
+	mockFileSystem.On("ReadFile", "/etc/netplan/92-multinic2.yaml").Return(orphanContent, nil)
+
+	// 격리: 파일 복사 → 링크 비활성화 → 원본 삭제 및 재적용
+	mockFileSystem.On("MkdirAll", "/var/lib/multinic/quarantine", mock.Anything).Return(nil)
+	mockFileSystem.On("WriteFile", "/var/lib/multinic/quarantine/1700000000_92-multinic2.yaml", orphanContent, mock.Anything).Return(nil)
+	mockLinkManager.On("SetLinkDown", ctx, "multinic2").Return(nil)
+	mockRollbacker.On("Rollback", ctx, "multinic2").Return(nil)
+
+	// Act
+	output, err := useCase.Execute(ctx, DeleteNetworkInput{NodeName: "test-node"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, output.TotalDeleted)
+	assert.Equal(t, []string{"multinic2"}, output.QuarantinedInterfaces)
+	mockFileSystem.AssertExpectations(t)
+	mockLinkManager.AssertExpectations(t)
+	mockRollbacker.AssertExpectations(t)
+}
+
+func TestDeleteNetworkUseCase_Execute_QuarantineRestoreAndPurge(t *testing.T) {
+	// Arrange
+	mockOSDetector := new(MockOSDetector)
+	mockRollbacker := new(MockNetworkRollbacker)
+	mockFileSystem := new(MockFileSystem)
+	mockExecutor := new(MockCommandExecutor)
+	mockRepository := new(MockNetworkInterfaceRepository)
+	mockLinkManager := new(MockLinkManager)
+	mockLinkManager.On("ListLinks", mock.Anything).Return([]entities.LinkState{}, nil).Maybe()
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+	namingService := services.NewInterfaceNamingService(mockFileSystem, mockExecutor, entities.DefaultNamingScheme(), nil)
+	clock := &fixedClock{now: time.Unix(1700007200, 0)}
+	quarantine := services.NewQuarantineStore(mockFileSystem, clock, "/q", time.Hour)
+	guard := services.NewOrphanDeletionGuard(services.DeletionSafetyPolicy{}, clock)
+	useCase := NewDeleteNetworkUseCase(mockOSDetector, mockRollbacker, namingService, mockRepository, mockFileSystem, mockLinkManager, services.NewLinkCleaner(mockLinkManager, false), guard, quarantine, "test-node", logger)
+
+	ctx := context.Background()
+	mockOSDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil)
+
+	// multinic1의 MAC은 DB에 다시 나타남, multinic2는 보존 기간(1시간) 경과
+	mockRepository.On("GetAllNodeInterfaces", ctx, "test-node").Return([]entities.NetworkInterface{
+		{ID: 7, MacAddress: "fa:16:3e:11:11:11", AttachedNodeName: "test-node"},
+	}, nil)
+	mockFileSystem.On("Exists", "/q").Return(true)
+	mockFileSystem.On("ListFiles", "/q").Return([]string{"1700000000_91-multinic1.yaml", "1700000000_92-multinic2.yaml"}, nil)
+
+	restoreContent := []byte("network:\n  ethernets:\n    multinic1:\n      match:\n        macaddress: fa:16:3e:11:11:11\n  version: 2")
+	purgeContent := []byte("network:\n  ethernets:\n    multinic2:\n      match:\n        macaddress: fa:16:3e:22:22:22\n  version: 2")
+	mockFileSystem.On("ReadFile", "/q/1700000000_91-multinic1.yaml").Return(restoreContent, nil)
+	mockFileSystem.On("ReadFile", "/q/1700000000_92-multinic2.yaml").Return(purgeContent, nil)
+
+	// 복원: 원래 위치로 파일 복사, 링크 활성화, 재적용을 위해 대기 상태로 변경
+	mockFileSystem.On("Exists", "/etc/netplan/91-multinic1.yaml").Return(false)
+	mockFileSystem.On("WriteFile", "/etc/netplan/91-multinic1.yaml", restoreContent, mock.Anything).Return(nil)
+	mockFileSystem.On("Remove", "/q/1700000000_91-multinic1.yaml").Return(nil)
+	mockLinkManager.On("SetLinkUp", ctx, "multinic1").Return(nil)
+	mockRepository.On("UpdateInterfaceStatus", ctx, 7, entities.StatusPending).Return(nil)
+
+	// 영구 삭제 후 커널에 남은 링크 상태 정리
+	mockFileSystem.On("Remove", "/q/1700000000_92-multinic2.yaml").Return(nil)
+	mockLinkManager.On("GetLinkState", ctx, "multinic2").Return(&entities.LinkState{Name: "multinic2", Addresses: []string{"10.0.0.5/24"}}, nil).Once()
+	mockLinkManager.On("FlushLink", ctx, "multinic2").Return(nil)
+	mockLinkManager.On("SetLinkDown", ctx, "multinic2").Return(nil)
+	mockLinkManager.On("GetLinkState", ctx, "multinic2").Return(&entities.LinkState{Name: "multinic2"}, nil).Once()
+
+	// 설정 디렉토리에는 고아 파일 없음
+	mockFileSystem.On("ListFiles", "/etc/netplan").Return([]string{}, nil)
+
+	// Act
+	output, err := useCase.Execute(ctx, DeleteNetworkInput{NodeName: "test-node"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"multinic1"}, output.RestoredInterfaces)
+	assert.Equal(t, []string{"multinic2"}, output.DeletedInterfaces)
+	assert.Equal(t, 1, output.TotalDeleted)
+	assert.Len(t, output.LinkCleanups, 1)
+	assert.True(t, output.LinkCleanups[0].Verified)
+	mockFileSystem.AssertExpectations(t)
+	mockLinkManager.AssertExpectations(t)
+	mockRepository.AssertExpectations(t)
+	mockRollbacker.AssertNotCalled(t, "Rollback", mock.Anything, mock.Anything)
+}
+
+func TestDeleteNetworkUseCase_Execute_QuarantineRollbackFailureDiscardsEntry(t *testing.T) {
+	// Arrange
+	mockOSDetector := new(MockOSDetector)
+	mockRollbacker := new(MockNetworkRollbacker)
+	mockFileSystem := new(MockFileSystem)
+	mockExecutor := new(MockCommandExecutor)
+	mockRepository := new(MockNetworkInterfaceRepository)
+	mockLinkManager := new(MockLinkManager)
+	mockLinkManager.On("ListLinks", mock.Anything).Return([]entities.LinkState{}, nil).Maybe()
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+	namingService := services.NewInterfaceNamingService(mockFileSystem, mockExecutor, entities.DefaultNamingScheme(), nil)
+	clock := &fixedClock{now: time.Unix(1700000000, 0)}
+	quarantine := services.NewQuarantineStore(mockFileSystem, clock, "/q", time.Hour)
+	guard := services.NewOrphanDeletionGuard(services.DeletionSafetyPolicy{}, clock)
+	useCase := NewDeleteNetworkUseCase(mockOSDetector, mockRollbacker, namingService, mockRepository, mockFileSystem, mockLinkManager, services.NewLinkCleaner(mockLinkManager, false), guard, quarantine, "test-node", logger)
+
+	ctx := context.Background()
+	mockOSDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil)
+	mockRepository.On("GetAllNodeInterfaces", ctx, "test-node").Return([]entities.NetworkInterface{}, nil)
+	mockFileSystem.On("Exists", "/q").Return(false)
+
+	orphanContent := ownedContent(2, "fa:16:3e:22:22:22", "network:\n  ethernets:\n    multinic2:\n      match:\n        macaddress: fa:16:3e:22:22:22\n  version: 2")
+	mockFileSystem.On("ListFiles", "/etc/netplan").Return([]string{"92-multinic2.yaml"}, nil)
+	mockFileSystem.On("ReadFile", "/etc/netplan/92-multinic2.yaml").Return(orphanContent, nil)
+
+	// 원본 삭제에 실패하면 격리 항목을 제거하고 링크를 다시 활성화해야 다음 사이클에 중복 격리되지 않음
+	mockFileSystem.On("MkdirAll", "/q", mock.Anything).Return(nil)
+	mockFileSystem.On("WriteFile", "/q/1700000000_92-multinic2.yaml", orphanContent, mock.Anything).Return(nil)
+	mockLinkManager.On("SetLinkDown", ctx, "multinic2").Return(nil)
+	mockRollbacker.On("Rollback", ctx, "multinic2").Return(fmt.Errorf("netplan apply failed"))
+	mockFileSystem.On("Remove", "/q/1700000000_92-multinic2.yaml").Return(nil)
+	mockLinkManager.On("SetLinkUp", ctx, "multinic2").Return(nil)
+
+	// Act
+	output, err := useCase.Execute(ctx, DeleteNetworkInput{NodeName: "test-node"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, output.QuarantinedInterfaces)
+	assert.Len(t, output.Errors, 1)
+	mockFileSystem.AssertExpectations(t)
+	mockLinkManager.AssertExpectations(t)
+}
+
+func TestDeleteNetworkUseCase_Execute_QuarantinePurgeBlockedBySafetyRules(t *testing.T) {
+	// Arrange
+	mockOSDetector := new(MockOSDetector)
+	mockRollbacker := new(MockNetworkRollbacker)
+	mockFileSystem := new(MockFileSystem)
+	mockExecutor := new(MockCommandExecutor)
+	mockRepository := new(MockNetworkInterfaceRepository)
+	mockLinkManager := new(MockLinkManager)
+	mockLinkManager.On("ListLinks", mock.Anything).Return([]entities.LinkState{}, nil).Maybe()
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+	namingService := services.NewInterfaceNamingService(mockFileSystem, mockExecutor, entities.DefaultNamingScheme(), nil)
+	clock := &fixedClock{now: time.Unix(1700007200, 0)}
+	quarantine := services.NewQuarantineStore(mockFileSystem, clock, "/q", time.Hour)
+	guard := services.NewOrphanDeletionGuard(services.DeletionSafetyPolicy{MaxDeletionsPerCycle: 1}, clock)
+	useCase := NewDeleteNetworkUseCase(mockOSDetector, mockRollbacker, namingService, mockRepository, mockFileSystem, mockLinkManager, services.NewLinkCleaner(mockLinkManager, false), guard, quarantine, "test-node", logger)
+
+	ctx := context.Background()
+	mockOSDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil)
+	mockRepository.On("GetAllNodeInterfaces", ctx, "test-node").Return([]entities.NetworkInterface{}, nil)
+
+	// 보존 기간이 지난 두 항목이 한 사이클 최대 삭제 수(1)를 넘으므로 모두 보류
+	mockFileSystem.On("Exists", "/q").Return(true)
+	mockFileSystem.On("ListFiles", "/q").Return([]string{"1700000000_91-multinic1.yaml", "1700000000_92-multinic2.yaml"}, nil)
+	mockFileSystem.On("ReadFile", "/q/1700000000_91-multinic1.yaml").Return([]byte("network:\n  ethernets:\n    multinic1:\n      match:\n        macaddress: fa:16:3e:11:11:11\n  version: 2"), nil)
+	mockFileSystem.On("ReadFile", "/q/1700000000_92-multinic2.yaml").Return([]byte("network:\n  ethernets:\n    multinic2:\n      match:\n        macaddress: fa:16:3e:22:22:22\n  version: 2"), nil)
+	mockFileSystem.On("ListFiles", "/etc/netplan").Return([]string{}, nil)
+
+	// Act
+	output, err := useCase.Execute(ctx, DeleteNetworkInput{NodeName: "test-node"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, output.DeletedInterfaces)
+	assert.ElementsMatch(t, []string{"multinic1", "multinic2"}, output.BlockedInterfaces)
+	assert.NotEmpty(t, output.BlockReason)
+	mockFileSystem.AssertNotCalled(t, "Remove", mock.Anything)
+}
+
+func TestDeleteNetworkUseCase_Execute_LinkCleanupAfterDelete(t *testing.T) {
+	// Arrange
+	mockOSDetector := new(MockOSDetector)
+	mockRollbacker := new(MockNetworkRollbacker)
+	mockFileSystem := new(MockFileSystem)
+	mockExecutor := new(MockCommandExecutor)
+	mockRepository := new(MockNetworkInterfaceRepository)
+	mockLinkManager := new(MockLinkManager)
+	mockLinkManager.On("ListLinks", mock.Anything).Return([]entities.LinkState{}, nil).Maybe()
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+	namingService := services.NewInterfaceNamingService(mockFileSystem, mockExecutor, entities.DefaultNamingScheme(), nil)
+	linkCleaner := services.NewLinkCleaner(mockLinkManager, true)
+	useCase := NewDeleteNetworkUseCase(mockOSDetector, mockRollbacker, namingService, mockRepository, mockFileSystem, mockLinkManager, linkCleaner, newTestOrphanGuard(services.DeletionSafetyPolicy{}), nil, "test-node", logger)
+
+	ctx := context.Background()
+	mockOSDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil)
+	mockRepository.On("GetAllNodeInterfaces", ctx, "test-node").Return([]entities.NetworkInterface{}, nil)
+	mockFileSystem.On("ListFiles", "/etc/netplan").Return([]string{"91-multinic1.yaml"}, nil)
+	mockFileSystem.On("ReadFile", "/etc/netplan/91-multinic1.yaml").Return(ownedContent(1, "fa:16:3e:11:11:11", "network:\n  ethernets:\n    multinic1:\n      match:\n        macaddress: fa:16:3e:11:11:11\n  version: 2"), nil)
+	mockRollbacker.On("Rollback", ctx, "multinic1").Return(nil)
+
+	// 주소/라우트 제거, 비활성화, 원래 커널 이름으로 변경 후 검증
+	mockLinkManager.On("GetLinkState", ctx, "multinic1").Return(&entities.LinkState{Name: "multinic1", AdminUp: true, Addresses: []string{"192.168.1.10/24"}}, nil)
+	mockLinkManager.On("FlushLink", ctx, "multinic1").Return(nil)
+	mockLinkManager.On("SetLinkDown", ctx, "multinic1").Return(nil)
+	mockLinkManager.On("GetKernelName", ctx, "multinic1").Return("ens5", nil)
+	mockLinkManager.On("RenameLink", ctx, "multinic1", "ens5").Return(nil)
+	mockLinkManager.On("GetLinkState", ctx, "ens5").Return(&entities.LinkState{Name: "ens5"}, nil)
+
+	// Act
+	output, err := useCase.Execute(ctx, DeleteNetworkInput{NodeName: "test-node"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"multinic1"}, output.DeletedInterfaces)
+	assert.Empty(t, output.Errors)
+	assert.Equal(t, []services.LinkCleanupResult{{
+		InterfaceName: "multinic1",
+		FinalName:     "ens5",
+		LinkPresent:   true,
+		Flushed:       true,
+		Renamed:       true,
+		Verified:      true,
+	}}, output.LinkCleanups)
+	mockLinkManager.AssertExpectations(t)
+	mockRollbacker.AssertExpectations(t)
+}
+
+func TestDeleteNetworkUseCase_Execute_LiveLinkWithoutConfig(t *testing.T) {
+	// Arrange
+	mockOSDetector := new(MockOSDetector)
+	mockRollbacker := new(MockNetworkRollbacker)
+	mockFileSystem := new(MockFileSystem)
+	mockExecutor := new(MockCommandExecutor)
+	mockRepository := new(MockNetworkInterfaceRepository)
+	mockLinkManager := new(MockLinkManager)
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+	namingService := services.NewInterfaceNamingService(mockFileSystem, mockExecutor, entities.DefaultNamingScheme(), nil)
+	useCase := NewDeleteNetworkUseCase(mockOSDetector, mockRollbacker, namingService, mockRepository, mockFileSystem, mockLinkManager, services.NewLinkCleaner(mockLinkManager, false), newTestOrphanGuard(services.DeletionSafetyPolicy{}), nil, "test-node", logger)
+
+	ctx := context.Background()
+	mockOSDetector.On("DetectOS").Return(interfaces.OSTypeRHEL, nil)
+	mockRepository.On("GetAllNodeInterfaces", ctx, "test-node").Return([]entities.NetworkInterface{
+		{ID: 3, MacAddress: "fa:16:3e:33:33:33", AttachedNodeName: "test-node", Status: entities.StatusConfigured},
+	}, nil)
+	mockFileSystem.On("ListFiles", "/etc/sysconfig/network-scripts").Return([]string{"ifcfg-eth0"}, nil)
+	mockFileSystem.On("ReadFile", "/etc/sysconfig/network-scripts/ifcfg-eth0").Return([]byte("DEVICE=eth0\nHWADDR=fa:16:3e:00:00:01"), nil)
+
+	// multinic0: 설정 파일도 DB 행도 없음 -> 해제, multinic1: DB에 있음 -> 재적용 대상
+	mockLinkManager.On("ListLinks", ctx).Return([]entities.LinkState{
+		{Name: "eth0", MacAddress: "fa:16:3e:00:00:01"},
+		{Name: "multinic0", MacAddress: "fa:16:3e:00:00:02"},
+		{Name: "multinic1", MacAddress: "fa:16:3e:33:33:33"},
+	}, nil)
+	mockRepository.On("UpdateInterfaceStatus", ctx, 3, entities.StatusPending).Return(nil)
+
+	mockLinkManager.On("GetLinkState", ctx, "multinic0").Return(&entities.LinkState{Name: "multinic0"}, nil)
+	mockLinkManager.On("FlushLink", ctx, "multinic0").Return(nil)
+	mockLinkManager.On("SetLinkDown", ctx, "multinic0").Return(nil)
+	mockLinkManager.On("GetKernelName", ctx, "multinic0").Return("ens6", nil)
+	mockLinkManager.On("RenameLink", ctx, "multinic0", "ens6").Return(nil)
+	mockLinkManager.On("GetLinkState", ctx, "ens6").Return(&entities.LinkState{Name: "ens6"}, nil)
+
+	// Act
+	output, err := useCase.Execute(ctx, DeleteNetworkInput{NodeName: "test-node"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"multinic0"}, output.ReleasedLinks)
+	assert.Equal(t, []string{"multinic1"}, output.RepairPendingInterfaces)
+	assert.Empty(t, output.DeletedInterfaces)
+	assert.Empty(t, output.Errors)
+	mockLinkManager.AssertExpectations(t)
+	mockRepository.AssertExpectations(t)
+	mockRollbacker.AssertNotCalled(t, "Rollback", mock.Anything, mock.Anything)
+}
+
+func TestDeleteNetworkUseCase_Execute_ConfiguredNamingScheme(t *testing.T) {
+	mockOSDetector := new(MockOSDetector)
+	mockRollbacker := new(MockNetworkRollbacker)
+	mockFileSystem := new(MockFileSystem)
+	mockExecutor := new(MockCommandExecutor)
+	mockRepository := new(MockNetworkInterfaceRepository)
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+
+	scheme, err := entities.NewNamingScheme("multinic", "{prefix}{index}", 16)
+	assert.NoError(t, err)
+	namingService := services.NewInterfaceNamingService(mockFileSystem, mockExecutor, scheme, nil)
+	linkManager := newAbsentLinkManager()
+	useCase := NewDeleteNetworkUseCase(mockOSDetector, mockRollbacker, namingService, mockRepository, mockFileSystem, linkManager, services.NewLinkCleaner(linkManager, false), newTestOrphanGuard(services.DeletionSafetyPolicy{}), nil, "test-node", logger)
+
+	ctx := context.Background()
+	mockOSDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil)
+
+	// 90-multinic-custom.yaml은 소유권 헤더가 없는 수동 파일이므로 삭제하지 않음
+	mockFileSystem.On("ListFiles", "/etc/netplan").Return([]string{"90-multinic0.yaml", "99-multinic12.yaml", "90-multinic-custom.yaml"}, nil)
+	mockRepository.On("GetAllNodeInterfaces", ctx, "test-node").Return([]entities.NetworkInterface{
+		{ID: 1, MacAddress: "fa:16:3e:00:00:00", AttachedNodeName: "test-node"},
+	}, nil)
+	mockFileSystem.On("ReadFile", "/etc/netplan/90-multinic0.yaml").Return(ownedContent(1, "fa:16:3e:00:00:00", `network:
+  ethernets:
+    multinic0:
+      match:
+        macaddress: fa:16:3e:00:00:00
+  version: 2`), nil)
+	mockFileSystem.On("ReadFile", "/etc/netplan/99-multinic12.yaml").Return(ownedContent(12, "fa:16:3e:00:00:12", `network:
+  ethernets:
+    multinic12:
+      match:
+        macaddress: fa:16:3e:00:00:12
+  version: 2`), nil)
+	mockFileSystem.On("ReadFile", "/etc/netplan/90-multinic-custom.yaml").Return([]byte(`network:
+  ethernets:
+    custom:
+      match:
+        macaddress: fa:16:3e:00:00:99
+  version: 2`), nil)
+	mockRollbacker.On("Rollback", ctx, "multinic12").Return(nil)
+
+	output, err := useCase.Execute(ctx, DeleteNetworkInput{NodeName: "test-node"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"multinic12"}, output.DeletedInterfaces)
+	mockRollbacker.AssertNotCalled(t, "Rollback", ctx, "multinic-custom")
+	mockRollbacker.AssertExpectations(t)
+}
+
+func TestDeleteNetworkUseCase_Execute_DesiredNameOwnership(t *testing.T) {
+	mockOSDetector := new(MockOSDetector)
+	mockRollbacker := new(MockNetworkRollbacker)
+	mockFileSystem := new(MockFileSystem)
+	mockExecutor := new(MockCommandExecutor)
+	mockRepository := new(MockNetworkInterfaceRepository)
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+
+	namingService := services.NewInterfaceNamingService(mockFileSystem, mockExecutor, entities.DefaultNamingScheme(), nil)
+	linkManager := newAbsentLinkManager()
+	useCase := NewDeleteNetworkUseCase(mockOSDetector, mockRollbacker, namingService, mockRepository, mockFileSystem, linkManager, services.NewLinkCleaner(linkManager, false), newTestOrphanGuard(services.DeletionSafetyPolicy{}), nil, "test-node", logger)
+
+	ctx := context.Background()
+	mockOSDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil)
+
+	// DB에서 지정한 이름의 파일도 소유권 헤더로 식별되어 MAC이 DB에서 사라지면 삭제됨
+	mockFileSystem.On("ListFiles", "/etc/netplan").Return([]string{"99-storage0.yaml", "99-tenant-a.yaml", "99-manual.yaml"}, nil)
+	mockRepository.On("GetAllNodeInterfaces", ctx, "test-node").Return([]entities.NetworkInterface{
+		{ID: 1, MacAddress: "fa:16:3e:00:00:01", AttachedNodeName: "test-node", DesiredName: "storage0"},
+	}, nil)
+	mockFileSystem.On("ReadFile", "/etc/netplan/99-storage0.yaml").Return(ownedContent(1, "fa:16:3e:00:00:01", `network:
+  ethernets:
+    storage0:
+      match:
+        macaddress: fa:16:3e:00:00:01
+  version: 2`), nil)
+	mockFileSystem.On("ReadFile", "/etc/netplan/99-tenant-a.yaml").Return(ownedContent(2, "fa:16:3e:00:00:02", `network:
+  ethernets:
+    tenant-a:
+      match:
+        macaddress: fa:16:3e:00:00:02
+  version: 2`), nil)
+	mockFileSystem.On("ReadFile", "/etc/netplan/99-manual.yaml").Return([]byte(`network:
+  ethernets:
+    manual:
+      match:
+        macaddress: fa:16:3e:00:00:03
+  version: 2`), nil)
+	mockRollbacker.On("Rollback", ctx, "tenant-a").Return(nil)
+
+	output, err := useCase.Execute(ctx, DeleteNetworkInput{NodeName: "test-node"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tenant-a"}, output.DeletedInterfaces)
+	mockRollbacker.AssertNotCalled(t, "Rollback", ctx, "manual")
+	mockRollbacker.AssertExpectations(t)
+}
+
+func TestDeleteNetworkUseCase_Execute_OwnershipRequired(t *testing.T) {
+	mockOSDetector := new(MockOSDetector)
+	mockRollbacker := new(MockNetworkRollbacker)
+	mockFileSystem := new(MockFileSystem)
+	mockExecutor := new(MockCommandExecutor)
+	mockRepository := new(MockNetworkInterfaceRepository)
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+
+	namingService := services.NewInterfaceNamingService(mockFileSystem, mockExecutor, entities.DefaultNamingScheme(), nil)
+	linkManager := newAbsentLinkManager()
+	useCase := NewDeleteNetworkUseCase(mockOSDetector, mockRollbacker, namingService, mockRepository, mockFileSystem, linkManager, services.NewLinkCleaner(linkManager, false), newTestOrphanGuard(services.DeletionSafetyPolicy{}), nil, "test-node", logger)
+
+	ctx := context.Background()
+	mockOSDetector.On("DetectOS").Return(interfaces.OSTypeRHEL, nil)
+	mockRepository.On("GetAllNodeInterfaces", ctx, "test-node").Return([]entities.NetworkInterface{}, nil)
+
+	// 네 파일 모두 DB에 MAC이 없지만 이 에이전트의 소유가 증명되고 내용이 그대로인 파일만 삭제
+	// ifcfg-eth0은 이름 규칙 밖의 배포판 파일이므로 헤더 없는 파일로 보고하지 않음
+	ifcfgDir := "/etc/sysconfig/network-scripts"
+	mockFileSystem.On("ListFiles", ifcfgDir).Return([]string{"ifcfg-eth0", "ifcfg-multinic0", "ifcfg-multinic1", "ifcfg-multinic2", "ifcfg-multinic3"}, nil)
+	mockFileSystem.On("ReadFile", ifcfgDir+"/ifcfg-eth0").Return([]byte("DEVICE=eth0\nHWADDR=fa:16:3e:00:00:09"), nil)
+	mockFileSystem.On("ReadFile", ifcfgDir+"/ifcfg-multinic0").Return(ownedContent(1, "fa:16:3e:00:00:01", "DEVICE=multinic0\nHWADDR=fa:16:3e:00:00:01"), nil)
+	tampered := strings.Replace(string(ownedContent(2, "fa:16:3e:00:00:02", "DEVICE=multinic1\nHWADDR=fa:16:3e:00:00:02")), "DEVICE=multinic1", "DEVICE=multinic1\nMTU=9000", 1)
+	mockFileSystem.On("ReadFile", ifcfgDir+"/ifcfg-multinic1").Return([]byte(tampered), nil)
+	mockFileSystem.On("ReadFile", ifcfgDir+"/ifcfg-multinic2").Return([]byte("DEVICE=multinic2\nHWADDR=fa:16:3e:00:00:03"), nil)
+	foreignBody := "DEVICE=multinic3\nHWADDR=fa:16:3e:00:00:04"
+	foreign := entities.NewFileOwnership("other-agent", entities.NetworkInterface{ID: 4, MacAddress: "fa:16:3e:00:00:04"}, []byte(foreignBody)).Render([]byte(foreignBody))
+	mockFileSystem.On("ReadFile", ifcfgDir+"/ifcfg-multinic3").Return(foreign, nil)
+	mockRollbacker.On("Rollback", ctx, "multinic0").Return(nil)
+
+	output, err := useCase.Execute(ctx, DeleteNetworkInput{NodeName: "test-node"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"multinic0"}, output.DeletedInterfaces)
+	assert.Equal(t, []string{"ifcfg-multinic1"}, output.TamperedFiles)
+	assert.Equal(t, []string{"ifcfg-multinic2"}, output.UnownedFiles)
+	assert.Equal(t, []string{"ifcfg-multinic3"}, output.ForeignFiles)
+	mockRollbacker.AssertExpectations(t)
+	mockRollbacker.AssertNotCalled(t, "Rollback", ctx, "multinic3")
+}
+
+func TestDeleteNetworkUseCase_Execute_DryRun(t *testing.T) {
+	mockOSDetector := new(MockOSDetector)
+	mockRollbacker := new(MockNetworkRollbacker)
+	mockFileSystem := new(MockFileSystem)
+	mockExecutor := new(MockCommandExecutor)
+	mockRepository := new(MockNetworkInterfaceRepository)
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+
+	namingService := services.NewInterfaceNamingService(mockFileSystem, mockExecutor, entities.DefaultNamingScheme(), nil)
+	linkManager := newAbsentLinkManager()
+	useCase := NewDeleteNetworkUseCase(mockOSDetector, mockRollbacker, namingService, mockRepository, mockFileSystem, linkManager, services.NewLinkCleaner(linkManager, false), newTestOrphanGuard(services.DeletionSafetyPolicy{}), nil, "test-node", logger)
+
+	ctx := context.Background()
+	mockOSDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil)
+	mockRepository.On("GetAllNodeInterfaces", ctx, "test-node").Return([]entities.NetworkInterface{}, nil)
+	mockFileSystem.On("ListFiles", "/etc/netplan").Return([]string{"91-multinic1.yaml"}, nil)
+	mockFileSystem.On("ReadFile", "/etc/netplan/91-multinic1.yaml").Return(ownedContent(1, "fa:16:3e:11:11:11", "network:\n  ethernets:\n    multinic1:\n      match:\n        macaddress: fa:16:3e:11:11:11\n  version: 2"), nil)
+
+	output, err := useCase.Execute(ctx, DeleteNetworkInput{NodeName: "test-node", DryRun: true})
+
+	assert.NoError(t, err)
+	assert.Empty(t, output.DeletedInterfaces)
+	assert.Equal(t, []entities.PlannedChange{{
+		Action:        entities.PlanActionDelete,
+		InterfaceName: "multinic1",
+		MacAddress:    "fa:16:3e:11:11:11",
+		ConfigPath:    "/etc/netplan/91-multinic1.yaml",
+		Reason:        "orphaned",
+	}}, output.Plan)
+	mockRollbacker.AssertNotCalled(t, "Rollback", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,196 @@
+package usecases
+
+import (
+	"context"
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/errors"
+	"multinic-agent/internal/domain/interfaces"
+	"multinic-agent/internal/domain/services"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// 설정 파일 상태
+const (
+	FileStateInSync     = "in_sync"
+	FileStateDrifted    = "drifted"
+	FileStateMissing    = "missing"
+	FileStateUnowned    = "unowned"    // 소유권 헤더가 없는 이전 버전 파일 (다음 적용 시 인수)
+	FileStateForeign    = "foreign"    // 헤더 없이 MAC이 다르거나 다른 에이전트의 헤더가 있어 덮어쓰지 않는 파일
+	FileStateTampered   = "tampered"   // 소유권 헤더의 해시와 내용이 다른 수동 수정 파일
+	FileStateUnreadable = "unreadable" // 읽기 또는 파싱 실패
+)
+
+// NetworkStatusInput은 상태 조회 유스케이스의 입력 파라미터입니다
+type NetworkStatusInput struct {
+	NodeName string
+}
+
+// InterfaceStatus는 관리 대상 인터페이스 하나의 DB 행, 설정 파일, 커널 링크 상태입니다
+type InterfaceStatus struct {
+	Interface     entities.NetworkInterface
+	InterfaceName string // 현재 할당되었거나 다음 적용 시 사용할 이름 (결정할 수 없으면 빈 문자열)
+	ConfigPath    string
+	FileState     string
+	FileDrifts    []string
+	Link          *entities.LinkState // MAC에 해당하는 커널 링크 (없으면 nil)
+	LinkDrifts    []string
+	Error         string // 이름 결정 또는 파일 읽기 실패 사유
+}
+
+// InterfaceStatusView는 InterfaceStatus의 JSON 출력 형식입니다 (status 명령과 관리 API에서 공통 사용)
+type InterfaceStatusView struct {
+	InterfaceID   int      `json:"interface_id"`
+	InterfaceName string   `json:"interface_name"`
+	MacAddress    string   `json:"mac_address"`
+	DBStatus      string   `json:"db_status"`
+	Address       string   `json:"address,omitempty"`
+	CIDR          string   `json:"cidr,omitempty"`
+	MTU           int      `json:"mtu,omitempty"`
+	ConfigPath    string   `json:"config_path,omitempty"`
+	FileState     string   `json:"file_state,omitempty"`
+	FileDrifts    []string `json:"file_drifts,omitempty"`
+	LinkName      string   `json:"link_name,omitempty"`
+	LinkState     string   `json:"link_operstate,omitempty"`
+	LinkAddresses []string `json:"link_addresses,omitempty"`
+	LinkDrifts    []string `json:"link_drifts,omitempty"`
+	Error         string   `json:"error,omitempty"`
+}
+
+// View는 JSON 출력 형식으로 변환합니다
+func (s InterfaceStatus) View() InterfaceStatusView {
+	view := InterfaceStatusView{
+		InterfaceID:   s.Interface.ID,
+		InterfaceName: s.InterfaceName,
+		MacAddress:    s.Interface.MacAddress,
+		DBStatus:      s.Interface.Status.String(),
+		Address:       s.Interface.Address,
+		CIDR:          s.Interface.CIDR,
+		MTU:           s.Interface.MTU,
+		ConfigPath:    s.ConfigPath,
+		FileState:     s.FileState,
+		FileDrifts:    s.FileDrifts,
+		LinkDrifts:    s.LinkDrifts,
+		Error:         s.Error,
+	}
+	if s.Link != nil {
+		view.LinkName = s.Link.Name
+		view.LinkState = s.Link.OperState
+		view.LinkAddresses = s.Link.Addresses
+	}
+	return view
+}
+
+// NetworkStatusOutput은 상태 조회 유스케이스의 출력 결과입니다
+type NetworkStatusOutput struct {
+	Interfaces []InterfaceStatus
+}
+
+// NetworkStatusUseCase는 노드의 관리 대상 인터페이스 상태를 변경 없이 조회하는 유스케이스입니다
+type NetworkStatusUseCase struct {
+	repository    interfaces.NetworkInterfaceRepository
+	configReader  interfaces.ConfigReader
+	linkManager   interfaces.LinkManager
+	namingService *services.InterfaceNamingService
+	agentID       string   // 빈 값이면 소유권 헤더의 에이전트 ID를 비교하지 않음
+	agentAliases  []string // agentID 외에 이 에이전트의 것으로 인정하는 소유권 헤더의 에이전트 ID
+	logger        *logrus.Logger
+}
+
+// NewNetworkStatusUseCase는 새로운 NetworkStatusUseCase를 생성합니다
+func NewNetworkStatusUseCase(
+	repo interfaces.NetworkInterfaceRepository,
+	configReader interfaces.ConfigReader,
+	linkManager interfaces.LinkManager,
+	naming *services.InterfaceNamingService,
+	agentID string,
+	logger *logrus.Logger,
+) *NetworkStatusUseCase {
+	return &NetworkStatusUseCase{
+		repository:    repo,
+		configReader:  configReader,
+		linkManager:   linkManager,
+		namingService: naming,
+		agentID:       agentID,
+		logger:        logger,
+	}
+}
+
+// SetAgentAliases는 agentID 외에 이 에이전트의 것으로 인정할 소유권 헤더의 에이전트 ID를 설정합니다 (실행 전에 호출)
+func (uc *NetworkStatusUseCase) SetAgentAliases(aliases []string) {
+	uc.agentAliases = aliases
+}
+
+// Execute는 DB의 인터페이스마다 설정 파일과 커널 링크 상태를 조회합니다
+func (uc *NetworkStatusUseCase) Execute(ctx context.Context, input NetworkStatusInput) (*NetworkStatusOutput, error) {
+	ifaces, err := uc.repository.GetAllNodeInterfaces(ctx, input.NodeName)
+	if err != nil {
+		return nil, errors.NewSystemError("failed to get node interfaces", err)
+	}
+
+	// 링크 목록은 한 번만 조회 (실패해도 파일 상태는 보여줌)
+	links, err := uc.linkManager.ListLinks(ctx)
+	if err != nil {
+		uc.logger.WithError(err).Warn("Failed to list live links for status report")
+	}
+	linksByMAC := make(map[string]*entities.LinkState, len(links))
+	for i := range links {
+		linksByMAC[strings.ToLower(links[i].MacAddress)] = &links[i]
+	}
+
+	output := &NetworkStatusOutput{Interfaces: make([]InterfaceStatus, 0, len(ifaces))}
+	for _, iface := range ifaces {
+		output.Interfaces = append(output.Interfaces, uc.inspect(iface, linksByMAC[strings.ToLower(iface.MacAddress)]))
+	}
+	return output, nil
+}
+
+// inspect는 인터페이스 하나의 이름, 설정 파일, 커널 링크 상태를 조회합니다
+func (uc *NetworkStatusUseCase) inspect(iface entities.NetworkInterface, link *entities.LinkState) InterfaceStatus {
+	status := InterfaceStatus{Interface: iface, Link: link}
+
+	// 상태 조회는 이름 할당을 저장하지 않음
+	name, err := uc.namingService.PreviewNameForInterface(iface)
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	status.InterfaceName = name.String()
+
+	if link != nil {
+		status.LinkDrifts = services.DetectRuntimeDrift(iface, status.InterfaceName, link)
+	}
+
+	status.ConfigPath = uc.configReader.FindConfigPath(status.InterfaceName)
+	if status.ConfigPath == "" {
+		status.FileState = FileStateMissing
+		return status
+	}
+
+	fileConfig, err := uc.configReader.ReadConfig(status.ConfigPath)
+	if err != nil {
+		status.FileState = FileStateUnreadable
+		status.Error = err.Error()
+		return status
+	}
+
+	ownershipDrifts, err := services.DetectOwnershipDrift(iface, fileConfig, uc.agentID, uc.agentAliases...)
+	if err != nil {
+		status.FileState = FileStateForeign
+		return status
+	}
+	status.FileDrifts = append(ownershipDrifts, services.DetectConfigDrift(iface, fileConfig)...)
+
+	switch {
+	case fileConfig.Ownership == nil:
+		status.FileState = FileStateUnowned
+	case fileConfig.Tampered:
+		status.FileState = FileStateTampered
+	case len(status.FileDrifts) > 0:
+		status.FileState = FileStateDrifted
+	default:
+		status.FileState = FileStateInSync
+	}
+	return status
+}
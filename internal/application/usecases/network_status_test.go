@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/services"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkStatusUseCase_Execute(t *testing.T) {
+	inSync := entities.NetworkInterface{ID: 1, MacAddress: "fa:16:3e:00:00:01", MTU: 1500, Status: entities.StatusConfigured}
+	missing := entities.NetworkInterface{ID: 2, MacAddress: "fa:16:3e:00:00:02", MTU: 1500, Status: entities.StatusPending}
+	foreign := entities.NetworkInterface{ID: 3, MacAddress: "fa:16:3e:00:00:03", MTU: 1500, Status: entities.StatusConfigured}
+
+	mockRepo := new(MockNetworkInterfaceRepository)
+	mockConfigurer := new(MockNetworkConfigurer)
+	mockLinks := new(MockLinkManager)
+	mockFS := new(MockFileSystem)
+	mockExecutor := new(MockCommandExecutor)
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+	// multinic0, multinic1은 각각 ID 1, 3의 NIC이 사용 중
+	mockFS.On("Exists", "/sys/class/net/multinic0").Return(true)
+	mockFS.On("Exists", "/sys/class/net/multinic1").Return(true)
+	for i := 2; i < 10; i++ {
+		mockFS.On("Exists", fmt.Sprintf("/sys/class/net/multinic%d", i)).Return(false).Maybe()
+	}
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "ip", "addr", "show", "multinic0").
+		Return([]byte("link/ether fa:16:3e:00:00:01 brd ff:ff:ff:ff:ff:ff"), nil)
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "ip", "addr", "show", "multinic1").
+		Return([]byte("link/ether fa:16:3e:00:00:03 brd ff:ff:ff:ff:ff:ff"), nil)
+
+	mockRepo.On("GetAllNodeInterfaces", mock.Anything, "test-node").Return([]entities.NetworkInterface{inSync, missing, foreign}, nil)
+	mockLinks.On("ListLinks", mock.Anything).Return([]entities.LinkState{
+		{Name: "multinic0", MacAddress: "FA:16:3E:00:00:01", MTU: 1500, AdminUp: true},
+		{Name: "eth2", MacAddress: "fa:16:3e:00:00:02", MTU: 1500, AdminUp: true},
+	}, nil)
+	mockConfigurer.On("FindConfigPath", "multinic0").Return("/etc/netplan/90-multinic0.yaml")
+	mockConfigurer.On("ReadConfig", "/etc/netplan/90-multinic0.yaml").Return(&entities.InterfaceConfig{
+		Name:       "multinic0",
+		MacAddress: "fa:16:3e:00:00:01",
+		MTU:        1500,
+		Ownership:  &entities.FileOwnership{AgentID: "test-node", InterfaceID: 1, MacAddress: "fa:16:3e:00:00:01"},
+	}, nil)
+	mockConfigurer.On("FindConfigPath", "multinic2").Return("")
+	mockConfigurer.On("FindConfigPath", "multinic1").Return("/etc/netplan/91-multinic1.yaml")
+	mockConfigurer.On("ReadConfig", "/etc/netplan/91-multinic1.yaml").Return(&entities.InterfaceConfig{
+		Name:       "multinic1",
+		MacAddress: "fa:16:3e:00:00:99",
+		MTU:        1500,
+	}, nil)
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+	namingService := services.NewInterfaceNamingService(mockFS, mockExecutor, entities.DefaultNamingScheme(), nil)
+	useCase := NewNetworkStatusUseCase(mockRepo, mockConfigurer, mockLinks, namingService, "test-node", logger)
+
+	output, err := useCase.Execute(context.Background(), NetworkStatusInput{NodeName: "test-node"})
+
+	require.NoError(t, err)
+	require.Len(t, output.Interfaces, 3)
+
+	assert.Equal(t, "multinic0", output.Interfaces[0].InterfaceName)
+	assert.Equal(t, FileStateInSync, output.Interfaces[0].FileState)
+	assert.Empty(t, output.Interfaces[0].FileDrifts)
+	assert.Empty(t, output.Interfaces[0].LinkDrifts)
+
+	// 아직 설정되지 않은 NIC은 커널 이름 그대로 남아 있음
+	assert.Equal(t, "multinic2", output.Interfaces[1].InterfaceName)
+	assert.Equal(t, FileStateMissing, output.Interfaces[1].FileState)
+	require.NotNil(t, output.Interfaces[1].Link)
+	assert.Equal(t, []string{services.DriftTypeRuntimeName}, output.Interfaces[1].LinkDrifts)
+
+	assert.Equal(t, "multinic1", output.Interfaces[2].InterfaceName)
+	assert.Equal(t, FileStateForeign, output.Interfaces[2].FileState)
+	assert.Nil(t, output.Interfaces[2].Link)
+
+	mockConfigurer.AssertNotCalled(t, "Configure", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,297 @@
+package usecases
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"multinic-agent/internal/domain/constants"
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/interfaces"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// 노드 사전 점검 항목 이름
+const (
+	DoctorCheckOSRelease      = "os_release"
+	DoctorCheckOSType         = "os_type"
+	DoctorCheckNsenter        = "nsenter"
+	DoctorCheckHostNamespace  = "host_namespace"
+	DoctorCheckNetplan        = "netplan"
+	DoctorCheckNetworkManager = "network_manager"
+	DoctorCheckIfcfgPlugin    = "network_manager_ifcfg"
+	DoctorCheckConfigDir      = "config_dir"
+	DoctorCheckDatabase       = "database"
+	DoctorCheckNodeName       = "node_name"
+)
+
+// doctorCommandTimeout은 점검 명령 하나의 최대 실행 시간입니다
+const doctorCommandTimeout = 5 * time.Second
+
+// hostNamespaceArgs는 호스트 네임스페이스에서 명령을 실행하기 위한 nsenter 인자입니다 (어댑터와 동일)
+var hostNamespaceArgs = []string{"--target", "1", "--mount", "--uts", "--ipc", "--net", "--pid"}
+
+// NodeDoctorInput은 노드 사전 점검 유스케이스의 입력 파라미터입니다
+type NodeDoctorInput struct {
+	NodeName string
+}
+
+// NodeDoctorUseCase는 에이전트가 동작하는 데 필요한 노드 환경을 변경 없이 점검하는 유스케이스입니다
+type NodeDoctorUseCase struct {
+	osDetector      interfaces.OSDetector
+	commandExecutor interfaces.CommandExecutor
+	fileSystem      interfaces.FileSystem
+	repository      interfaces.NetworkInterfaceRepository
+	clock           interfaces.Clock
+	logger          *logrus.Logger
+}
+
+// NewNodeDoctorUseCase는 새로운 NodeDoctorUseCase를 생성합니다
+func NewNodeDoctorUseCase(
+	osDetector interfaces.OSDetector,
+	executor interfaces.CommandExecutor,
+	fs interfaces.FileSystem,
+	repo interfaces.NetworkInterfaceRepository,
+	clock interfaces.Clock,
+	logger *logrus.Logger,
+) *NodeDoctorUseCase {
+	return &NodeDoctorUseCase{
+		osDetector:      osDetector,
+		commandExecutor: executor,
+		fileSystem:      fs,
+		repository:      repo,
+		clock:           clock,
+		logger:          logger,
+	}
+}
+
+// Execute는 모든 점검을 실행하고 결과를 반환합니다
+// 앞선 점검이 실패하여 의미가 없는 점검은 skip으로 표시합니다
+func (uc *NodeDoctorUseCase) Execute(ctx context.Context, input NodeDoctorInput) entities.DiagnosticReport {
+	report := entities.DiagnosticReport{
+		NodeName:    input.NodeName,
+		GeneratedAt: uc.clock.Now(),
+	}
+	add := func(check entities.DiagnosticCheck) {
+		uc.logger.WithFields(logrus.Fields{
+			"check":  check.Name,
+			"status": check.Status,
+		}).Debug(check.Message)
+		report.Checks = append(report.Checks, check)
+	}
+
+	// 1. OS 감지
+	add(uc.checkOSRelease())
+	osCheck, osType := uc.checkOSType()
+	add(osCheck)
+
+	// 2. 호스트 네임스페이스 진입
+	nsenterCheck := uc.checkNsenter(ctx)
+	add(nsenterCheck)
+	hostCheck := skipCheck(DoctorCheckHostNamespace, "nsenter is not available")
+	if nsenterCheck.Status == entities.DiagnosticPass {
+		hostCheck = uc.checkHostNamespace(ctx)
+	}
+	add(hostCheck)
+	hostReady := hostCheck.Status == entities.DiagnosticPass
+
+	// 3. OS별 네트워크 도구
+	for _, check := range uc.checkNetworkTooling(ctx, osType, hostReady) {
+		add(check)
+	}
+
+	// 4. DB 연결과 노드 이름 일치
+	dbCheck, rows := uc.checkDatabase(ctx, input.NodeName)
+	add(dbCheck)
+	nodeCheck := skipCheck(DoctorCheckNodeName, "database is not reachable")
+	if dbCheck.Status == entities.DiagnosticPass {
+		nodeCheck = checkNodeName(input.NodeName, rows)
+	}
+	add(nodeCheck)
+
+	return report
+}
+
+// checkOSRelease는 호스트의 /etc/os-release 마운트를 확인합니다
+func (uc *NodeDoctorUseCase) checkOSRelease() entities.DiagnosticCheck {
+	if !uc.fileSystem.Exists(constants.OSReleaseFile) {
+		return failCheck(DoctorCheckOSRelease,
+			fmt.Sprintf("%s not found", constants.OSReleaseFile),
+			"mount the host /etc/os-release at /host/etc/os-release (hostPath volume in the DaemonSet)")
+	}
+	return passCheck(DoctorCheckOSRelease, fmt.Sprintf("%s is mounted", constants.OSReleaseFile))
+}
+
+// checkOSType은 지원하는 OS인지 확인합니다 (실패 시 빈 OS 타입 반환)
+func (uc *NodeDoctorUseCase) checkOSType() (entities.DiagnosticCheck, interfaces.OSType) {
+	osType, err := uc.osDetector.DetectOS()
+	if err != nil {
+		return failCheck(DoctorCheckOSType, err.Error(),
+			"run the agent only on Ubuntu (Netplan) or RHEL-family (NetworkManager) nodes, e.g. with a nodeSelector"), ""
+	}
+	return passCheck(DoctorCheckOSType, fmt.Sprintf("detected %s", osType)), osType
+}
+
+// checkNsenter는 컨테이너 이미지에 nsenter가 있는지 확인합니다
+func (uc *NodeDoctorUseCase) checkNsenter(ctx context.Context) entities.DiagnosticCheck {
+	if _, err := uc.commandExecutor.ExecuteWithTimeout(ctx, doctorCommandTimeout, "nsenter", "--version"); err != nil {
+		return failCheck(DoctorCheckNsenter, fmt.Sprintf("nsenter cannot be executed: %v", err),
+			"use an agent image that ships util-linux (nsenter)")
+	}
+	return passCheck(DoctorCheckNsenter, "nsenter is available")
+}
+
+// checkHostNamespace는 호스트 PID 1의 네임스페이스에 진입할 수 있는지 확인합니다
+func (uc *NodeDoctorUseCase) checkHostNamespace(ctx context.Context) entities.DiagnosticCheck {
+	if _, err := uc.execOnHost(ctx, "true"); err != nil {
+		return failCheck(DoctorCheckHostNamespace, fmt.Sprintf("cannot enter the host namespaces: %v", err),
+			"run the pod with hostPID: true, hostNetwork: true and a privileged security context")
+	}
+	return passCheck(DoctorCheckHostNamespace, "host namespaces are reachable through PID 1")
+}
+
+// checkNetworkTooling은 OS에 맞는 네트워크 설정 도구와 설정 디렉토리를 확인합니다
+func (uc *NodeDoctorUseCase) checkNetworkTooling(ctx context.Context, osType interfaces.OSType, hostReady bool) []entities.DiagnosticCheck {
+	switch osType {
+	case interfaces.OSTypeUbuntu:
+		netplanCheck := skipCheck(DoctorCheckNetplan, "host namespaces are not reachable")
+		if hostReady {
+			netplanCheck = uc.checkNetplan(ctx)
+		}
+		return []entities.DiagnosticCheck{netplanCheck, uc.checkConfigDir(constants.NetplanConfigDir)}
+	case interfaces.OSTypeRHEL:
+		nmCheck := skipCheck(DoctorCheckNetworkManager, "host namespaces are not reachable")
+		pluginCheck := skipCheck(DoctorCheckIfcfgPlugin, "host namespaces are not reachable")
+		if hostReady {
+			nmCheck = uc.checkNetworkManager(ctx)
+			if nmCheck.Status == entities.DiagnosticPass {
+				pluginCheck = uc.checkIfcfgPlugin(ctx)
+			} else {
+				pluginCheck = skipCheck(DoctorCheckIfcfgPlugin, "NetworkManager is not running")
+			}
+		}
+		return []entities.DiagnosticCheck{nmCheck, pluginCheck, uc.checkConfigDir(constants.RHELNetworkScriptsDir)}
+	default:
+		return []entities.DiagnosticCheck{skipCheck(DoctorCheckConfigDir, "OS type is unknown")}
+	}
+}
+
+// checkNetplan은 호스트에 netplan 명령이 있는지 확인합니다
+func (uc *NodeDoctorUseCase) checkNetplan(ctx context.Context) entities.DiagnosticCheck {
+	if _, err := uc.execOnHost(ctx, "netplan", "--help"); err != nil {
+		return failCheck(DoctorCheckNetplan, fmt.Sprintf("netplan cannot be executed on the host: %v", err),
+			"install netplan.io on the node")
+	}
+	return passCheck(DoctorCheckNetplan, "netplan is installed on the host")
+}
+
+// checkNetworkManager는 호스트에서 NetworkManager가 실행 중인지 확인합니다
+func (uc *NodeDoctorUseCase) checkNetworkManager(ctx context.Context) entities.DiagnosticCheck {
+	if _, err := uc.execOnHost(ctx, "systemctl", "is-active", "NetworkManager"); err != nil {
+		return failCheck(DoctorCheckNetworkManager, fmt.Sprintf("NetworkManager is not active: %v", err),
+			"enable and start NetworkManager on the node (systemctl enable --now NetworkManager)")
+	}
+	return passCheck(DoctorCheckNetworkManager, "NetworkManager is active")
+}
+
+// checkIfcfgPlugin은 NetworkManager가 ifcfg 파일을 읽도록 설정되어 있는지 확인합니다
+// plugins 설정이 없으면 배포판 기본값(ifcfg-rh 포함)을 사용하는 것으로 봅니다
+func (uc *NodeDoctorUseCase) checkIfcfgPlugin(ctx context.Context) entities.DiagnosticCheck {
+	output, err := uc.execOnHost(ctx, "NetworkManager", "--print-config")
+	if err != nil {
+		return warnCheck(DoctorCheckIfcfgPlugin, fmt.Sprintf("cannot read the NetworkManager configuration: %v", err),
+			"make sure NetworkManager loads the ifcfg-rh plugin so that ifcfg files are applied")
+	}
+
+	plugins, found := parseNetworkManagerPlugins(string(output))
+	if !found {
+		return passCheck(DoctorCheckIfcfgPlugin, "NetworkManager uses the default plugins")
+	}
+	for _, plugin := range plugins {
+		if plugin == "ifcfg-rh" {
+			return passCheck(DoctorCheckIfcfgPlugin, fmt.Sprintf("NetworkManager plugins: %s", strings.Join(plugins, ",")))
+		}
+	}
+	return failCheck(DoctorCheckIfcfgPlugin,
+		fmt.Sprintf("NetworkManager plugins (%s) do not include ifcfg-rh, ifcfg files will be ignored", strings.Join(plugins, ",")),
+		"add ifcfg-rh to plugins= in the [main] section of /etc/NetworkManager/NetworkManager.conf and restart NetworkManager")
+}
+
+// checkConfigDir는 설정 파일 디렉토리가 컨테이너에 마운트되어 있는지 확인합니다
+func (uc *NodeDoctorUseCase) checkConfigDir(dir string) entities.DiagnosticCheck {
+	if !uc.fileSystem.Exists(dir) {
+		return failCheck(DoctorCheckConfigDir, fmt.Sprintf("%s not found", dir),
+			fmt.Sprintf("mount the host %s into the agent container (hostPath volume in the DaemonSet)", dir))
+	}
+	return passCheck(DoctorCheckConfigDir, fmt.Sprintf("%s is mounted", dir))
+}
+
+// checkDatabase는 노드의 인터페이스를 조회하여 DB 연결을 확인합니다
+func (uc *NodeDoctorUseCase) checkDatabase(ctx context.Context, nodeName string) (entities.DiagnosticCheck, []entities.NetworkInterface) {
+	rows, err := uc.repository.GetAllNodeInterfaces(ctx, nodeName)
+	if err != nil {
+		return failCheck(DoctorCheckDatabase, fmt.Sprintf("cannot query multi_interface: %v", err),
+			"check DB_HOST, DB_PORT, DB_USER, DB_PASSWORD and DB_NAME, and that the node can reach the database"), nil
+	}
+	return passCheck(DoctorCheckDatabase, "database is reachable"), rows
+}
+
+// checkNodeName은 DB에 이 노드 이름으로 할당된 인터페이스가 있는지 확인합니다
+// 인터페이스가 아직 할당되지 않은 노드일 수 있으므로 경고로만 보고합니다
+func checkNodeName(nodeName string, rows []entities.NetworkInterface) entities.DiagnosticCheck {
+	if len(rows) == 0 {
+		return warnCheck(DoctorCheckNodeName,
+			fmt.Sprintf("no interfaces have attached_node_name = %q", nodeName),
+			"if interfaces are attached to this node, make sure the hostname (without domain suffix) matches attached_node_name")
+	}
+	return passCheck(DoctorCheckNodeName, fmt.Sprintf("%d interface(s) attached to %s", len(rows), nodeName))
+}
+
+// execOnHost는 호스트 네임스페이스에서 명령을 실행합니다
+func (uc *NodeDoctorUseCase) execOnHost(ctx context.Context, command string, args ...string) ([]byte, error) {
+	cmdArgs := append(append(append([]string{}, hostNamespaceArgs...), command), args...)
+	return uc.commandExecutor.ExecuteWithTimeout(ctx, doctorCommandTimeout, "nsenter", cmdArgs...)
+}
+
+// parseNetworkManagerPlugins는 NetworkManager --print-config 출력에서 [main] 섹션의 plugins 값을 찾습니다
+func parseNetworkManagerPlugins(config string) ([]string, bool) {
+	section := ""
+	scanner := bufio.NewScanner(strings.NewReader(config))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			section = line
+		case section == "[main]" && strings.HasPrefix(line, "plugins="):
+			var plugins []string
+			for _, plugin := range strings.Split(strings.TrimPrefix(line, "plugins="), ",") {
+				if plugin = strings.TrimSpace(plugin); plugin != "" {
+					plugins = append(plugins, plugin)
+				}
+			}
+			return plugins, true
+		}
+	}
+	return nil, false
+}
+
+// passCheck, warnCheck, failCheck, skipCheck는 점검 결과를 생성합니다
+func passCheck(name, message string) entities.DiagnosticCheck {
+	return entities.DiagnosticCheck{Name: name, Status: entities.DiagnosticPass, Message: message}
+}
+
+func warnCheck(name, message, remedy string) entities.DiagnosticCheck {
+	return entities.DiagnosticCheck{Name: name, Status: entities.DiagnosticWarn, Message: message, Remedy: remedy}
+}
+
+func failCheck(name, message, remedy string) entities.DiagnosticCheck {
+	return entities.DiagnosticCheck{Name: name, Status: entities.DiagnosticFail, Message: message, Remedy: remedy}
+}
+
+func skipCheck(name, reason string) entities.DiagnosticCheck {
+	return entities.DiagnosticCheck{Name: name, Status: entities.DiagnosticSkip, Message: reason}
+}
@@ -0,0 +1,136 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"multinic-agent/internal/domain/constants"
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/interfaces"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// onHost는 호스트 네임스페이스에서 실행되는 명령의 목 호출 인자를 생성합니다
+func onHost(command ...string) []interface{} {
+	args := []interface{}{mock.Anything, mock.Anything, "nsenter"}
+	for _, arg := range append(append([]string{}, hostNamespaceArgs...), command...) {
+		args = append(args, arg)
+	}
+	return args
+}
+
+func TestNodeDoctorUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(*MockOSDetector, *MockCommandExecutor, *MockFileSystem, *MockNetworkInterfaceRepository)
+		want       map[string]entities.DiagnosticStatus
+		healthy    bool
+	}{
+		{
+			name: "RHEL 노드 - ifcfg 플러그인 누락과 DB에 노드 행 없음",
+			setupMocks: func(osDetector *MockOSDetector, executor *MockCommandExecutor, fs *MockFileSystem, repo *MockNetworkInterfaceRepository) {
+				fs.On("Exists", constants.OSReleaseFile).Return(true)
+				fs.On("Exists", constants.RHELNetworkScriptsDir).Return(true)
+				osDetector.On("DetectOS").Return(interfaces.OSTypeRHEL, nil)
+				executor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "nsenter", "--version").Return([]byte("nsenter from util-linux 2.37"), nil)
+				executor.On("ExecuteWithTimeout", onHost("true")...).Return([]byte{}, nil)
+				executor.On("ExecuteWithTimeout", onHost("systemctl", "is-active", "NetworkManager")...).Return([]byte("active\n"), nil)
+				executor.On("ExecuteWithTimeout", onHost("NetworkManager", "--print-config")...).
+					Return([]byte("[main]\n# plugins=ifcfg-rh,keyfile\nplugins=keyfile\n\n[logging]\nlevel=INFO\n"), nil)
+				repo.On("GetAllNodeInterfaces", mock.Anything, "worker-1").Return([]entities.NetworkInterface{}, nil)
+			},
+			want: map[string]entities.DiagnosticStatus{
+				DoctorCheckOSRelease:      entities.DiagnosticPass,
+				DoctorCheckOSType:         entities.DiagnosticPass,
+				DoctorCheckNsenter:        entities.DiagnosticPass,
+				DoctorCheckHostNamespace:  entities.DiagnosticPass,
+				DoctorCheckNetworkManager: entities.DiagnosticPass,
+				DoctorCheckIfcfgPlugin:    entities.DiagnosticFail,
+				DoctorCheckConfigDir:      entities.DiagnosticPass,
+				DoctorCheckDatabase:       entities.DiagnosticPass,
+				DoctorCheckNodeName:       entities.DiagnosticWarn,
+			},
+			healthy: false,
+		},
+		{
+			name: "Ubuntu 노드 - 모든 점검 통과",
+			setupMocks: func(osDetector *MockOSDetector, executor *MockCommandExecutor, fs *MockFileSystem, repo *MockNetworkInterfaceRepository) {
+				fs.On("Exists", constants.OSReleaseFile).Return(true)
+				fs.On("Exists", constants.NetplanConfigDir).Return(true)
+				osDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil)
+				executor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "nsenter", "--version").Return([]byte("nsenter from util-linux 2.37"), nil)
+				executor.On("ExecuteWithTimeout", onHost("true")...).Return([]byte{}, nil)
+				executor.On("ExecuteWithTimeout", onHost("netplan", "--help")...).Return([]byte("usage: netplan"), nil)
+				repo.On("GetAllNodeInterfaces", mock.Anything, "worker-1").Return([]entities.NetworkInterface{{ID: 1}}, nil)
+			},
+			want: map[string]entities.DiagnosticStatus{
+				DoctorCheckOSRelease:     entities.DiagnosticPass,
+				DoctorCheckOSType:        entities.DiagnosticPass,
+				DoctorCheckNsenter:       entities.DiagnosticPass,
+				DoctorCheckHostNamespace: entities.DiagnosticPass,
+				DoctorCheckNetplan:       entities.DiagnosticPass,
+				DoctorCheckConfigDir:     entities.DiagnosticPass,
+				DoctorCheckDatabase:      entities.DiagnosticPass,
+				DoctorCheckNodeName:      entities.DiagnosticPass,
+			},
+			healthy: true,
+		},
+		{
+			name: "마운트, nsenter, DB 모두 없음 - 의존 점검은 건너뜀",
+			setupMocks: func(osDetector *MockOSDetector, executor *MockCommandExecutor, fs *MockFileSystem, repo *MockNetworkInterfaceRepository) {
+				fs.On("Exists", constants.OSReleaseFile).Return(false)
+				osDetector.On("DetectOS").Return(interfaces.OSType(""), fmt.Errorf("cannot read /etc/os-release file"))
+				executor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "nsenter", "--version").Return([]byte(nil), fmt.Errorf("executable file not found"))
+				repo.On("GetAllNodeInterfaces", mock.Anything, "worker-1").Return([]entities.NetworkInterface(nil), fmt.Errorf("connection refused"))
+			},
+			want: map[string]entities.DiagnosticStatus{
+				DoctorCheckOSRelease:     entities.DiagnosticFail,
+				DoctorCheckOSType:        entities.DiagnosticFail,
+				DoctorCheckNsenter:       entities.DiagnosticFail,
+				DoctorCheckHostNamespace: entities.DiagnosticSkip,
+				DoctorCheckConfigDir:     entities.DiagnosticSkip,
+				DoctorCheckDatabase:      entities.DiagnosticFail,
+				DoctorCheckNodeName:      entities.DiagnosticSkip,
+			},
+			healthy: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOSDetector := new(MockOSDetector)
+			mockExecutor := new(MockCommandExecutor)
+			mockFS := new(MockFileSystem)
+			mockRepo := new(MockNetworkInterfaceRepository)
+			tt.setupMocks(mockOSDetector, mockExecutor, mockFS, mockRepo)
+
+			logger := logrus.New()
+			logger.SetLevel(logrus.FatalLevel)
+			clock := &fixedClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+			useCase := NewNodeDoctorUseCase(mockOSDetector, mockExecutor, mockFS, mockRepo, clock, logger)
+
+			report := useCase.Execute(context.Background(), NodeDoctorInput{NodeName: "worker-1"})
+
+			got := make(map[string]entities.DiagnosticStatus, len(report.Checks))
+			for _, check := range report.Checks {
+				got[check.Name] = check.Status
+				if check.Status == entities.DiagnosticFail || check.Status == entities.DiagnosticWarn {
+					assert.NotEmpty(t, check.Remedy, "check %s has no remedy", check.Name)
+				}
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.healthy, report.Healthy())
+			assert.Equal(t, "worker-1", report.NodeName)
+			assert.Equal(t, clock.now, report.GeneratedAt)
+
+			// 점검은 노드를 변경하지 않음
+			mockFS.AssertNotCalled(t, "WriteFile", mock.Anything, mock.Anything, mock.Anything)
+			mockRepo.AssertNotCalled(t, "UpdateInterfaceStatus", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package usecases
+
+import (
+	"multinic-agent/internal/domain/entities"
+	"sort"
+	"sync"
+)
+
+// dry-run 계획 항목의 사유 (드리프트가 원인이면 드리프트 유형을 사용)
+const (
+	planReasonConfigMissing     = "config_missing"
+	planReasonConfigUnreadable  = "config_unreadable"
+	planReasonStatusPending     = "status_pending"
+	planReasonRenamed           = "renamed"
+	planReasonOrphaned          = "orphaned"
+	planReasonOrphanedLink      = "orphaned_link"
+	planReasonQuarantine        = "quarantine"
+	planReasonQuarantineRestore = "quarantine_restore"
+	planReasonQuarantineExpired = "quarantine_expired"
+)
+
+// planRecorder는 dry-run 실행 중 병렬로 계산된 계획 항목을 모읍니다
+type planRecorder struct {
+	mu      sync.Mutex
+	changes []entities.PlannedChange
+}
+
+// add는 계획 항목을 추가합니다
+func (r *planRecorder) add(change entities.PlannedChange) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.changes = append(r.changes, change)
+}
+
+// list는 병렬 처리 순서와 무관하게 같은 결과가 나오도록 인터페이스 이름 순으로 정렬된 계획 항목을 반환합니다
+func (r *planRecorder) list() []entities.PlannedChange {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	changes := append([]entities.PlannedChange{}, r.changes...)
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].InterfaceName < changes[j].InterfaceName
+	})
+	return changes
+}
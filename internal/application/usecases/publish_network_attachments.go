@@ -0,0 +1,114 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"multinic-agent/internal/domain/entities"
+	domainErrors "multinic-agent/internal/domain/errors"
+	"multinic-agent/internal/domain/interfaces"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// invalidAttachmentNameChars는 Kubernetes 오브젝트 이름(DNS-1123)에 쓸 수 없는 문자입니다
+var invalidAttachmentNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// attachmentNamePrefix는 서브넷 CIDR로 만든 정의 이름의 접두사입니다
+const attachmentNamePrefix = "multinic-"
+
+// PublishNetworkAttachmentsInput은 보조 네트워크 정의 게시 유스케이스의 입력 파라미터입니다
+type PublishNetworkAttachmentsInput struct {
+	Results []entities.InterfaceResult // 설정 유스케이스의 인터페이스별 결과
+}
+
+// PublishNetworkAttachmentsOutput은 보조 네트워크 정의 게시 유스케이스의 출력 데이터입니다
+type PublishNetworkAttachmentsOutput struct {
+	Applied []string // 생성하거나 갱신을 시도해 성공한 정의 이름
+	Skipped []string // 서브넷 CIDR이 없어 게시하지 않은 인터페이스
+	Errors  []error  // 게시 실패와 같은 서브넷을 가리키는 인터페이스가 둘 이상인 충돌
+}
+
+// PublishNetworkAttachmentsUseCase는 설정이 완료된 인터페이스의 서브넷마다 파드가 연결할 수 있는 보조 네트워크 정의를 게시하는 유스케이스입니다
+// 정의는 서브넷 CIDR로 이름을 정해 여러 노드가 공유하므로 인터페이스가 사라져도 삭제하지 않습니다
+type PublishNetworkAttachmentsUseCase struct {
+	publisher interfaces.NetworkAttachmentPublisher
+	logger    *logrus.Logger
+
+	mu      sync.Mutex
+	applied map[string]entities.NetworkAttachment // 마지막으로 게시에 성공한 정의 (바뀐 경우에만 다시 게시)
+}
+
+// NewPublishNetworkAttachmentsUseCase는 새로운 PublishNetworkAttachmentsUseCase를 생성합니다
+func NewPublishNetworkAttachmentsUseCase(publisher interfaces.NetworkAttachmentPublisher, logger *logrus.Logger) *PublishNetworkAttachmentsUseCase {
+	return &PublishNetworkAttachmentsUseCase{
+		publisher: publisher,
+		logger:    logger,
+		applied:   make(map[string]entities.NetworkAttachment),
+	}
+}
+
+// Execute는 보조 네트워크 정의 게시 유스케이스를 실행합니다
+func (uc *PublishNetworkAttachmentsUseCase) Execute(ctx context.Context, input PublishNetworkAttachmentsInput) *PublishNetworkAttachmentsOutput {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	output := &PublishNetworkAttachmentsOutput{}
+	seen := make(map[string]entities.NetworkAttachment) // 이번 실행에서 정의 이름별로 처음 본 인터페이스
+
+	for _, result := range input.Results {
+		// 호스트 쪽 설정이 끝난 인터페이스만 게시
+		if result.Result != entities.ResultConfigured && result.Result != entities.ResultInSync {
+			continue
+		}
+		if result.CIDR == "" {
+			output.Skipped = append(output.Skipped, result.InterfaceName)
+			continue
+		}
+
+		attachment := entities.NetworkAttachment{
+			Name:          attachmentName(result.CIDR),
+			InterfaceName: result.InterfaceName,
+			CIDR:          result.CIDR,
+		}
+		// 같은 서브넷의 인터페이스가 둘 이상이면 정의의 master 장치를 정할 수 없으므로 처음 것만 게시
+		if first, ok := seen[attachment.Name]; ok {
+			if first != attachment {
+				output.Errors = append(output.Errors, domainErrors.NewConflictError(fmt.Sprintf(
+					"network attachment %s: interface %s (%s) conflicts with interface %s (%s)",
+					attachment.Name, attachment.InterfaceName, attachment.CIDR, first.InterfaceName, first.CIDR)))
+			}
+			continue
+		}
+		seen[attachment.Name] = attachment
+
+		if previous, ok := uc.applied[attachment.Name]; ok && previous == attachment {
+			continue
+		}
+
+		if err := uc.publisher.Apply(ctx, attachment); err != nil {
+			output.Errors = append(output.Errors, fmt.Errorf("failed to publish network attachment %s: %w", attachment.Name, err))
+			continue
+		}
+		uc.applied[attachment.Name] = attachment
+		output.Applied = append(output.Applied, attachment.Name)
+	}
+
+	for _, err := range output.Errors {
+		uc.logger.WithError(err).Warn("Failed to publish network attachment definition")
+	}
+	if len(output.Applied) > 0 {
+		uc.logger.WithField("attachments", output.Applied).Info("Network attachment definitions published")
+	}
+
+	return output
+}
+
+// attachmentName은 서브넷 CIDR을 Kubernetes 오브젝트 이름으로 변환합니다 (예: 10.10.0.0/24 → multinic-10-10-0-0-24)
+// 노드마다 인터페이스 이름이 달라도 같은 서브넷은 같은 정의를 가리킵니다
+func attachmentName(cidr string) string {
+	name := invalidAttachmentNameChars.ReplaceAllString(strings.ToLower(cidr), "-")
+	return attachmentNamePrefix + strings.Trim(name, "-")
+}
@@ -0,0 +1,83 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"multinic-agent/internal/domain/entities"
+	domainErrors "multinic-agent/internal/domain/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockNetworkAttachmentPublisher는 NetworkAttachmentPublisher의 목 구현체입니다
+type MockNetworkAttachmentPublisher struct {
+	mock.Mock
+}
+
+func (m *MockNetworkAttachmentPublisher) Apply(ctx context.Context, attachment entities.NetworkAttachment) error {
+	return m.Called(ctx, attachment).Error(0)
+}
+
+func TestPublishNetworkAttachmentsUseCase_Execute(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	storage := entities.NetworkAttachment{Name: "multinic-10-10-0-0-24", InterfaceName: "multinic0", CIDR: "10.10.0.0/24"}
+	backup := entities.NetworkAttachment{Name: "multinic-10-20-0-0-24", InterfaceName: "Backup_Net", CIDR: "10.20.0.0/24"}
+
+	publisher := new(MockNetworkAttachmentPublisher)
+	publisher.On("Apply", mock.Anything, storage).Return(nil).Once()
+	publisher.On("Apply", mock.Anything, backup).Return(fmt.Errorf("connection refused")).Once()
+	publisher.On("Apply", mock.Anything, backup).Return(nil).Once()
+	useCase := NewPublishNetworkAttachmentsUseCase(publisher, logger)
+
+	results := []entities.InterfaceResult{
+		{InterfaceID: 1, InterfaceName: "multinic0", CIDR: "10.10.0.0/24", Result: entities.ResultConfigured},
+		{InterfaceID: 2, InterfaceName: "Backup_Net", CIDR: "10.20.0.0/24", Result: entities.ResultInSync},
+		{InterfaceID: 3, InterfaceName: "multinic2", CIDR: "10.30.0.0/24", Result: entities.ResultFailed},
+		{InterfaceID: 4, InterfaceName: "multinic3", Result: entities.ResultConfigured},
+	}
+
+	// 실패한 인터페이스는 게시하지 않고, CIDR이 없는 인터페이스는 건너뜀
+	output := useCase.Execute(context.Background(), PublishNetworkAttachmentsInput{Results: results})
+	assert.Equal(t, []string{"multinic-10-10-0-0-24"}, output.Applied)
+	assert.Equal(t, []string{"multinic3"}, output.Skipped)
+	assert.Len(t, output.Errors, 1)
+
+	// 게시에 성공한 정의는 바뀌지 않으면 다시 게시하지 않고, 실패한 정의는 재시도
+	output = useCase.Execute(context.Background(), PublishNetworkAttachmentsInput{Results: results})
+	assert.Equal(t, []string{"multinic-10-20-0-0-24"}, output.Applied)
+	assert.Empty(t, output.Errors)
+	publisher.AssertExpectations(t)
+}
+
+func TestPublishNetworkAttachmentsUseCase_Execute_SharedSubnet(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	first := entities.NetworkAttachment{Name: "multinic-10-10-0-0-24", InterfaceName: "multinic0", CIDR: "10.10.0.0/24"}
+	publisher := new(MockNetworkAttachmentPublisher)
+	publisher.On("Apply", mock.Anything, first).Return(nil).Once()
+	useCase := NewPublishNetworkAttachmentsUseCase(publisher, logger)
+
+	// 같은 서브넷을 가리키는 두 번째 인터페이스는 정의의 master를 바꾸지 않고 충돌로 보고
+	output := useCase.Execute(context.Background(), PublishNetworkAttachmentsInput{Results: []entities.InterfaceResult{
+		{InterfaceID: 1, InterfaceName: "multinic0", CIDR: "10.10.0.0/24", Result: entities.ResultConfigured},
+		{InterfaceID: 2, InterfaceName: "multinic1", CIDR: "10.10.0.0/24", Result: entities.ResultConfigured},
+	}})
+
+	assert.Equal(t, []string{"multinic-10-10-0-0-24"}, output.Applied)
+	require.Len(t, output.Errors, 1)
+	assert.True(t, domainErrors.IsConflictError(output.Errors[0]))
+	publisher.AssertExpectations(t)
+}
+
+func TestAttachmentName(t *testing.T) {
+	assert.Equal(t, "multinic-10-10-0-0-24", attachmentName("10.10.0.0/24"))
+	assert.Equal(t, "multinic-fd00-64", attachmentName("FD00::/64"))
+}
@@ -0,0 +1,200 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/interfaces"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// PublishNodeStatusInput은 노드 상태 게시 유스케이스의 입력 파라미터입니다
+type PublishNodeStatusInput struct {
+	NodeName          string
+	Results           []entities.InterfaceResult // 설정 유스케이스의 인터페이스별 결과
+	DeletedInterfaces []string                   // 이번 사이클에 삭제된 고아 인터페이스
+}
+
+// PublishNodeStatusOutput은 노드 상태 게시 유스케이스의 출력 데이터입니다
+type PublishNodeStatusOutput struct {
+	Events        []entities.NodeEvent // 게시를 시도한 이벤트
+	StatusUpdated bool                 // 컨디션과 어노테이션을 갱신했는지 여부
+	GateUpdated   bool                 // 노드의 taint 또는 label을 갱신했는지 여부
+	Errors        []error
+}
+
+// PublishNodeStatusUseCase는 사이클 결과를 노드 오브젝트의 이벤트, 컨디션, 어노테이션, 준비 상태 taint/label로 게시하는 유스케이스입니다
+// 게시 실패는 네트워크 설정에 영향이 없으므로 출력의 Errors로만 보고합니다
+type PublishNodeStatusUseCase struct {
+	reporter interfaces.NodeReporter      // nil이면 이벤트와 컨디션을 게시하지 않음
+	gate     interfaces.NodeReadinessGate // nil이면 노드 taint/label을 관리하지 않음
+	logger   *logrus.Logger
+
+	mu           sync.Mutex
+	lastFailures map[int]string              // 인터페이스 ID별로 이미 게시한 실패 메시지 (같은 실패를 매 사이클 반복 게시하지 않음)
+	lastReport   *entities.NodeNetworkReport // 마지막으로 게시에 성공한 노드 상태
+	lastReady    *bool                       // 마지막으로 노드에 반영한 네트워크 준비 여부
+}
+
+// NewPublishNodeStatusUseCase는 새로운 PublishNodeStatusUseCase를 생성합니다
+func NewPublishNodeStatusUseCase(reporter interfaces.NodeReporter, gate interfaces.NodeReadinessGate, logger *logrus.Logger) *PublishNodeStatusUseCase {
+	return &PublishNodeStatusUseCase{
+		reporter:     reporter,
+		gate:         gate,
+		logger:       logger,
+		lastFailures: make(map[int]string),
+	}
+}
+
+// Execute는 노드 상태 게시 유스케이스를 실행합니다
+func (uc *PublishNodeStatusUseCase) Execute(ctx context.Context, input PublishNodeStatusInput) *PublishNodeStatusOutput {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	output := &PublishNodeStatusOutput{}
+	report := buildNodeNetworkReport(input.Results)
+
+	if uc.reporter != nil {
+		uc.publishReport(ctx, input, report, output)
+	}
+
+	// 모든 인터페이스가 설정된 경우에만 노드를 준비 상태로 표시 (준비 여부가 바뀐 경우에만 갱신)
+	if uc.gate != nil && (uc.lastReady == nil || *uc.lastReady != report.Ready) {
+		if err := uc.gate.SetNetworkReady(ctx, input.NodeName, report.Ready); err != nil {
+			output.Errors = append(output.Errors, fmt.Errorf("failed to update node readiness gate: %w", err))
+		} else {
+			ready := report.Ready
+			uc.lastReady = &ready
+			output.GateUpdated = true
+		}
+	}
+
+	for _, err := range output.Errors {
+		uc.logger.WithError(err).WithField("node_name", input.NodeName).Warn("Failed to publish NIC status to the node object")
+	}
+
+	return output
+}
+
+// publishReport는 이벤트를 기록하고 노드 상태가 바뀐 경우에만 컨디션과 어노테이션을 갱신합니다
+func (uc *PublishNodeStatusUseCase) publishReport(ctx context.Context, input PublishNodeStatusInput, report entities.NodeNetworkReport, output *PublishNodeStatusOutput) {
+	// 1. 설정 결과와 고아 삭제를 이벤트로 게시
+	output.Events = uc.collectEvents(input)
+	for _, event := range output.Events {
+		if err := uc.reporter.RecordEvent(ctx, input.NodeName, event); err != nil {
+			output.Errors = append(output.Errors, fmt.Errorf("failed to record %s event: %w", event.Reason, err))
+		}
+	}
+
+	// 2. 컨디션과 어노테이션 갱신
+	if uc.lastReport == nil || !reflect.DeepEqual(*uc.lastReport, report) {
+		if err := uc.reporter.UpdateNodeStatus(ctx, input.NodeName, report); err != nil {
+			output.Errors = append(output.Errors, fmt.Errorf("failed to update node status: %w", err))
+		} else {
+			uc.lastReport = &report
+			output.StatusUpdated = true
+		}
+	}
+}
+
+// collectEvents는 이번 사이클에서 게시할 이벤트를 만듭니다
+// 실패는 메시지가 바뀌었을 때만 다시 게시하고, 설정에 성공하거나 동기화된 인터페이스는 실패 기록을 지웁니다
+func (uc *PublishNodeStatusUseCase) collectEvents(input PublishNodeStatusInput) []entities.NodeEvent {
+	var events []entities.NodeEvent
+	seen := make(map[int]bool, len(input.Results))
+
+	for _, result := range input.Results {
+		seen[result.InterfaceID] = true
+		name := interfaceLabel(result)
+
+		switch result.Result {
+		case entities.ResultConfigured:
+			delete(uc.lastFailures, result.InterfaceID)
+			events = append(events, entities.NodeEvent{
+				Type:    entities.NodeEventNormal,
+				Reason:  entities.EventReasonInterfaceConfigured,
+				Message: fmt.Sprintf("Configured interface %s (%s, reason: %s)", name, result.MacAddress, result.Drift),
+			})
+		case entities.ResultInSync:
+			delete(uc.lastFailures, result.InterfaceID)
+		case entities.ResultFailed:
+			if uc.lastFailures[result.InterfaceID] == result.Error {
+				continue
+			}
+			uc.lastFailures[result.InterfaceID] = result.Error
+			events = append(events, entities.NodeEvent{
+				Type:    entities.NodeEventWarning,
+				Reason:  entities.EventReasonInterfaceConfigFailed,
+				Message: fmt.Sprintf("Failed to configure interface %s (%s): %s", name, result.MacAddress, result.Error),
+			})
+			if result.RolledBack {
+				events = append(events, entities.NodeEvent{
+					Type:    entities.NodeEventWarning,
+					Reason:  entities.EventReasonInterfaceRolledBack,
+					Message: fmt.Sprintf("Rolled back configuration of interface %s (%s)", name, result.MacAddress),
+				})
+			}
+		}
+	}
+
+	// 더 이상 관리 대상이 아닌 인터페이스의 실패 기록 정리
+	for id := range uc.lastFailures {
+		if !seen[id] {
+			delete(uc.lastFailures, id)
+		}
+	}
+
+	for _, name := range input.DeletedInterfaces {
+		events = append(events, entities.NodeEvent{
+			Type:    entities.NodeEventNormal,
+			Reason:  entities.EventReasonOrphanInterfaceDeleted,
+			Message: fmt.Sprintf("Deleted orphaned interface %s", name),
+		})
+	}
+
+	return events
+}
+
+// buildNodeNetworkReport는 인터페이스별 결과로 노드의 NIC 준비 상태를 만듭니다
+func buildNodeNetworkReport(results []entities.InterfaceResult) entities.NodeNetworkReport {
+	report := entities.NodeNetworkReport{
+		Ready:      true,
+		Reason:     entities.ConditionReasonInterfacesConfigured,
+		Interfaces: make([]entities.ManagedInterface, 0, len(results)),
+	}
+
+	var failed []string
+	for _, result := range results {
+		report.Interfaces = append(report.Interfaces, entities.ManagedInterface{
+			Name:       result.InterfaceName,
+			MacAddress: result.MacAddress,
+			Address:    result.Address,
+			Result:     result.Result,
+		})
+		if result.Result == entities.ResultFailed {
+			failed = append(failed, interfaceLabel(result))
+		}
+	}
+
+	if len(failed) > 0 {
+		report.Ready = false
+		report.Reason = entities.ConditionReasonInterfacesFailed
+		report.Message = fmt.Sprintf("%d of %d interfaces failed: %s", len(failed), len(results), strings.Join(failed, ", "))
+	} else {
+		report.Message = fmt.Sprintf("%d interfaces configured", len(results))
+	}
+
+	return report
+}
+
+// interfaceLabel은 이벤트 메시지에 사용할 인터페이스 이름을 반환합니다 (이름이 없으면 DB ID)
+func interfaceLabel(result entities.InterfaceResult) string {
+	if result.InterfaceName != "" {
+		return result.InterfaceName
+	}
+	return fmt.Sprintf("#%d", result.InterfaceID)
+}
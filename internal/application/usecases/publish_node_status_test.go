@@ -0,0 +1,171 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"multinic-agent/internal/domain/entities"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockNodeReporter는 NodeReporter의 목 구현체입니다
+type MockNodeReporter struct {
+	mock.Mock
+}
+
+func (m *MockNodeReporter) RecordEvent(ctx context.Context, nodeName string, event entities.NodeEvent) error {
+	return m.Called(ctx, nodeName, event).Error(0)
+}
+
+func (m *MockNodeReporter) UpdateNodeStatus(ctx context.Context, nodeName string, report entities.NodeNetworkReport) error {
+	return m.Called(ctx, nodeName, report).Error(0)
+}
+
+// MockNodeReadinessGate는 NodeReadinessGate의 목 구현체입니다
+type MockNodeReadinessGate struct {
+	mock.Mock
+}
+
+func (m *MockNodeReadinessGate) SetNetworkReady(ctx context.Context, nodeName string, ready bool) error {
+	return m.Called(ctx, nodeName, ready).Error(0)
+}
+
+func TestPublishNodeStatusUseCase_Execute(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	reporter := new(MockNodeReporter)
+	reporter.On("RecordEvent", mock.Anything, "worker-1", mock.Anything).Return(nil)
+	reporter.On("UpdateNodeStatus", mock.Anything, "worker-1", mock.Anything).Return(nil)
+	useCase := NewPublishNodeStatusUseCase(reporter, nil, logger)
+
+	failed := entities.InterfaceResult{
+		InterfaceID: 2, InterfaceName: "multinic1", MacAddress: "fa:16:3e:00:00:02", Address: "10.0.0.2",
+		Result: entities.ResultFailed, Error: "netplan apply failed", RolledBack: true,
+	}
+	configured := entities.InterfaceResult{
+		InterfaceID: 1, InterfaceName: "multinic0", MacAddress: "fa:16:3e:00:00:01", Address: "10.0.0.1",
+		Result: entities.ResultConfigured, Drift: "config_missing",
+	}
+	input := PublishNodeStatusInput{
+		NodeName:          "worker-1",
+		Results:           []entities.InterfaceResult{configured, failed},
+		DeletedInterfaces: []string{"multinic5"},
+	}
+
+	// 첫 사이클: 설정 성공, 실패와 롤백, 고아 삭제 이벤트와 NotReady 컨디션 게시
+	output := useCase.Execute(context.Background(), input)
+
+	reasons := make([]string, 0, len(output.Events))
+	for _, event := range output.Events {
+		reasons = append(reasons, event.Reason)
+	}
+	assert.Equal(t, []string{
+		entities.EventReasonInterfaceConfigured,
+		entities.EventReasonInterfaceConfigFailed,
+		entities.EventReasonInterfaceRolledBack,
+		entities.EventReasonOrphanInterfaceDeleted,
+	}, reasons)
+	assert.Equal(t, entities.NodeEventWarning, output.Events[1].Type)
+	assert.True(t, output.StatusUpdated)
+	assert.Empty(t, output.Errors)
+
+	report := reporter.Calls[len(reporter.Calls)-1].Arguments.Get(2).(entities.NodeNetworkReport)
+	assert.False(t, report.Ready)
+	assert.Equal(t, entities.ConditionReasonInterfacesFailed, report.Reason)
+	assert.Contains(t, report.Message, "multinic1")
+	assert.Equal(t, []entities.ManagedInterface{
+		{Name: "multinic0", MacAddress: "fa:16:3e:00:00:01", Address: "10.0.0.1", Result: entities.ResultConfigured},
+		{Name: "multinic1", MacAddress: "fa:16:3e:00:00:02", Address: "10.0.0.2", Result: entities.ResultFailed},
+	}, report.Interfaces)
+
+	// 같은 실패가 반복되면 이벤트와 노드 상태를 다시 게시하지 않음
+	configured.Result = entities.ResultInSync
+	output = useCase.Execute(context.Background(), PublishNodeStatusInput{
+		NodeName: "worker-1",
+		Results:  []entities.InterfaceResult{configured, failed},
+	})
+	assert.Empty(t, output.Events)
+	assert.True(t, output.StatusUpdated, "result of multinic0 changed to in_sync")
+
+	output = useCase.Execute(context.Background(), PublishNodeStatusInput{
+		NodeName: "worker-1",
+		Results:  []entities.InterfaceResult{configured, failed},
+	})
+	assert.Empty(t, output.Events)
+	assert.False(t, output.StatusUpdated)
+
+	// 복구되면 Ready 컨디션으로 갱신
+	failed.Result = entities.ResultConfigured
+	failed.Error = ""
+	output = useCase.Execute(context.Background(), PublishNodeStatusInput{
+		NodeName: "worker-1",
+		Results:  []entities.InterfaceResult{configured, failed},
+	})
+	assert.Len(t, output.Events, 1)
+	report = reporter.Calls[len(reporter.Calls)-1].Arguments.Get(2).(entities.NodeNetworkReport)
+	assert.True(t, report.Ready)
+	assert.Equal(t, entities.ConditionReasonInterfacesConfigured, report.Reason)
+}
+
+func TestPublishNodeStatusUseCase_RetriesStatusAfterFailure(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	reporter := new(MockNodeReporter)
+	reporter.On("UpdateNodeStatus", mock.Anything, "worker-1", mock.Anything).Return(fmt.Errorf("connection refused")).Once()
+	reporter.On("UpdateNodeStatus", mock.Anything, "worker-1", mock.Anything).Return(nil).Once()
+	useCase := NewPublishNodeStatusUseCase(reporter, nil, logger)
+
+	input := PublishNodeStatusInput{
+		NodeName: "worker-1",
+		Results:  []entities.InterfaceResult{{InterfaceID: 1, InterfaceName: "multinic0", Result: entities.ResultInSync}},
+	}
+
+	output := useCase.Execute(context.Background(), input)
+	assert.False(t, output.StatusUpdated)
+	assert.Len(t, output.Errors, 1)
+
+	// API 서버 장애 후에는 상태가 같아도 다시 게시
+	output = useCase.Execute(context.Background(), input)
+	assert.True(t, output.StatusUpdated)
+	assert.Empty(t, output.Errors)
+	reporter.AssertExpectations(t)
+}
+
+func TestPublishNodeStatusUseCase_ReadinessGate(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	gate := new(MockNodeReadinessGate)
+	gate.On("SetNetworkReady", mock.Anything, "worker-1", false).Return(nil).Once()
+	gate.On("SetNetworkReady", mock.Anything, "worker-1", true).Return(nil).Once()
+	// 이벤트 게시가 비활성화되어도 준비 상태는 관리
+	useCase := NewPublishNodeStatusUseCase(nil, gate, logger)
+
+	pending := []entities.InterfaceResult{
+		{InterfaceID: 1, InterfaceName: "multinic0", Result: entities.ResultConfigured},
+		{InterfaceID: 2, InterfaceName: "multinic1", Result: entities.ResultFailed, Error: "netplan apply failed"},
+	}
+	ready := []entities.InterfaceResult{
+		{InterfaceID: 1, InterfaceName: "multinic0", Result: entities.ResultInSync},
+		{InterfaceID: 2, InterfaceName: "multinic1", Result: entities.ResultConfigured},
+	}
+
+	// 실패한 인터페이스가 있으면 준비되지 않음으로 표시, 같은 상태면 다시 갱신하지 않음
+	output := useCase.Execute(context.Background(), PublishNodeStatusInput{NodeName: "worker-1", Results: pending})
+	assert.True(t, output.GateUpdated)
+	assert.Empty(t, output.Events)
+	output = useCase.Execute(context.Background(), PublishNodeStatusInput{NodeName: "worker-1", Results: pending})
+	assert.False(t, output.GateUpdated)
+
+	// 모든 인터페이스가 설정되면 준비 상태로 표시
+	output = useCase.Execute(context.Background(), PublishNodeStatusInput{NodeName: "worker-1", Results: ready})
+	assert.True(t, output.GateUpdated)
+	assert.Empty(t, output.Errors)
+	gate.AssertExpectations(t)
+}
@@ -0,0 +1,176 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"multinic-agent/internal/domain/constants"
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/errors"
+	"multinic-agent/internal/domain/interfaces"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// NodeSnapshot은 한 사이클의 설정/삭제 단계가 함께 사용하는 노드 상태 스냅샷입니다
+// 두 단계가 같은 노드 이름, 저장소 행, 설정 파일 목록, 링크 목록을 기준으로 계획하도록 사이클 시작 시 한 번만 조회합니다
+type NodeSnapshot struct {
+	NodeName    string
+	OSType      interfaces.OSType
+	ConfigDir   string                      // OS별 설정 파일 디렉토리 (지원하지 않는 OS면 빈 값)
+	Interfaces  []entities.NetworkInterface // 저장소의 노드 인터페이스 행
+	ConfigFiles []string                    // 설정 디렉토리의 파일 목록
+	Links       []entities.LinkState        // 커널 링크 목록
+	TakenAt     time.Time
+
+	configFilesErr error // 파일 목록 조회 실패 (삭제 단계에서 에러로 보고)
+	linksErr       error // 링크 목록 조회 실패 (삭제 단계에서 링크 고아 감지만 건너뜀)
+}
+
+// ReconcileNetworkInput은 reconcile 유스케이스의 입력 파라미터입니다
+type ReconcileNetworkInput struct {
+	NodeName string
+	DryRun   bool
+}
+
+// ReconcileNetworkOutput은 reconcile 유스케이스의 출력 결과입니다
+type ReconcileNetworkOutput struct {
+	Snapshot  *NodeSnapshot
+	Configure *ConfigureNetworkOutput
+	Delete    *DeleteNetworkOutput
+	Plan      entities.ReconcilePlan // 삭제 → 생성/수정의 실행 순서로 합친 계획 (dry-run일 때 채워짐)
+}
+
+// ReconcileNetworkUseCase는 한 사이클의 스냅샷을 만들고 삭제와 설정을 안전한 순서로 실행하는 유스케이스입니다
+// 고아 삭제가 해제한 이름과 설정 파일을 같은 사이클의 설정 단계가 사용할 수 있도록 삭제를 먼저 실행합니다
+type ReconcileNetworkUseCase struct {
+	configureNetwork *ConfigureNetworkUseCase
+	deleteNetwork    *DeleteNetworkUseCase
+	repository       interfaces.NetworkInterfaceRepository
+	osDetector       interfaces.OSDetector
+	fileSystem       interfaces.FileSystem
+	linkManager      interfaces.LinkManager
+	clock            interfaces.Clock
+	logger           *logrus.Logger
+}
+
+// NewReconcileNetworkUseCase는 새로운 ReconcileNetworkUseCase를 생성합니다
+func NewReconcileNetworkUseCase(
+	configureNetwork *ConfigureNetworkUseCase,
+	deleteNetwork *DeleteNetworkUseCase,
+	repository interfaces.NetworkInterfaceRepository,
+	osDetector interfaces.OSDetector,
+	fileSystem interfaces.FileSystem,
+	linkManager interfaces.LinkManager,
+	clock interfaces.Clock,
+	logger *logrus.Logger,
+) *ReconcileNetworkUseCase {
+	return &ReconcileNetworkUseCase{
+		configureNetwork: configureNetwork,
+		deleteNetwork:    deleteNetwork,
+		repository:       repository,
+		osDetector:       osDetector,
+		fileSystem:       fileSystem,
+		linkManager:      linkManager,
+		clock:            clock,
+		logger:           logger,
+	}
+}
+
+// Execute는 스냅샷을 만들고 삭제 단계와 설정 단계를 차례로 실행합니다
+// 삭제 실패는 치명적이지 않으므로 삭제 결과의 Errors로 반환합니다
+func (uc *ReconcileNetworkUseCase) Execute(ctx context.Context, input ReconcileNetworkInput) (*ReconcileNetworkOutput, error) {
+	snapshot, err := uc.takeSnapshot(ctx, input.NodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 고아 인터페이스 정리 (이름과 설정 파일을 먼저 해제)
+	deleteOutput, err := uc.deleteNetwork.Execute(ctx, DeleteNetworkInput{
+		NodeName: input.NodeName,
+		DryRun:   input.DryRun,
+		Snapshot: snapshot,
+	})
+	if err != nil {
+		uc.logger.WithError(err).Error("Failed to process orphaned interface deletion")
+		deleteOutput = newDeleteNetworkOutput()
+		deleteOutput.Errors = append(deleteOutput.Errors, err)
+	}
+
+	// 2. 네트워크 설정 (생성/수정)
+	configOutput, err := uc.configureNetwork.Execute(ctx, ConfigureNetworkInput{
+		NodeName: input.NodeName,
+		DryRun:   input.DryRun,
+		Snapshot: snapshot,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]entities.PlannedChange, 0, len(deleteOutput.Plan)+len(configOutput.Plan))
+	changes = append(changes, deleteOutput.Plan...)
+	changes = append(changes, configOutput.Plan...)
+
+	return &ReconcileNetworkOutput{
+		Snapshot:  snapshot,
+		Configure: configOutput,
+		Delete:    deleteOutput,
+		Plan: entities.ReconcilePlan{
+			NodeName:    input.NodeName,
+			GeneratedAt: uc.clock.Now(),
+			Changes:     changes,
+		},
+	}, nil
+}
+
+// takeSnapshot은 노드의 저장소 행, 설정 파일 목록, 링크 목록을 한 번에 조회합니다
+// OS 감지나 저장소 조회에 실패하면 사이클 전체를 실패 처리하고, 파일/링크 목록 조회 실패는 삭제 단계가 처리하도록 기록합니다
+func (uc *ReconcileNetworkUseCase) takeSnapshot(ctx context.Context, nodeName string) (*NodeSnapshot, error) {
+	osType, err := uc.osDetector.DetectOS()
+	if err != nil {
+		return nil, errors.NewSystemError("failed to detect OS type", err)
+	}
+
+	rows, err := uc.repository.GetAllNodeInterfaces(ctx, nodeName)
+	if err != nil {
+		return nil, errors.NewSystemError("failed to get node interfaces", err)
+	}
+
+	snapshot := &NodeSnapshot{
+		NodeName:   nodeName,
+		OSType:     osType,
+		ConfigDir:  configDirForOS(osType),
+		Interfaces: rows,
+		TakenAt:    uc.clock.Now(),
+	}
+
+	if snapshot.ConfigDir != "" {
+		snapshot.ConfigFiles, err = uc.fileSystem.ListFiles(snapshot.ConfigDir)
+		if err != nil {
+			snapshot.configFilesErr = fmt.Errorf("failed to list files in directory %s: %w", snapshot.ConfigDir, err)
+		}
+	}
+	snapshot.Links, snapshot.linksErr = uc.linkManager.ListLinks(ctx)
+
+	uc.logger.WithFields(logrus.Fields{
+		"node_name":       nodeName,
+		"os_type":         osType,
+		"interface_count": len(snapshot.Interfaces),
+		"config_files":    len(snapshot.ConfigFiles),
+		"links":           len(snapshot.Links),
+	}).Debug("Node snapshot taken")
+
+	return snapshot, nil
+}
+
+// configDirForOS는 OS 타입별 설정 파일 디렉토리를 반환합니다 (지원하지 않는 OS면 빈 값)
+func configDirForOS(osType interfaces.OSType) string {
+	switch osType {
+	case interfaces.OSTypeUbuntu:
+		return constants.NetplanConfigDir
+	case interfaces.OSTypeRHEL:
+		return constants.RHELNetworkScriptsDir
+	default:
+		return ""
+	}
+}
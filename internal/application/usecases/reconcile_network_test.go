@@ -0,0 +1,122 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/errors"
+	"multinic-agent/internal/domain/interfaces"
+	"multinic-agent/internal/domain/services"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestReconcileUseCase는 목 의존성으로 설정/삭제 유스케이스를 묶은 reconcile 유스케이스를 생성합니다
+func newTestReconcileUseCase(repo *MockNetworkInterfaceRepository, configurer *MockNetworkConfigurer, fs *MockFileSystem, osDetector *MockOSDetector, linkManager *MockLinkManager) *ReconcileNetworkUseCase {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	executor := new(MockCommandExecutor)
+	executor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+	rollbacker := new(MockNetworkRollbacker)
+	namingService := services.NewInterfaceNamingService(fs, executor, entities.DefaultNamingScheme(), nil)
+	clock := &fixedClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	configure := NewConfigureNetworkUseCase(repo, configurer, rollbacker, configurer, nil, namingService, fs, osDetector, "test-node", logger, 2)
+	deleteNetwork := NewDeleteNetworkUseCase(osDetector, rollbacker, namingService, repo, fs, linkManager, services.NewLinkCleaner(linkManager, false), newTestOrphanGuard(services.DeletionSafetyPolicy{}), nil, "test-node", logger)
+	return NewReconcileNetworkUseCase(configure, deleteNetwork, repo, osDetector, fs, linkManager, clock, logger)
+}
+
+func TestReconcileNetworkUseCase_Execute_SingleSnapshot(t *testing.T) {
+	repo := new(MockNetworkInterfaceRepository)
+	configurer := new(MockNetworkConfigurer)
+	fs := new(MockFileSystem)
+	osDetector := new(MockOSDetector)
+	linkManager := new(MockLinkManager)
+
+	// 스냅샷 조회는 사이클당 한 번만 일어나야 함
+	osDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil).Once()
+	repo.On("GetAllNodeInterfaces", mock.Anything, "test-node").Return([]entities.NetworkInterface{
+		{ID: 3, MacAddress: "fa:16:3e:33:33:33", AttachedNodeName: "test-node", Address: "192.168.3.10", CIDR: "192.168.3.0/24", MTU: 1500, Status: entities.StatusPending},
+	}, nil).Once()
+	fs.On("ListFiles", "/etc/netplan").Return([]string{"91-multinic1.yaml"}, nil).Once()
+	linkManager.On("ListLinks", mock.Anything).Return([]entities.LinkState{}, nil).Once()
+
+	// DB에서 사라진 MAC의 설정 파일 (삭제 대상)
+	fs.On("ReadFile", "/etc/netplan/91-multinic1.yaml").Return(ownedContent(1, "fa:16:3e:22:22:22", "network:\n  ethernets:\n    multinic1:\n      match:\n        macaddress: fa:16:3e:22:22:22\n  version: 2"), nil)
+
+	// 새 인터페이스 (생성 대상)
+	for i := 0; i < 10; i++ {
+		fs.On("Exists", fmt.Sprintf("/sys/class/net/multinic%d", i)).Return(false).Maybe()
+	}
+	configurer.On("FindConfigPath", "multinic0").Return("")
+	configurer.On("ConfigPath", "multinic0").Return("/etc/netplan/90-multinic0.yaml")
+
+	useCase := newTestReconcileUseCase(repo, configurer, fs, osDetector, linkManager)
+	output, err := useCase.Execute(context.Background(), ReconcileNetworkInput{NodeName: "test-node", DryRun: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, "test-node", output.Snapshot.NodeName)
+	assert.Equal(t, "test-node", output.Plan.NodeName)
+
+	// 이름을 해제하는 삭제가 생성보다 먼저 계획됨
+	require.Len(t, output.Plan.Changes, 2)
+	assert.Equal(t, entities.PlanActionDelete, output.Plan.Changes[0].Action)
+	assert.Equal(t, "multinic1", output.Plan.Changes[0].InterfaceName)
+	assert.Equal(t, entities.PlanActionCreate, output.Plan.Changes[1].Action)
+	assert.Equal(t, "multinic0", output.Plan.Changes[1].InterfaceName)
+	assert.Equal(t, 3, output.Plan.Changes[1].InterfaceID)
+
+	osDetector.AssertExpectations(t)
+	repo.AssertExpectations(t)
+	fs.AssertExpectations(t)
+	linkManager.AssertExpectations(t)
+}
+
+func TestReconcileNetworkUseCase_Execute_SnapshotFailure(t *testing.T) {
+	repo := new(MockNetworkInterfaceRepository)
+	configurer := new(MockNetworkConfigurer)
+	fs := new(MockFileSystem)
+	osDetector := new(MockOSDetector)
+	linkManager := new(MockLinkManager)
+
+	osDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil)
+	repo.On("GetAllNodeInterfaces", mock.Anything, "test-node").Return([]entities.NetworkInterface(nil), fmt.Errorf("connection refused"))
+
+	useCase := newTestReconcileUseCase(repo, configurer, fs, osDetector, linkManager)
+	_, err := useCase.Execute(context.Background(), ReconcileNetworkInput{NodeName: "test-node"})
+
+	// 저장소 조회 실패 시 설정/삭제 모두 실행하지 않음
+	require.Error(t, err)
+	assert.True(t, errors.IsSystemError(err))
+	fs.AssertNotCalled(t, "ListFiles", mock.Anything)
+	linkManager.AssertNotCalled(t, "ListLinks", mock.Anything)
+}
+
+func TestReconcileNetworkUseCase_Execute_FileListingFailureIsNotFatal(t *testing.T) {
+	repo := new(MockNetworkInterfaceRepository)
+	configurer := new(MockNetworkConfigurer)
+	fs := new(MockFileSystem)
+	osDetector := new(MockOSDetector)
+	linkManager := new(MockLinkManager)
+
+	osDetector.On("DetectOS").Return(interfaces.OSTypeUbuntu, nil)
+	repo.On("GetAllNodeInterfaces", mock.Anything, "test-node").Return([]entities.NetworkInterface{}, nil)
+	fs.On("ListFiles", "/etc/netplan").Return([]string(nil), fmt.Errorf("permission denied"))
+	linkManager.On("ListLinks", mock.Anything).Return([]entities.LinkState{}, nil)
+
+	useCase := newTestReconcileUseCase(repo, configurer, fs, osDetector, linkManager)
+	output, err := useCase.Execute(context.Background(), ReconcileNetworkInput{NodeName: "test-node"})
+
+	// 삭제 단계 실패는 결과의 Errors로 보고되고 설정 단계는 계속 실행됨
+	require.NoError(t, err)
+	require.Len(t, output.Delete.Errors, 1)
+	assert.Contains(t, output.Delete.Errors[0].Error(), "permission denied")
+	assert.Equal(t, 0, output.Configure.TotalCount)
+}
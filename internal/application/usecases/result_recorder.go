@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"multinic-agent/internal/domain/entities"
+	"sort"
+	"sync"
+)
+
+// resultRecorder는 병렬로 처리된 인터페이스별 결과를 모읍니다
+type resultRecorder struct {
+	mu      sync.Mutex
+	results []entities.InterfaceResult
+}
+
+// add는 인터페이스 처리 결과를 추가합니다
+func (r *resultRecorder) add(result entities.InterfaceResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.results = append(r.results, result)
+}
+
+// fail은 인터페이스 처리 실패를 추가합니다
+func (r *resultRecorder) fail(iface entities.NetworkInterface, name, drift string, err error) {
+	r.add(entities.InterfaceResult{
+		InterfaceID:   iface.ID,
+		InterfaceName: name,
+		MacAddress:    iface.MacAddress,
+		Address:       iface.Address,
+		CIDR:          iface.CIDR,
+		Result:        entities.ResultFailed,
+		Drift:         drift,
+		Error:         err.Error(),
+	})
+}
+
+// count는 주어진 결과의 인터페이스 수를 반환합니다
+func (r *resultRecorder) count(result entities.ReconcileResult) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	count := 0
+	for _, res := range r.results {
+		if res.Result == result {
+			count++
+		}
+	}
+	return count
+}
+
+// list는 처리 순서와 무관하게 같은 결과가 나오도록 인터페이스 ID 순으로 정렬된 결과를 반환합니다
+func (r *resultRecorder) list() []entities.InterfaceResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	results := append([]entities.InterfaceResult{}, r.results...)
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].InterfaceID < results[j].InterfaceID
+	})
+	return results
+}
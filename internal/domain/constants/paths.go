@@ -15,15 +15,34 @@ const (
 	// 백업 디렉토리
 	DefaultBackupDir = "/var/lib/multinic/backups"
 
+	// 고아 인터페이스 설정 격리 디렉토리
+	DefaultQuarantineDir = "/var/lib/multinic/quarantine"
+
+	// MAC별 인터페이스 이름 할당 파일
+	DefaultNameAllocationFile = "/var/lib/multinic/names.json"
+
+	// 메타데이터 소스 사용 시 인터페이스별 설정 상태 파일
+	DefaultMetadataStatusFile = "/var/lib/multinic/metadata-status.json"
+
 	// 시스템 네트워크 경로
 	SysClassNet = "/sys/class/net"
+
+	// 시스템 UUID (OpenStack Nova는 인스턴스 UUID로 설정)
+	DMIProductUUIDFile = "/sys/class/dmi/id/product_uuid"
 )
 
+// OpenStack 메타데이터 서비스의 network_data.json 주소
+const DefaultMetadataURL = "http://169.254.169.254/openstack/latest/network_data.json"
+
 // 네트워크 설정 관련 상수들
 const (
 	// 인터페이스 이름 패턴
-	InterfacePrefix = "multinic"
-	MaxInterfaces   = 10
+	InterfacePrefix       = "multinic"
+	MaxInterfaces         = 10
+	DefaultNamingTemplate = "{prefix}{index}"
+
+	// 에이전트가 생성한 설정 파일 표시 (이름 규칙 밖의 인터페이스 이름도 고아 감지 대상으로 인식)
+	ManagedFileMarker = "# Managed by multinic-agent. Do not edit."
 
 	// 파일 권한
 	ConfigFilePermission = 0644
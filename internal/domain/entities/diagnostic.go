@@ -0,0 +1,44 @@
+package entities
+
+import "time"
+
+// DiagnosticStatus is the outcome of a single node pre-flight check
+type DiagnosticStatus string
+
+const (
+	DiagnosticPass DiagnosticStatus = "pass"
+	DiagnosticWarn DiagnosticStatus = "warn"
+	DiagnosticFail DiagnosticStatus = "fail"
+	DiagnosticSkip DiagnosticStatus = "skip" // not applicable, or a prerequisite check failed
+)
+
+// DiagnosticCheck is the result of a single node pre-flight check
+type DiagnosticCheck struct {
+	Name    string           `json:"name"`
+	Status  DiagnosticStatus `json:"status"`
+	Message string           `json:"message"`
+	Remedy  string           `json:"remedy,omitempty"` // what the operator should do when the check did not pass
+}
+
+// DiagnosticReport is the result of a full run of node pre-flight checks
+type DiagnosticReport struct {
+	NodeName    string            `json:"node_name"`
+	GeneratedAt time.Time         `json:"generated_at"`
+	Checks      []DiagnosticCheck `json:"checks"`
+}
+
+// Count returns the number of checks with the given status
+func (r *DiagnosticReport) Count(status DiagnosticStatus) int {
+	count := 0
+	for _, check := range r.Checks {
+		if check.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// Healthy reports whether no check failed
+func (r *DiagnosticReport) Healthy() bool {
+	return r.Count(DiagnosticFail) == 0
+}
@@ -0,0 +1,103 @@
+package entities
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"multinic-agent/internal/domain/constants"
+	"strconv"
+	"strings"
+)
+
+// Ownership header keys written after the managed file marker
+const (
+	ownershipKeyAgentID     = "agent-id"
+	ownershipKeyInterfaceID = "interface-id"
+	ownershipKeyMacAddress  = "mac-address"
+	ownershipKeyContentHash = "content-sha256"
+)
+
+// FileOwnership is the header the agent writes at the top of every rendered config file
+// It proves that the file was created by the agent and records which DB interface it belongs to
+type FileOwnership struct {
+	AgentID     string
+	InterfaceID int
+	MacAddress  string // lower-case MAC address
+	ContentHash string // hex SHA-256 of the file body following the header
+}
+
+// NewFileOwnership creates the ownership header for a rendered file body
+func NewFileOwnership(agentID string, iface NetworkInterface, body []byte) FileOwnership {
+	return FileOwnership{
+		AgentID:     agentID,
+		InterfaceID: iface.ID,
+		MacAddress:  strings.ToLower(iface.MacAddress),
+		ContentHash: HashConfigContent(body),
+	}
+}
+
+// HashConfigContent returns the hex SHA-256 of a config file body
+func HashConfigContent(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// Render returns the file content with the ownership header prepended to the body
+func (o FileOwnership) Render(body []byte) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(constants.ManagedFileMarker + "\n")
+	fmt.Fprintf(&buf, "# %s: %s\n", ownershipKeyAgentID, o.AgentID)
+	fmt.Fprintf(&buf, "# %s: %d\n", ownershipKeyInterfaceID, o.InterfaceID)
+	fmt.Fprintf(&buf, "# %s: %s\n", ownershipKeyMacAddress, o.MacAddress)
+	fmt.Fprintf(&buf, "# %s: %s\n", ownershipKeyContentHash, o.ContentHash)
+	buf.Write(body)
+	return buf.Bytes()
+}
+
+// ParseFileOwnership extracts the ownership header from a config file
+// It returns nil if the file does not start with a complete header; intact reports whether
+// the body still matches the recorded content hash (false means the file was edited by hand)
+func ParseFileOwnership(content []byte) (ownership *FileOwnership, intact bool) {
+	rest := content
+	line, rest, ok := cutLine(rest)
+	if !ok || strings.TrimSpace(line) != constants.ManagedFileMarker {
+		return nil, false
+	}
+
+	fields := make(map[string]string)
+	for {
+		line, next, ok := cutLine(rest)
+		if !ok {
+			break
+		}
+		key, value, found := strings.Cut(strings.TrimPrefix(line, "# "), ": ")
+		if !strings.HasPrefix(line, "# ") || !found {
+			break
+		}
+		fields[key] = strings.TrimSpace(value)
+		rest = next
+	}
+
+	interfaceID, err := strconv.Atoi(fields[ownershipKeyInterfaceID])
+	if err != nil || fields[ownershipKeyAgentID] == "" || fields[ownershipKeyMacAddress] == "" || fields[ownershipKeyContentHash] == "" {
+		return nil, false
+	}
+
+	ownership = &FileOwnership{
+		AgentID:     fields[ownershipKeyAgentID],
+		InterfaceID: interfaceID,
+		MacAddress:  strings.ToLower(fields[ownershipKeyMacAddress]),
+		ContentHash: fields[ownershipKeyContentHash],
+	}
+	return ownership, HashConfigContent(rest) == ownership.ContentHash
+}
+
+// cutLine splits the first newline-terminated line from content
+func cutLine(content []byte) (string, []byte, bool) {
+	i := bytes.IndexByte(content, '\n')
+	if i < 0 {
+		return "", content, false
+	}
+	return string(content[:i]), content[i+1:], true
+}
@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileOwnership_RenderAndParse(t *testing.T) {
+	body := []byte("network:\n  version: 2\n")
+	ownership := NewFileOwnership("node-1", NetworkInterface{ID: 12, MacAddress: "FA:16:3E:00:00:01"}, body)
+	content := ownership.Render(body)
+
+	parsed, intact := ParseFileOwnership(content)
+	require.NotNil(t, parsed)
+	assert.True(t, intact)
+	assert.Equal(t, ownership, *parsed)
+	assert.Equal(t, "fa:16:3e:00:00:01", parsed.MacAddress)
+
+	t.Run("본문이 수정되면 해시 불일치", func(t *testing.T) {
+		parsed, intact := ParseFileOwnership([]byte(strings.Replace(string(content), "version: 2", "version: 3", 1)))
+		require.NotNil(t, parsed)
+		assert.False(t, intact)
+	})
+
+	t.Run("헤더가 없는 파일", func(t *testing.T) {
+		parsed, _ := ParseFileOwnership(body)
+		assert.Nil(t, parsed)
+	})
+
+	t.Run("필드가 빠진 헤더", func(t *testing.T) {
+		parsed, _ := ParseFileOwnership([]byte("# Managed by multinic-agent. Do not edit.\n# agent-id: node-1\n" + string(body)))
+		assert.Nil(t, parsed)
+	})
+}
@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"net"
+	"strings"
+)
+
+// InterfaceConfig is the normalized desired state of an interface, independent of the OS config format
+type InterfaceConfig struct {
+	Name       string
+	MacAddress string         // lower-case MAC address
+	Address    string         // IP address without prefix (e.g., "192.168.1.10"), empty if no static address
+	CIDR       string         // network CIDR (e.g., "192.168.1.0/24"), empty if no static address
+	MTU        int            // 0 means not set
+	Ownership  *FileOwnership // ownership header of the file, nil if the file was not provably rendered by the agent
+	Tampered   bool           // the file body no longer matches the content hash in the ownership header
+}
+
+// HasAddress checks if a static address is configured
+func (c *InterfaceConfig) HasAddress() bool {
+	return c.Address != ""
+}
+
+// NewInterfaceConfig builds the normalized desired state for a DB interface.
+// A static address is only part of the desired state when both address and a valid CIDR are present,
+// matching how the OS adapters render configuration files.
+func NewInterfaceConfig(iface NetworkInterface, name string) InterfaceConfig {
+	config := InterfaceConfig{
+		Name:       name,
+		MacAddress: strings.ToLower(iface.MacAddress),
+		MTU:        iface.MTU,
+	}
+
+	if iface.Address == "" || iface.CIDR == "" {
+		return config
+	}
+	if _, ipNet, err := net.ParseCIDR(iface.CIDR); err == nil {
+		config.Address = iface.Address
+		config.CIDR = ipNet.String()
+	}
+
+	return config
+}
+
+// ParseAddressWithPrefix converts an "address/prefix" string into an address and its network CIDR
+func ParseAddressWithPrefix(addressWithPrefix string) (string, string, error) {
+	ip, ipNet, err := net.ParseCIDR(addressWithPrefix)
+	if err != nil {
+		return "", "", err
+	}
+	return ip.String(), ipNet.String(), nil
+}
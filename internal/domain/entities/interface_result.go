@@ -0,0 +1,24 @@
+package entities
+
+// ReconcileResult is the outcome of reconciling one interface in a cycle
+type ReconcileResult string
+
+const (
+	ResultConfigured ReconcileResult = "configured" // configuration was (re)applied
+	ResultInSync     ReconcileResult = "in_sync"    // nothing to do
+	ResultPlanned    ReconcileResult = "planned"    // dry-run recorded a change without applying it
+	ResultFailed     ReconcileResult = "failed"
+)
+
+// InterfaceResult is the outcome of reconciling one interface in a cycle
+type InterfaceResult struct {
+	InterfaceID   int
+	InterfaceName string // empty when the name could not be determined
+	MacAddress    string
+	Address       string
+	CIDR          string
+	Result        ReconcileResult
+	Drift         string // why the interface needed a change (drift types or another cause), empty when in sync
+	Error         string
+	RolledBack    bool // the failed configuration was rolled back
+}
@@ -0,0 +1,16 @@
+package entities
+
+// LinkState is a snapshot of a live kernel network link
+type LinkState struct {
+	Name       string
+	MacAddress string
+	MTU        int
+	OperState  string   // kernel operstate (e.g., "UP", "DOWN", "UNKNOWN")
+	AdminUp    bool     // whether the link is administratively up (IFF_UP flag)
+	Addresses  []string // addresses in CIDR notation (e.g., "192.168.1.10/24")
+}
+
+// HasAddresses checks if any address is still assigned to the link
+func (s *LinkState) HasAddresses() bool {
+	return len(s.Addresses) > 0
+}
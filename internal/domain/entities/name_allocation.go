@@ -0,0 +1,17 @@
+package entities
+
+import "time"
+
+// NameAllocation is a durable assignment of an interface name to a MAC address
+type NameAllocation struct {
+	MacAddress  string // lower-case MAC address
+	Name        string
+	Pinned      bool       // pinned by an operator; never released automatically
+	AllocatedAt time.Time  // when the name was (re)assigned to the MAC
+	ReleasedAt  *time.Time // when the interface was deleted; nil while the allocation is active
+}
+
+// IsActive checks if the name is currently assigned to the MAC
+func (a *NameAllocation) IsActive() bool {
+	return a.ReleasedAt == nil
+}
@@ -0,0 +1,169 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"multinic-agent/internal/domain/constants"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Naming template placeholders
+const (
+	PlaceholderPrefix  = "{prefix}"
+	PlaceholderIndex   = "{index}"
+	PlaceholderNetwork = "{network}"
+)
+
+// MaxInterfaceNameLength is the longest interface name accepted by the kernel (IFNAMSIZ - 1)
+const MaxInterfaceNameLength = 15
+
+var (
+	ErrInvalidNamingScheme = errors.New("invalid interface naming scheme")
+
+	namingLiteralRegex = regexp.MustCompile(`^[a-zA-Z0-9_.-]*$`)
+	networkCharRegex   = regexp.MustCompile(`[^a-z0-9]`)
+)
+
+// NamingScheme describes how managed interface names are generated and recognized
+// A template combines the prefix, a numeric index and optionally a network name (e.g., "{prefix}{index}")
+type NamingScheme struct {
+	prefix        string
+	template      string
+	maxInterfaces int
+	pattern       *regexp.Regexp
+}
+
+// NewNamingScheme creates a naming scheme after validating that every generated name is a valid kernel name
+// The template must contain {prefix} and {index} exactly once so that managed names stay recognizable and unique
+func NewNamingScheme(prefix, template string, maxInterfaces int) (NamingScheme, error) {
+	if template == "" {
+		template = constants.DefaultNamingTemplate
+	}
+	if prefix == "" || !namingLiteralRegex.MatchString(prefix) {
+		return NamingScheme{}, fmt.Errorf("%w: prefix %q must be non-empty and contain only [a-zA-Z0-9_.-]", ErrInvalidNamingScheme, prefix)
+	}
+	if strings.Count(template, PlaceholderPrefix) != 1 || strings.Count(template, PlaceholderIndex) != 1 {
+		return NamingScheme{}, fmt.Errorf("%w: template %q must contain %s and %s exactly once", ErrInvalidNamingScheme, template, PlaceholderPrefix, PlaceholderIndex)
+	}
+	if strings.Count(template, PlaceholderNetwork) > 1 {
+		return NamingScheme{}, fmt.Errorf("%w: template %q contains %s more than once", ErrInvalidNamingScheme, template, PlaceholderNetwork)
+	}
+	if maxInterfaces <= 0 {
+		return NamingScheme{}, fmt.Errorf("%w: max interfaces must be positive", ErrInvalidNamingScheme)
+	}
+
+	literals := strings.NewReplacer(PlaceholderPrefix, "", PlaceholderIndex, "", PlaceholderNetwork, "").Replace(template)
+	if !namingLiteralRegex.MatchString(literals) {
+		return NamingScheme{}, fmt.Errorf("%w: template %q contains characters outside [a-zA-Z0-9_.-]", ErrInvalidNamingScheme, template)
+	}
+
+	scheme := NamingScheme{
+		prefix:        prefix,
+		template:      template,
+		maxInterfaces: maxInterfaces,
+		pattern:       compileNamingPattern(prefix, template),
+	}
+
+	// The longest name without a network part must fit; the network part is truncated to the remaining space
+	if longest := scheme.Name(maxInterfaces-1, ""); len(longest) > MaxInterfaceNameLength {
+		return NamingScheme{}, fmt.Errorf("%w: name %q exceeds %d characters", ErrInvalidNamingScheme, longest, MaxInterfaceNameLength)
+	}
+
+	return scheme, nil
+}
+
+// DefaultNamingScheme returns the built-in scheme (multinic0 ... multinic9)
+func DefaultNamingScheme() NamingScheme {
+	scheme, _ := NewNamingScheme(constants.InterfacePrefix, constants.DefaultNamingTemplate, constants.MaxInterfaces)
+	return scheme
+}
+
+// compileNamingPattern builds the regular expression that recognizes names generated by the template
+func compileNamingPattern(prefix, template string) *regexp.Regexp {
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	rest := template
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, PlaceholderPrefix):
+			pattern.WriteString(regexp.QuoteMeta(prefix))
+			rest = rest[len(PlaceholderPrefix):]
+		case strings.HasPrefix(rest, PlaceholderIndex):
+			pattern.WriteString(`(?P<index>0|[1-9][0-9]*)`)
+			rest = rest[len(PlaceholderIndex):]
+		case strings.HasPrefix(rest, PlaceholderNetwork):
+			pattern.WriteString(`(?:[a-z0-9]*[a-z])?`)
+			rest = rest[len(PlaceholderNetwork):]
+		default:
+			pattern.WriteString(regexp.QuoteMeta(rest[:1]))
+			rest = rest[1:]
+		}
+	}
+	pattern.WriteString("$")
+	return regexp.MustCompile(pattern.String())
+}
+
+// Prefix returns the configured name prefix
+func (s NamingScheme) Prefix() string {
+	return s.prefix
+}
+
+// MaxInterfaces returns the number of indexes available per network
+func (s NamingScheme) MaxInterfaces() int {
+	return s.maxInterfaces
+}
+
+// Name renders the interface name for the index and network name
+// The network name is reduced to [a-z0-9] without trailing digits (so it cannot be confused with the index)
+// and truncated so that the name fits in IFNAMSIZ
+func (s NamingScheme) Name(index int, network string) string {
+	name := strings.NewReplacer(
+		PlaceholderPrefix, s.prefix,
+		PlaceholderIndex, strconv.Itoa(index),
+		PlaceholderNetwork, "",
+	).Replace(s.template)
+
+	network = networkCharRegex.ReplaceAllString(strings.ToLower(network), "")
+	if room := MaxInterfaceNameLength - len(name); len(network) > room {
+		network = network[:max(room, 0)]
+	}
+	network = strings.TrimRight(network, "0123456789")
+
+	return strings.NewReplacer(
+		PlaceholderPrefix, s.prefix,
+		PlaceholderIndex, strconv.Itoa(index),
+		PlaceholderNetwork, network,
+	).Replace(s.template)
+}
+
+// Index returns the index of a name generated by this scheme
+func (s NamingScheme) Index(name string) (int, bool) {
+	if s.pattern == nil || len(name) > MaxInterfaceNameLength {
+		return 0, false
+	}
+	matches := s.pattern.FindStringSubmatch(name)
+	if matches == nil {
+		return 0, false
+	}
+	index, err := strconv.Atoi(matches[s.pattern.SubexpIndex("index")])
+	if err != nil || index >= s.maxInterfaces {
+		return 0, false
+	}
+	return index, true
+}
+
+// Matches checks if the name was generated by this scheme and is therefore managed by the agent
+func (s NamingScheme) Matches(name string) bool {
+	_, ok := s.Index(name)
+	return ok
+}
+
+// NewInterfaceName creates an interface name validated against this scheme
+func (s NamingScheme) NewInterfaceName(name string) (InterfaceName, error) {
+	if !s.Matches(name) {
+		return InterfaceName{}, ErrInvalidInterfaceName
+	}
+	return InterfaceName{value: name}, nil
+}
@@ -0,0 +1,85 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNamingScheme(t *testing.T) {
+	tests := []struct {
+		name          string
+		prefix        string
+		template      string
+		maxInterfaces int
+		wantError     bool
+	}{
+		{"기본 규칙", "multinic", "{prefix}{index}", 10, false},
+		{"빈 템플릿은 기본 템플릿 사용", "multinic", "", 10, false},
+		{"16개 이상 인터페이스", "multinic", "{prefix}{index}", 32, false},
+		{"네트워크 이름 템플릿", "n", "{prefix}{network}{index}", 16, false},
+		{"빈 접두사", "", "{prefix}{index}", 10, true},
+		{"인덱스 없는 템플릿", "multinic", "{prefix}", 10, true},
+		{"접두사 없는 템플릿", "multinic", "{network}{index}", 10, true},
+		{"허용되지 않는 문자", "multi nic", "{prefix}{index}", 10, true},
+		{"IFNAMSIZ 초과", "multinicnetwork", "{prefix}{index}", 10, true},
+		{"최대 개수 0", "multinic", "{prefix}{index}", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewNamingScheme(tt.prefix, tt.template, tt.maxInterfaces)
+
+			if tt.wantError {
+				assert.ErrorIs(t, err, ErrInvalidNamingScheme)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNamingScheme_NameAndIndex(t *testing.T) {
+	t.Run("10개 이상 인덱스", func(t *testing.T) {
+		scheme, err := NewNamingScheme("multinic", "{prefix}{index}", 16)
+		require.NoError(t, err)
+
+		assert.Equal(t, "multinic12", scheme.Name(12, ""))
+
+		index, ok := scheme.Index("multinic12")
+		assert.True(t, ok)
+		assert.Equal(t, 12, index)
+
+		assert.False(t, scheme.Matches("multinic16"), "최대 개수를 넘는 인덱스")
+		assert.False(t, scheme.Matches("multinic01"), "앞자리 0")
+		assert.False(t, scheme.Matches("eth0"))
+	})
+
+	t.Run("네트워크 이름 템플릿", func(t *testing.T) {
+		scheme, err := NewNamingScheme("n", "{prefix}{network}{index}", 16)
+		require.NoError(t, err)
+
+		assert.Equal(t, "nstorage0", scheme.Name(0, "Storage"))
+		assert.Equal(t, "ntenanta3", scheme.Name(3, "tenant-a"))
+		// 인덱스와 혼동되지 않도록 네트워크 이름 끝의 숫자는 제거
+		assert.Equal(t, "nnet1", scheme.Name(1, "net2"))
+		// IFNAMSIZ에 맞게 네트워크 이름 절단
+		assert.Equal(t, "nverylongnetw15", scheme.Name(15, "very-long-network-name"))
+		assert.Equal(t, "n0", scheme.Name(0, ""))
+
+		index, ok := scheme.Index("nstorage10")
+		assert.True(t, ok)
+		assert.Equal(t, 10, index)
+		assert.True(t, scheme.Matches("n0"))
+		assert.False(t, scheme.Matches("multinic0"))
+	})
+
+	t.Run("기본 규칙은 기존 이름과 호환", func(t *testing.T) {
+		scheme := DefaultNamingScheme()
+
+		assert.Equal(t, "multinic0", scheme.Name(0, "ignored"))
+		assert.True(t, scheme.Matches("multinic9"))
+		assert.False(t, scheme.Matches("multinic10"))
+	})
+}
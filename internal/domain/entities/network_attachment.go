@@ -0,0 +1,8 @@
+package entities
+
+// NetworkAttachment is a secondary network that pods can attach to through a configured host interface
+type NetworkAttachment struct {
+	Name          string // name of the attachment object, derived from the subnet CIDR so that every node shares it
+	InterfaceName string // host interface the attachment points at
+	CIDR          string // subnet used for pod address management
+}
@@ -14,6 +14,8 @@ type NetworkInterface struct {
 	Address          string // IP address (e.g., "192.168.1.10")
 	CIDR             string // CIDR (e.g., "192.168.1.0/24")
 	MTU              int    // MTU value
+	NetworkName      string // network name used by {network} naming templates (empty if the source does not provide it)
+	DesiredName      string // centrally managed interface name (empty to use the naming scheme)
 }
 
 // InterfaceStatus represents the state of an interface
@@ -25,6 +27,18 @@ const (
 	StatusFailed
 )
 
+// String returns the lowercase name of the status
+func (s InterfaceStatus) String() string {
+	switch s {
+	case StatusConfigured:
+		return "configured"
+	case StatusFailed:
+		return "failed"
+	default:
+		return "pending"
+	}
+}
+
 // InterfaceName is a value object representing multinic interface name
 type InterfaceName struct {
 	value string
@@ -36,7 +50,7 @@ var (
 	ErrInvalidNodeName      = errors.New("invalid node name")
 )
 
-// NewInterfaceName creates a new interface name
+// NewInterfaceName creates a new interface name validated against the default naming scheme
 func NewInterfaceName(name string) (InterfaceName, error) {
 	if !isValidInterfaceName(name) {
 		return InterfaceName{}, ErrInvalidInterfaceName
@@ -44,6 +58,15 @@ func NewInterfaceName(name string) (InterfaceName, error) {
 	return InterfaceName{value: name}, nil
 }
 
+// NewCustomInterfaceName creates an interface name that is not generated by a naming scheme (e.g., provided by the DB)
+// The name must be accepted by the kernel: at most IFNAMSIZ-1 characters of [a-zA-Z0-9_.-], and not "." or ".."
+func NewCustomInterfaceName(name string) (InterfaceName, error) {
+	if len(name) == 0 || len(name) > MaxInterfaceNameLength || name == "." || name == ".." || !namingLiteralRegex.MatchString(name) {
+		return InterfaceName{}, ErrInvalidInterfaceName
+	}
+	return InterfaceName{value: name}, nil
+}
+
 // String returns the string representation of interface name
 func (n InterfaceName) String() string {
 	return n.value
@@ -83,6 +106,5 @@ func isValidMacAddress(mac string) bool {
 
 // isValidInterfaceName validates interface name format
 func isValidInterfaceName(name string) bool {
-	matched, _ := regexp.MatchString(`^multinic[0-9]$`, name)
-	return matched
+	return DefaultNamingScheme().Matches(name)
 }
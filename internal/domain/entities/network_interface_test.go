@@ -152,6 +152,37 @@ func TestInterfaceName_NewInterfaceName(t *testing.T) {
 	}
 }
 
+func TestInterfaceName_NewCustomInterfaceName(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantError bool
+	}{
+		{"이름 규칙 밖의 이름", "storage0", false},
+		{"하이픈과 점 포함", "tenant-a.100", false},
+		{"최대 길이", "abcdefghijklmno", false},
+		{"IFNAMSIZ 초과", "abcdefghijklmnop", true},
+		{"빈 문자열", "", true},
+		{"공백 포함", "tenant a", true},
+		{"슬래시 포함", "tenant/a", true},
+		{"점 하나", ".", true},
+		{"점 두 개", "..", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewCustomInterfaceName(tt.input)
+
+			if tt.wantError {
+				assert.ErrorIs(t, err, ErrInvalidInterfaceName)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.input, result.String())
+			}
+		})
+	}
+}
+
 func TestInterfaceName_String(t *testing.T) {
 	name, err := NewInterfaceName("multinic5")
 	require.NoError(t, err)
@@ -0,0 +1,46 @@
+package entities
+
+// NodeEventType is the severity of an event published on the node object
+type NodeEventType string
+
+const (
+	NodeEventNormal  NodeEventType = "Normal"
+	NodeEventWarning NodeEventType = "Warning"
+)
+
+// Reasons of the events published on the node object
+const (
+	EventReasonInterfaceConfigured    = "InterfaceConfigured"
+	EventReasonInterfaceConfigFailed  = "InterfaceConfigurationFailed"
+	EventReasonInterfaceRolledBack    = "InterfaceRolledBack"
+	EventReasonOrphanInterfaceDeleted = "OrphanInterfaceDeleted"
+)
+
+// Reasons of the NIC readiness node condition
+const (
+	ConditionReasonInterfacesConfigured = "InterfacesConfigured"
+	ConditionReasonInterfacesFailed     = "InterfaceConfigurationFailed"
+)
+
+// NodeEvent is a notable reconcile outcome published on the node object
+type NodeEvent struct {
+	Type    NodeEventType
+	Reason  string
+	Message string
+}
+
+// ManagedInterface is an interface managed by the agent as listed on the node object
+type ManagedInterface struct {
+	Name       string          `json:"name"`
+	MacAddress string          `json:"mac"`
+	Address    string          `json:"address,omitempty"`
+	Result     ReconcileResult `json:"result"`
+}
+
+// NodeNetworkReport is the NIC configuration state published on the node object
+type NodeNetworkReport struct {
+	Ready      bool // false while any managed interface failed in the latest cycle
+	Reason     string
+	Message    string
+	Interfaces []ManagedInterface
+}
@@ -0,0 +1,48 @@
+package entities
+
+import "time"
+
+// PlanAction is the kind of change a reconcile cycle would make to an interface
+type PlanAction string
+
+const (
+	PlanActionCreate PlanAction = "create"
+	PlanActionUpdate PlanAction = "update"
+	PlanActionDelete PlanAction = "delete"
+)
+
+// FieldDiff is a single field that differs between the current and the desired state
+type FieldDiff struct {
+	Field   string `json:"field"`
+	Current string `json:"current"`
+	Desired string `json:"desired"`
+}
+
+// PlannedChange is a change a dry-run reconcile cycle would have applied
+type PlannedChange struct {
+	Action        PlanAction  `json:"action"`
+	InterfaceID   int         `json:"interface_id,omitempty"`
+	InterfaceName string      `json:"interface_name"`
+	MacAddress    string      `json:"mac_address"`
+	ConfigPath    string      `json:"config_path,omitempty"`
+	Reason        string      `json:"reason"` // drift types or another cause, comma separated
+	Diffs         []FieldDiff `json:"diffs,omitempty"`
+}
+
+// ReconcilePlan is the full set of changes computed by a dry-run reconcile cycle
+type ReconcilePlan struct {
+	NodeName    string          `json:"node_name"`
+	GeneratedAt time.Time       `json:"generated_at"`
+	Changes     []PlannedChange `json:"changes"`
+}
+
+// Count returns the number of planned changes with the given action
+func (p *ReconcilePlan) Count(action PlanAction) int {
+	count := 0
+	for _, change := range p.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+	return count
+}
@@ -130,6 +130,15 @@ func IsNotFoundError(err error) bool {
 	return false
 }
 
+// IsConflictError는 충돌 에러인지 확인합니다
+func IsConflictError(err error) bool {
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) {
+		return domainErr.Type == ErrorTypeConflict
+	}
+	return false
+}
+
 // IsSystemError는 시스템 에러인지 확인합니다
 func IsSystemError(err error) bool {
 	var domainErr *DomainError
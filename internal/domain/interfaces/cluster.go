@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"context"
+	"multinic-agent/internal/domain/entities"
+)
+
+// NodeReporter는 클러스터의 노드 오브젝트에 NIC 설정 결과를 게시하는 인터페이스입니다
+type NodeReporter interface {
+	// RecordEvent는 노드 오브젝트에 이벤트를 기록합니다
+	RecordEvent(ctx context.Context, nodeName string, event entities.NodeEvent) error
+
+	// UpdateNodeStatus는 노드의 NIC 준비 상태 컨디션과 관리 인터페이스 어노테이션을 갱신합니다
+	UpdateNodeStatus(ctx context.Context, nodeName string, report entities.NodeNetworkReport) error
+}
+
+// NodeReadinessGate는 보조 네트워크가 준비될 때까지 노드에 워크로드가 배치되지 않도록 막는 인터페이스입니다
+type NodeReadinessGate interface {
+	// SetNetworkReady는 모든 관리 인터페이스가 설정되었는지에 따라 노드의 taint 또는 label을 갱신합니다
+	SetNetworkReady(ctx context.Context, nodeName string, ready bool) error
+}
+
+// NetworkAttachmentPublisher는 설정된 호스트 인터페이스를 가리키는 파드용 보조 네트워크 정의를 게시하는 인터페이스입니다
+type NetworkAttachmentPublisher interface {
+	// Apply는 보조 네트워크 정의를 생성하거나 내용이 다르면 갱신합니다
+	Apply(ctx context.Context, attachment entities.NetworkAttachment) error
+}
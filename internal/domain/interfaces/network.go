@@ -22,3 +22,47 @@ type NetworkRollbacker interface {
 	// Rollback은 인터페이스 설정을 이전 상태로 되돌립니다
 	Rollback(ctx context.Context, name string) error
 }
+
+// ConfigReader는 OS별 설정 파일을 정규화된 설정 모델로 읽는 인터페이스입니다
+// 각 OS 어댑터가 자신의 파일 형식에 맞게 구현합니다
+type ConfigReader interface {
+	// ConfigPath는 인터페이스 설정 파일이 생성될 경로를 반환합니다
+	ConfigPath(name string) string
+
+	// FindConfigPath는 인터페이스의 기존 설정 파일 경로를 반환합니다 (없으면 빈 문자열)
+	FindConfigPath(name string) string
+
+	// ReadConfig는 설정 파일을 파싱하여 정규화된 설정을 반환합니다
+	ReadConfig(path string) (*entities.InterfaceConfig, error)
+}
+
+// ConfigRenderer는 설정 파일을 적용하지 않고 내용만 생성하는 인터페이스입니다
+// 각 OS 어댑터가 Configure에서 쓰는 것과 같은 내용을 반환합니다
+type ConfigRenderer interface {
+	// RenderConfig는 설정 파일 경로와 소유권 헤더를 포함한 파일 내용을 반환합니다
+	RenderConfig(iface entities.NetworkInterface, name entities.InterfaceName) (string, []byte, error)
+}
+
+// LinkManager는 커널 네트워크 링크 상태를 직접 제어하는 인터페이스입니다
+type LinkManager interface {
+	// SetLinkUp은 링크를 활성화합니다
+	SetLinkUp(ctx context.Context, name string) error
+
+	// SetLinkDown은 링크를 비활성화합니다
+	SetLinkDown(ctx context.Context, name string) error
+
+	// FlushLink는 링크의 주소, 라우트, 정책 라우팅 규칙을 모두 제거합니다
+	FlushLink(ctx context.Context, name string) error
+
+	// RenameLink는 링크 이름을 변경합니다 (링크가 비활성화된 상태여야 함)
+	RenameLink(ctx context.Context, name, newName string) error
+
+	// GetKernelName은 udev가 부여하는 원래 커널 인터페이스 이름을 반환합니다
+	GetKernelName(ctx context.Context, name string) (string, error)
+
+	// GetLinkState는 링크의 현재 상태를 반환합니다 (링크가 없으면 NotFound 에러)
+	GetLinkState(ctx context.Context, name string) (*entities.LinkState, error)
+
+	// ListLinks는 커널에 존재하는 모든 링크의 상태를 반환합니다
+	ListLinks(ctx context.Context) ([]entities.LinkState, error)
+}
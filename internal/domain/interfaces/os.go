@@ -32,6 +32,13 @@ type FileSystem interface {
 	// Remove는 파일이나 디렉토리를 삭제합니다
 	Remove(path string) error
 
+	// Rename은 파일 이름을 변경합니다 (같은 파일 시스템 안에서는 원자적)
+	Rename(oldPath, newPath string) error
+
+	// WriteFileAtomic은 같은 디렉토리의 임시 파일에 쓰고 디스크에 동기화한 뒤 대상 파일로 이름을 변경합니다
+	// 쓰는 도중 중단되어도 대상 파일은 이전 내용 또는 새 내용 중 하나로 남습니다
+	WriteFileAtomic(path string, data []byte, perm os.FileMode) error
+
 	// ListFiles는 디렉토리의 파일 목록을 반환합니다
 	ListFiles(path string) ([]string, error)
 }
@@ -23,3 +23,18 @@ type NetworkInterfaceRepository interface {
 	GetActiveInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error)
 	GetAllNodeInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error)
 }
+
+// ChangeTokenSource는 노드 인터페이스의 변경 여부를 전체 조회 없이 확인할 수 있는 저장소가 구현하는 인터페이스입니다
+type ChangeTokenSource interface {
+	// GetChangeToken은 노드의 인터페이스 행이 추가/수정/삭제되면 달라지는 토큰을 반환합니다
+	GetChangeToken(ctx context.Context, nodeName string) (string, error)
+}
+
+// NameAllocationStore는 MAC 주소별 인터페이스 이름 할당을 영구 저장하는 저장소 인터페이스입니다
+type NameAllocationStore interface {
+	// Load는 저장된 모든 할당을 조회합니다 (저장된 내용이 없으면 빈 목록)
+	Load(ctx context.Context) ([]entities.NameAllocation, error)
+
+	// Save는 할당 목록 전체를 저장합니다
+	Save(ctx context.Context, allocations []entities.NameAllocation) error
+}
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"multinic-agent/internal/domain/interfaces"
+	"sync"
+	"time"
+)
+
+// 전체 reconcile 사유
+const (
+	ReconcileReasonInitial   = "initial"   // 에이전트 시작 후 첫 사이클
+	ReconcileReasonChanged   = "changed"   // 저장소의 변경 토큰이 바뀜
+	ReconcileReasonResync    = "resync"    // 주기적 전체 재동기화 (런타임 드리프트 감지용)
+	ReconcileReasonRequested = "requested" // 이전 사이클이 수렴하지 않았거나 즉시 실행이 요청됨
+)
+
+// ReconcileDecision은 이번 사이클에 전체 reconcile이 필요한지에 대한 판단 결과입니다
+type ReconcileDecision struct {
+	Full   bool
+	Reason string // Full일 때의 사유
+	Token  string // 이번 사이클 시작 시점의 변경 토큰 (사이클이 수렴하면 Record에 전달)
+}
+
+// ChangeTracker는 저장소의 변경 토큰을 비교해 바뀐 것이 없는 사이클의 전체 reconcile을 건너뛰게 하는 도메인 서비스입니다
+// 노드 쪽 드리프트는 토큰에 나타나지 않으므로 resyncInterval마다 전체 reconcile을 실행합니다
+type ChangeTracker struct {
+	source         interfaces.ChangeTokenSource
+	clock          interfaces.Clock
+	resyncInterval time.Duration
+
+	mu           sync.Mutex
+	lastToken    string
+	lastFullSync time.Time // 마지막으로 수렴한 전체 reconcile 시각 (zero면 아직 없음)
+	forced       bool
+}
+
+// NewChangeTracker는 새로운 ChangeTracker를 생성합니다
+func NewChangeTracker(source interfaces.ChangeTokenSource, clock interfaces.Clock, resyncInterval time.Duration) *ChangeTracker {
+	return &ChangeTracker{
+		source:         source,
+		clock:          clock,
+		resyncInterval: resyncInterval,
+	}
+}
+
+// Check는 변경 토큰을 조회해 이번 사이클에 전체 reconcile이 필요한지 판단합니다
+// 토큰 조회에 실패하면 에러를 반환하며, 호출자는 전체 조회와 같은 저장소 장애로 처리합니다
+func (t *ChangeTracker) Check(ctx context.Context, nodeName string) (ReconcileDecision, error) {
+	token, err := t.source.GetChangeToken(ctx, nodeName)
+	if err != nil {
+		return ReconcileDecision{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	decision := ReconcileDecision{Full: true, Token: token}
+	switch {
+	case t.lastFullSync.IsZero():
+		decision.Reason = ReconcileReasonInitial
+	case t.forced:
+		decision.Reason = ReconcileReasonRequested
+	case token != t.lastToken:
+		decision.Reason = ReconcileReasonChanged
+	case t.clock.Now().Sub(t.lastFullSync) >= t.resyncInterval:
+		decision.Reason = ReconcileReasonResync
+	default:
+		decision.Full = false
+	}
+	return decision, nil
+}
+
+// Record는 token 시점의 저장소 상태로 노드가 수렴했음을 기록합니다
+// 사이클 중 에이전트 자신이 상태를 갱신했다면 토큰이 바뀌어 다음 사이클이 한 번 더 전체 reconcile을 실행합니다
+func (t *ChangeTracker) Record(token string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.lastToken = token
+	t.lastFullSync = t.clock.Now()
+	t.forced = false
+}
+
+// Invalidate는 다음 사이클이 토큰과 관계없이 전체 reconcile을 실행하도록 합니다
+// 실패한 인터페이스 재시도, 고아 삭제 확인 사이클 진행, 운영자의 즉시 실행 요청에 사용합니다
+func (t *ChangeTracker) Invalidate() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.forced = true
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeChangeTokenSource는 설정된 토큰을 반환하는 ChangeTokenSource입니다
+type fakeChangeTokenSource struct {
+	token string
+	err   error
+	calls int
+}
+
+func (s *fakeChangeTokenSource) GetChangeToken(ctx context.Context, nodeName string) (string, error) {
+	s.calls++
+	return s.token, s.err
+}
+
+func TestChangeTracker_Check(t *testing.T) {
+	source := &fakeChangeTokenSource{token: "3/2025-07-10 06:15:30/1a2b"}
+	clock := &mutableClock{now: time.Now()}
+	tracker := NewChangeTracker(source, clock, 5*time.Minute)
+	ctx := context.Background()
+
+	// 첫 사이클은 항상 전체 reconcile
+	decision, err := tracker.Check(ctx, "worker-1")
+	require.NoError(t, err)
+	assert.Equal(t, ReconcileDecision{Full: true, Reason: ReconcileReasonInitial, Token: source.token}, decision)
+	tracker.Record(decision.Token)
+
+	// 토큰이 같으면 건너뜀
+	clock.now = clock.now.Add(time.Minute)
+	decision, err = tracker.Check(ctx, "worker-1")
+	require.NoError(t, err)
+	assert.False(t, decision.Full)
+
+	// 토큰이 바뀌면 전체 reconcile
+	source.token = "4/2025-07-10 06:16:02/9f0e"
+	decision, err = tracker.Check(ctx, "worker-1")
+	require.NoError(t, err)
+	assert.Equal(t, ReconcileReasonChanged, decision.Reason)
+
+	// 수렴하지 않은 사이클은 기록하지 않으므로 다음 사이클도 전체 reconcile
+	decision, err = tracker.Check(ctx, "worker-1")
+	require.NoError(t, err)
+	assert.Equal(t, ReconcileReasonChanged, decision.Reason)
+	tracker.Record(decision.Token)
+
+	// 즉시 실행 요청
+	tracker.Invalidate()
+	decision, err = tracker.Check(ctx, "worker-1")
+	require.NoError(t, err)
+	assert.Equal(t, ReconcileReasonRequested, decision.Reason)
+	tracker.Record(decision.Token)
+
+	// 재동기화 주기가 지나면 토큰이 같아도 전체 reconcile
+	clock.now = clock.now.Add(5 * time.Minute)
+	decision, err = tracker.Check(ctx, "worker-1")
+	require.NoError(t, err)
+	assert.Equal(t, ReconcileReasonResync, decision.Reason)
+}
+
+func TestChangeTracker_SourceError(t *testing.T) {
+	source := &fakeChangeTokenSource{err: fmt.Errorf("connection refused")}
+	tracker := NewChangeTracker(source, &mutableClock{now: time.Now()}, 5*time.Minute)
+
+	_, err := tracker.Check(context.Background(), "worker-1")
+	assert.Error(t, err)
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"errors"
+	"multinic-agent/internal/domain/entities"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// 설정 파일 드리프트 유형 (multinic_configuration_drifts_total의 drift_type 레이블)
+const (
+	DriftTypeMACAddress     = "mac_address"
+	DriftTypeMissingAddress = "missing_address"
+	DriftTypeIPAddress      = "ip_address"
+	DriftTypeCIDR           = "cidr"
+	DriftTypeMTU            = "mtu"
+	DriftTypeUnowned        = "unowned"  // 소유권 헤더가 없는 이전 버전 파일
+	DriftTypeTampered       = "tampered" // 소유권 헤더의 해시와 내용이 다른 수동 수정 파일
+	DriftTypeOwner          = "owner"    // 소유권 헤더의 인터페이스 ID가 DB 행과 다름 (행 재생성 등)
+)
+
+// ErrConfigNotOwned는 설정 파일이 에이전트가 생성했다는 증거가 없어 덮어쓸 수 없음을 나타냅니다
+var ErrConfigNotOwned = errors.New("configuration file is not owned by the agent")
+
+// ErrConfigForeign은 설정 파일의 소유권 헤더가 다른 에이전트(다른 배포나 AGENT_ID)의 것이어서 덮어쓰거나 삭제할 수 없음을 나타냅니다
+var ErrConfigForeign = errors.New("configuration file is owned by another agent")
+
+// IsForeignOwnership은 소유권 헤더가 다른 에이전트의 것인지 확인합니다 (agentID가 비어 있으면 비교하지 않음)
+// aliases는 agentID 외에 이 에이전트의 것으로 인정하는 ID입니다 (예: 노드 이름이 바뀌기 전에 기록된 다른 후보 이름)
+func IsForeignOwnership(ownership *entities.FileOwnership, agentID string, aliases ...string) bool {
+	if ownership == nil || agentID == "" || ownership.AgentID == agentID {
+		return false
+	}
+	return !slices.Contains(aliases, ownership.AgentID)
+}
+
+// DetectConfigDrift는 DB의 원하는 상태와 설정 파일에서 읽은 상태를 비교하여 드리프트 유형 목록을 반환합니다
+// 모든 OS 백엔드가 같은 정규화 모델(entities.InterfaceConfig)을 사용하므로 드리프트 판단 기준이 동일합니다
+func DetectConfigDrift(dbIface entities.NetworkInterface, fileConfig *entities.InterfaceConfig) []string {
+	desired := entities.NewInterfaceConfig(dbIface, fileConfig.Name)
+	var drifts []string
+
+	if desired.MacAddress != fileConfig.MacAddress {
+		drifts = append(drifts, DriftTypeMACAddress)
+	}
+
+	switch {
+	case desired.HasAddress() && !fileConfig.HasAddress():
+		drifts = append(drifts, DriftTypeMissingAddress)
+	case desired.Address != fileConfig.Address:
+		drifts = append(drifts, DriftTypeIPAddress)
+	}
+
+	if desired.HasAddress() && fileConfig.HasAddress() && desired.CIDR != fileConfig.CIDR {
+		drifts = append(drifts, DriftTypeCIDR)
+	}
+
+	if desired.MTU != fileConfig.MTU {
+		drifts = append(drifts, DriftTypeMTU)
+	}
+
+	return drifts
+}
+
+// DetectOwnershipDrift는 설정 파일의 소유권 헤더를 확인하여 드리프트 유형 목록을 반환합니다
+// 헤더가 없어도 DB와 같은 MAC의 파일은 이전 버전이 생성한 파일로 보고 다시 생성하여 소유권을 기록하지만,
+// 다른 MAC의 파일은 수동으로 만든 파일일 수 있으므로 ErrConfigNotOwned를 반환합니다
+// 헤더의 에이전트 ID가 실행 중인 에이전트(agentID 또는 aliases)와 다르면 ErrConfigForeign을 반환합니다
+func DetectOwnershipDrift(dbIface entities.NetworkInterface, fileConfig *entities.InterfaceConfig, agentID string, aliases ...string) ([]string, error) {
+	switch {
+	case IsForeignOwnership(fileConfig.Ownership, agentID, aliases...):
+		return nil, ErrConfigForeign
+	case fileConfig.Ownership == nil:
+		if fileConfig.MacAddress != strings.ToLower(dbIface.MacAddress) {
+			return nil, ErrConfigNotOwned
+		}
+		return []string{DriftTypeUnowned}, nil
+	case fileConfig.Tampered:
+		return []string{DriftTypeTampered}, nil
+	case fileConfig.Ownership.InterfaceID != dbIface.ID:
+		return []string{DriftTypeOwner}, nil
+	}
+	return nil, nil
+}
+
+// DiffInterfaceConfig는 dry-run 계획에 표시할 설정 파일과 원하는 상태의 필드별 차이를 반환합니다
+// current가 nil이면 설정 파일이 없는 것으로 보고 원하는 값 전체를 차이로 반환합니다
+func DiffInterfaceConfig(desired entities.InterfaceConfig, current *entities.InterfaceConfig) []entities.FieldDiff {
+	if current == nil {
+		current = &entities.InterfaceConfig{}
+	}
+
+	var diffs []entities.FieldDiff
+	diffs = appendFieldDiff(diffs, "name", current.Name, desired.Name)
+	diffs = appendFieldDiff(diffs, "mac_address", current.MacAddress, desired.MacAddress)
+	diffs = appendFieldDiff(diffs, "address", current.Address, desired.Address)
+	diffs = appendFieldDiff(diffs, "cidr", current.CIDR, desired.CIDR)
+	diffs = appendFieldDiff(diffs, "mtu", formatMTU(current.MTU), formatMTU(desired.MTU))
+	return diffs
+}
+
+// appendFieldDiff는 값이 다를 때만 필드 차이를 추가합니다
+func appendFieldDiff(diffs []entities.FieldDiff, field, current, desired string) []entities.FieldDiff {
+	if current == desired {
+		return diffs
+	}
+	return append(diffs, entities.FieldDiff{Field: field, Current: current, Desired: desired})
+}
+
+// formatMTU는 MTU를 문자열로 변환합니다 (0은 미설정이므로 빈 문자열)
+func formatMTU(mtu int) string {
+	if mtu == 0 {
+		return ""
+	}
+	return strconv.Itoa(mtu)
+}
@@ -0,0 +1,189 @@
+package services
+
+import (
+	"testing"
+
+	"multinic-agent/internal/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectConfigDrift(t *testing.T) {
+	dbIface := entities.NetworkInterface{
+		MacAddress: "FA:16:3E:00:BE:63",
+		Address:    "192.168.1.10",
+		CIDR:       "192.168.1.0/24",
+		MTU:        1450,
+	}
+	inSync := entities.InterfaceConfig{
+		Name:       "multinic0",
+		MacAddress: "fa:16:3e:00:be:63",
+		Address:    "192.168.1.10",
+		CIDR:       "192.168.1.0/24",
+		MTU:        1450,
+	}
+
+	tests := []struct {
+		name     string
+		dbIface  entities.NetworkInterface
+		modify   func(*entities.InterfaceConfig)
+		expected []string
+	}{
+		{
+			name:     "동기화된 상태",
+			dbIface:  dbIface,
+			modify:   func(c *entities.InterfaceConfig) {},
+			expected: nil,
+		},
+		{
+			name: "DB CIDR이 정규화되지 않아도 같은 네트워크면 드리프트 아님",
+			dbIface: func() entities.NetworkInterface {
+				iface := dbIface
+				iface.CIDR = "192.168.1.10/24"
+				return iface
+			}(),
+			modify:   func(c *entities.InterfaceConfig) {},
+			expected: nil,
+		},
+		{
+			name:     "파일에 주소 누락",
+			dbIface:  dbIface,
+			modify:   func(c *entities.InterfaceConfig) { c.Address, c.CIDR = "", "" },
+			expected: []string{DriftTypeMissingAddress},
+		},
+		{
+			name:     "프리픽스 변경",
+			dbIface:  dbIface,
+			modify:   func(c *entities.InterfaceConfig) { c.CIDR = "192.168.0.0/16" },
+			expected: []string{DriftTypeCIDR},
+		},
+		{
+			name:     "IP와 MTU 변경",
+			dbIface:  dbIface,
+			modify:   func(c *entities.InterfaceConfig) { c.Address, c.MTU = "192.168.1.11", 1500 },
+			expected: []string{DriftTypeIPAddress, DriftTypeMTU},
+		},
+		{
+			name:     "MAC 주소 불일치",
+			dbIface:  dbIface,
+			modify:   func(c *entities.InterfaceConfig) { c.MacAddress = "fa:16:3e:00:be:64" },
+			expected: []string{DriftTypeMACAddress},
+		},
+		{
+			name: "CIDR 없는 DB 주소는 렌더링되지 않으므로 드리프트 아님",
+			dbIface: func() entities.NetworkInterface {
+				iface := dbIface
+				iface.CIDR = ""
+				return iface
+			}(),
+			modify:   func(c *entities.InterfaceConfig) { c.Address, c.CIDR = "", "" },
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fileConfig := inSync
+			tt.modify(&fileConfig)
+
+			assert.Equal(t, tt.expected, DetectConfigDrift(tt.dbIface, &fileConfig))
+		})
+	}
+}
+
+func TestDiffInterfaceConfig(t *testing.T) {
+	desired := entities.InterfaceConfig{
+		Name:       "multinic0",
+		MacAddress: "fa:16:3e:00:be:63",
+		Address:    "192.168.1.10",
+		CIDR:       "192.168.1.0/24",
+		MTU:        1450,
+	}
+
+	t.Run("설정 파일이 없으면 원하는 값 전체", func(t *testing.T) {
+		diffs := DiffInterfaceConfig(desired, nil)
+
+		assert.Equal(t, []entities.FieldDiff{
+			{Field: "name", Desired: "multinic0"},
+			{Field: "mac_address", Desired: "fa:16:3e:00:be:63"},
+			{Field: "address", Desired: "192.168.1.10"},
+			{Field: "cidr", Desired: "192.168.1.0/24"},
+			{Field: "mtu", Desired: "1450"},
+		}, diffs)
+	})
+
+	t.Run("다른 필드만 표시", func(t *testing.T) {
+		current := desired
+		current.Address = "192.168.1.20"
+		current.MTU = 0
+
+		diffs := DiffInterfaceConfig(desired, &current)
+
+		assert.Equal(t, []entities.FieldDiff{
+			{Field: "address", Current: "192.168.1.20", Desired: "192.168.1.10"},
+			{Field: "mtu", Current: "", Desired: "1450"},
+		}, diffs)
+	})
+
+	t.Run("동기화된 상태", func(t *testing.T) {
+		current := desired
+		assert.Empty(t, DiffInterfaceConfig(desired, &current))
+	})
+}
+
+func TestDetectOwnershipDrift(t *testing.T) {
+	dbIface := entities.NetworkInterface{ID: 1, MacAddress: "FA:16:3E:00:BE:63"}
+	owned := &entities.FileOwnership{AgentID: "node-a", InterfaceID: 1, MacAddress: "fa:16:3e:00:be:63"}
+	foreign := &entities.FileOwnership{AgentID: "node-b", InterfaceID: 1, MacAddress: "fa:16:3e:00:be:63"}
+
+	tests := []struct {
+		name        string
+		fileConfig  entities.InterfaceConfig
+		agentID     string
+		aliases     []string
+		expected    []string
+		expectedErr error
+	}{
+		{
+			name:       "같은 에이전트의 헤더가 있고 내용이 같으면 드리프트 없음",
+			fileConfig: entities.InterfaceConfig{MacAddress: "fa:16:3e:00:be:63", Ownership: owned},
+			agentID:    "node-a",
+		},
+		{
+			name:        "다른 에이전트의 헤더는 수정되었어도 외부 파일",
+			fileConfig:  entities.InterfaceConfig{MacAddress: "fa:16:3e:00:be:63", Ownership: foreign, Tampered: true},
+			agentID:     "node-a",
+			expectedErr: ErrConfigForeign,
+		},
+		{
+			name:       "노드 이름이 바뀌기 전 후보 이름으로 기록된 헤더는 같은 에이전트",
+			fileConfig: entities.InterfaceConfig{MacAddress: "fa:16:3e:00:be:63", Ownership: foreign},
+			agentID:    "node-a",
+			aliases:    []string{"node-a", "node-b"},
+		},
+		{
+			name:       "에이전트 ID가 비어 있으면 헤더의 에이전트 ID를 비교하지 않음",
+			fileConfig: entities.InterfaceConfig{MacAddress: "fa:16:3e:00:be:63", Ownership: foreign},
+		},
+		{
+			name:       "헤더 없는 같은 MAC의 파일은 소유권 기록 대상",
+			fileConfig: entities.InterfaceConfig{MacAddress: "fa:16:3e:00:be:63"},
+			agentID:    "node-a",
+			expected:   []string{DriftTypeUnowned},
+		},
+		{
+			name:        "헤더 없는 다른 MAC의 파일은 소유하지 않은 파일",
+			fileConfig:  entities.InterfaceConfig{MacAddress: "fa:16:3e:00:be:64"},
+			agentID:     "node-a",
+			expectedErr: ErrConfigNotOwned,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drifts, err := DetectOwnershipDrift(dbIface, &tt.fileConfig, tt.agentID, tt.aliases...)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, drifts)
+		})
+	}
+}
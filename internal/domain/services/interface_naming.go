@@ -14,11 +14,13 @@ import (
 type InterfaceNamingService struct {
 	fileSystem      interfaces.FileSystem
 	commandExecutor interfaces.CommandExecutor
-	isContainer     bool // indicates if running in container
+	scheme          entities.NamingScheme
+	allocator       *NameAllocator // nil이면 이름 할당을 영구 저장하지 않음
+	isContainer     bool           // indicates if running in container
 }
 
 // NewInterfaceNamingService는 새로운 InterfaceNamingService를 생성합니다
-func NewInterfaceNamingService(fs interfaces.FileSystem, executor interfaces.CommandExecutor) *InterfaceNamingService {
+func NewInterfaceNamingService(fs interfaces.FileSystem, executor interfaces.CommandExecutor, scheme entities.NamingScheme, allocator *NameAllocator) *InterfaceNamingService {
 	// Check if running in container by checking if /host exists
 	isContainer := false
 	if _, err := executor.ExecuteWithTimeout(context.Background(), 1*time.Second, "test", "-d", "/host"); err == nil {
@@ -28,47 +30,202 @@ func NewInterfaceNamingService(fs interfaces.FileSystem, executor interfaces.Com
 	return &InterfaceNamingService{
 		fileSystem:      fs,
 		commandExecutor: executor,
+		scheme:          scheme,
+		allocator:       allocator,
 		isContainer:     isContainer,
 	}
 }
 
+// Scheme은 인터페이스 이름 생성 규칙을 반환합니다
+func (s *InterfaceNamingService) Scheme() entities.NamingScheme {
+	return s.scheme
+}
+
+// IsManagedName은 이름이 에이전트의 이름 생성 규칙으로 만들어진 이름인지 확인합니다
+func (s *InterfaceNamingService) IsManagedName(name string) bool {
+	return s.scheme.Matches(name)
+}
+
+// Allocator는 이름 할당 저장소를 반환합니다 (비활성화 시 nil)
+func (s *InterfaceNamingService) Allocator() *NameAllocator {
+	return s.allocator
+}
+
 // GenerateNextName은 사용 가능한 다음 인터페이스 이름을 생성합니다
 func (s *InterfaceNamingService) GenerateNextName() (entities.InterfaceName, error) {
-	for i := 0; i < 10; i++ {
-		name := fmt.Sprintf("multinic%d", i)
+	return s.generateNextName("", notReserved)
+}
+
+// notReserved는 이름 할당 저장소가 없을 때 사용하는 예약 확인 함수입니다
+func notReserved(string) bool {
+	return false
+}
+
+// generateNextName은 네트워크 이름을 반영하여 사용 가능한 가장 작은 인덱스의 이름을 생성합니다
+// 다른 MAC에 할당되었거나 재사용 대기 중인 이름은 건너뜁니다
+func (s *InterfaceNamingService) generateNextName(network string, isReserved func(name string) bool) (entities.InterfaceName, error) {
+	for i := 0; i < s.scheme.MaxInterfaces(); i++ {
+		name := s.scheme.Name(i, network)
 
 		// 실제 인터페이스로 존재하는지 확인
-		if s.isInterfaceInUse(name) {
+		if isReserved(name) || s.isInterfaceInUse(name) {
 			continue
 		}
 
 		// 사용 가능한 이름 발견
-		return entities.NewInterfaceName(name)
+		return s.scheme.NewInterfaceName(name)
 	}
 
-	return entities.InterfaceName{}, fmt.Errorf("사용 가능한 인터페이스 이름이 없습니다 (multinic0-9 모두 사용 중)")
+	return entities.InterfaceName{}, fmt.Errorf("사용 가능한 인터페이스 이름이 없습니다 (%s-%s 모두 사용 중)",
+		s.scheme.Name(0, network), s.scheme.Name(s.scheme.MaxInterfaces()-1, network))
 }
 
 // GenerateNextNameForMAC은 특정 MAC 주소에 대한 인터페이스 이름을 생성합니다
 // 이미 해당 MAC 주소로 설정된 인터페이스가 있다면 해당 이름을 재사용합니다
 func (s *InterfaceNamingService) GenerateNextNameForMAC(macAddress string) (entities.InterfaceName, error) {
+	return s.generateNameForMAC(macAddress, "", true)
+}
+
+// GenerateNameForInterface는 인터페이스의 MAC 주소와 네트워크 이름으로 인터페이스 이름을 생성합니다
+// DB에서 이름을 지정한 경우 이름 규칙 대신 지정된 이름을 검증하여 사용합니다
+func (s *InterfaceNamingService) GenerateNameForInterface(iface entities.NetworkInterface) (entities.InterfaceName, error) {
+	return s.nameForInterface(iface, true)
+}
+
+// PreviewNameForInterface는 GenerateNameForInterface와 같은 이름을 결정하지만 이름 할당을 저장하지 않습니다 (dry-run용)
+func (s *InterfaceNamingService) PreviewNameForInterface(iface entities.NetworkInterface) (entities.InterfaceName, error) {
+	return s.nameForInterface(iface, false)
+}
+
+// nameForInterface는 인터페이스 이름을 결정하고, persist가 true이면 이름 할당을 저장합니다
+func (s *InterfaceNamingService) nameForInterface(iface entities.NetworkInterface, persist bool) (entities.InterfaceName, error) {
+	if iface.DesiredName != "" {
+		return s.assignDesiredName(iface.MacAddress, iface.DesiredName, persist)
+	}
+	return s.generateNameForMAC(iface.MacAddress, iface.NetworkName, persist)
+}
+
+// assignDesiredName은 DB에서 지정한 이름을 검증하고 다른 링크나 할당과 충돌하지 않는지 확인합니다
+func (s *InterfaceNamingService) assignDesiredName(macAddress, desiredName string, persist bool) (entities.InterfaceName, error) {
+	name, err := entities.NewCustomInterfaceName(desiredName)
+	if err != nil {
+		return entities.InterfaceName{}, fmt.Errorf("지정된 인터페이스 이름 %q이(가) 유효하지 않습니다: %w", desiredName, err)
+	}
+
+	// 같은 이름의 링크가 다른 MAC으로 이미 존재하면 설정 시 이름 변경이 실패하거나 다른 NIC을 덮어씀
+	if s.isInterfaceInUse(name.String()) {
+		existingMAC, err := s.GetMacAddressForInterface(name.String())
+		if err != nil {
+			return entities.InterfaceName{}, fmt.Errorf("지정된 이름 %s의 기존 링크를 확인할 수 없습니다: %w", name, err)
+		}
+		if !strings.EqualFold(existingMAC, macAddress) {
+			return entities.InterfaceName{}, fmt.Errorf("지정된 이름 %s은(는) 다른 링크(MAC %s)가 사용 중입니다", name, existingMAC)
+		}
+	}
+
+	if s.allocator != nil {
+		assign := s.allocator.Assign
+		if !persist {
+			assign = s.allocator.CheckAssign
+		}
+		if err := assign(context.Background(), macAddress, name.String()); err != nil {
+			return entities.InterfaceName{}, fmt.Errorf("지정된 이름 %s을(를) 할당할 수 없습니다: %w", name, err)
+		}
+	}
+
+	return name, nil
+}
+
+// CurrentNameForInterface는 인터페이스의 MAC에 현재 할당되어 있거나 커널에서 사용 중인 관리 이름을 반환합니다 (없으면 빈 문자열)
+// DB에서 지정한 이름으로 바뀔 때 이전 이름의 설정 파일을 정리하는 데 사용합니다
+func (s *InterfaceNamingService) CurrentNameForInterface(ctx context.Context, iface entities.NetworkInterface) (string, error) {
+	if s.allocator != nil {
+		name, ok, err := s.allocator.Lookup(ctx, iface.MacAddress)
+		if err != nil || ok {
+			return name, err
+		}
+	}
+
+	for i := 0; i < s.scheme.MaxInterfaces(); i++ {
+		name := s.scheme.Name(i, iface.NetworkName)
+		if !s.isInterfaceInUse(name) {
+			continue
+		}
+		if existingMAC, err := s.GetMacAddressForInterface(name); err == nil && strings.EqualFold(existingMAC, iface.MacAddress) {
+			return name, nil
+		}
+	}
+
+	return "", nil
+}
+
+// generateNameForMAC은 해당 MAC에 할당된 이름을 재사용하거나 새 이름을 생성합니다
+// 이름 할당 저장소가 있으면 저장된 할당을 우선하고, persist가 true이면 새로 정한 이름을 저장합니다
+func (s *InterfaceNamingService) generateNameForMAC(macAddress, network string, persist bool) (entities.InterfaceName, error) {
+	if s.allocator == nil {
+		return s.findOrGenerateName(macAddress, network, notReserved)
+	}
+
+	resolve := s.allocator.Resolve
+	if !persist {
+		resolve = s.allocator.Preview
+	}
+	name, err := resolve(context.Background(), macAddress, func(isReserved func(name string) bool) (string, error) {
+		interfaceName, err := s.findOrGenerateName(macAddress, network, isReserved)
+		return interfaceName.String(), err
+	})
+	if err != nil {
+		return entities.InterfaceName{}, err
+	}
+
+	// DB에서 지정했던 이름은 이름 규칙 밖에 있을 수 있으므로 커널 이름 규칙으로 검증
+	interfaceName, err := s.scheme.NewInterfaceName(name)
+	if err != nil {
+		if interfaceName, err = entities.NewCustomInterfaceName(name); err != nil {
+			return entities.InterfaceName{}, fmt.Errorf("할당된 이름 %s이(가) 유효하지 않습니다: %w", name, err)
+		}
+	}
+	return interfaceName, nil
+}
+
+// findOrGenerateName은 해당 MAC 주소로 이미 설정된 인터페이스 이름을 찾고, 없으면 새 이름을 생성합니다
+func (s *InterfaceNamingService) findOrGenerateName(macAddress, network string, isReserved func(name string) bool) (entities.InterfaceName, error) {
 	// 먼저 해당 MAC 주소로 이미 설정된 인터페이스가 있는지 확인
-	for i := 0; i < 10; i++ {
-		name := fmt.Sprintf("multinic%d", i)
+	for i := 0; i < s.scheme.MaxInterfaces(); i++ {
+		name := s.scheme.Name(i, network)
 
 		// ip 명령어로 MAC 주소 확인
-		if s.isInterfaceInUse(name) {
+		if !isReserved(name) && s.isInterfaceInUse(name) {
 			// 해당 인터페이스의 MAC 주소 확인
 			existingMAC, err := s.GetMacAddressForInterface(name)
 			if err == nil && strings.EqualFold(existingMAC, macAddress) {
 				// 동일한 MAC 주소를 가진 인터페이스 발견
-				return entities.NewInterfaceName(name)
+				return s.scheme.NewInterfaceName(name)
 			}
 		}
 	}
 
 	// 기존에 할당된 이름이 없으면 새로운 이름 생성
-	return s.GenerateNextName()
+	return s.generateNextName(network, isReserved)
+}
+
+// ReleaseName은 삭제된 인터페이스의 MAC에 할당된 이름을 해제합니다
+func (s *InterfaceNamingService) ReleaseName(ctx context.Context, macAddress string) error {
+	if s.allocator == nil {
+		return nil
+	}
+	return s.allocator.Release(ctx, macAddress)
+}
+
+// PinName은 이름 규칙을 검증한 뒤 MAC에 이름을 고정 할당합니다
+func (s *InterfaceNamingService) PinName(ctx context.Context, macAddress, name string) error {
+	if s.allocator == nil {
+		return fmt.Errorf("name allocation store is disabled")
+	}
+	if _, err := s.scheme.NewInterfaceName(name); err != nil {
+		return fmt.Errorf("name %s does not match the naming scheme: %w", name, err)
+	}
+	return s.allocator.Pin(ctx, macAddress, name)
 }
 
 // isInterfaceInUse는 인터페이스가 이미 사용 중인지 확인합니다
@@ -82,10 +239,10 @@ func (s *InterfaceNamingService) isInterfaceInUse(name string) bool {
 func (s *InterfaceNamingService) GetCurrentMultinicInterfaces() []entities.InterfaceName {
 	var interfaces []entities.InterfaceName
 
-	for i := 0; i < 10; i++ {
-		name := fmt.Sprintf("multinic%d", i)
+	for i := 0; i < s.scheme.MaxInterfaces(); i++ {
+		name := s.scheme.Name(i, "")
 		if s.isInterfaceInUse(name) {
-			if interfaceName, err := entities.NewInterfaceName(name); err == nil {
+			if interfaceName, err := s.scheme.NewInterfaceName(name); err == nil {
 				interfaces = append(interfaces, interfaceName)
 			}
 		}
@@ -124,26 +281,3 @@ func (s *InterfaceNamingService) ListNetplanFiles(dir string) ([]string, error)
 
 	return files, nil
 }
-
-// GetHostname은 시스템의 호스트네임을 반환합니다
-func (s *InterfaceNamingService) GetHostname() (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	output, err := s.commandExecutor.ExecuteWithTimeout(ctx, 5*time.Second, "hostname")
-	if err != nil {
-		return "", fmt.Errorf("failed to get hostname: %w", err)
-	}
-
-	hostname := strings.TrimSpace(string(output))
-	if hostname == "" {
-		return "", fmt.Errorf("hostname is empty")
-	}
-
-	// .novalocal 또는 다른 도메인 접미사 제거 (main.go와 동일한 로직)
-	if idx := strings.Index(hostname, "."); idx != -1 {
-		hostname = hostname[:idx]
-	}
-
-	return hostname, nil
-}
@@ -3,12 +3,14 @@ package services
 import (
 	"context"
 	"fmt"
+	"multinic-agent/internal/domain/entities"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // MockFileSystem은 FileSystem 인터페이스의 목 구현체입니다
@@ -41,6 +43,16 @@ func (m *MockFileSystem) Remove(path string) error {
 	return args.Error(0)
 }
 
+func (m *MockFileSystem) Rename(oldPath, newPath string) error {
+	args := m.Called(oldPath, newPath)
+	return args.Error(0)
+}
+
+func (m *MockFileSystem) WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	args := m.Called(path, data, perm)
+	return args.Error(0)
+}
+
 func (m *MockFileSystem) ListFiles(path string) ([]string, error) {
 	args := m.Called(path)
 	return args.Get(0).([]string), args.Error(1)
@@ -152,7 +164,7 @@ func TestInterfaceNamingService_GenerateNextName(t *testing.T) {
 			mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "nmcli", "-t", "-f", "NAME", "c", "show").Return([]byte(""), nil).Maybe()
 			// 컨테이너 환경에서 nsenter 사용하는 경우도 대비
 			mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "nsenter", "--target", "1", "--mount", "--uts", "--ipc", "--net", "--pid", "nmcli", "-t", "-f", "NAME", "c", "show").Return([]byte(""), nil).Maybe()
-			service := NewInterfaceNamingService(mockFS, mockExecutor)
+			service := NewInterfaceNamingService(mockFS, mockExecutor, entities.DefaultNamingScheme(), nil)
 			result, err := service.GenerateNextName()
 
 			if tt.wantError {
@@ -201,7 +213,7 @@ func TestInterfaceNamingService_isInterfaceInUse(t *testing.T) {
 			expectedPath := fmt.Sprintf("/sys/class/net/%s", tt.interfaceName)
 			mockFS.On("Exists", expectedPath).Return(tt.exists)
 
-			service := NewInterfaceNamingService(mockFS, mockExecutor)
+			service := NewInterfaceNamingService(mockFS, mockExecutor, entities.DefaultNamingScheme(), nil)
 			result := service.isInterfaceInUse(tt.interfaceName)
 
 			assert.Equal(t, tt.expected, result)
@@ -272,7 +284,7 @@ func TestInterfaceNamingService_GetCurrentMultinicInterfaces_SystemBased(t *test
 			mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "nsenter", "--target", "1", "--mount", "--uts", "--ipc", "--net", "--pid", "nmcli", "-t", "-f", "NAME", "c", "show").Return([]byte(""), nil).Maybe()
 			tt.setupMock(mockFS)
 
-			service := NewInterfaceNamingService(mockFS, mockExecutor)
+			service := NewInterfaceNamingService(mockFS, mockExecutor, entities.DefaultNamingScheme(), nil)
 			interfaces := service.GetCurrentMultinicInterfaces()
 
 			assert.Equal(t, tt.expectedCount, len(interfaces))
@@ -354,7 +366,7 @@ func TestInterfaceNamingService_GetMacAddressForInterface_FromIPCommand(t *testi
 			mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "nsenter", "--target", "1", "--mount", "--uts", "--ipc", "--net", "--pid", "nmcli", "-t", "-f", "NAME", "c", "show").Return([]byte(""), nil).Maybe()
 			tt.setupMock(mockFS, mockExecutor)
 
-			service := NewInterfaceNamingService(mockFS, mockExecutor)
+			service := NewInterfaceNamingService(mockFS, mockExecutor, entities.DefaultNamingScheme(), nil)
 			mac, err := service.GetMacAddressForInterface(tt.interfaceName)
 
 			if tt.expectError {
@@ -368,66 +380,84 @@ func TestInterfaceNamingService_GetMacAddressForInterface_FromIPCommand(t *testi
 	}
 }
 
-func TestInterfaceNamingService_GetHostname(t *testing.T) {
-	tests := []struct {
-		name           string
-		hostnameOutput string
-		expectError    bool
-		expectedHost   string
-	}{
-		{
-			name:           "도메인 접미사가 있는 호스트네임",
-			hostnameOutput: "biz-master1.novalocal",
-			expectError:    false,
-			expectedHost:   "biz-master1",
-		},
-		{
-			name:           "도메인 접미사가 없는 호스트네임",
-			hostnameOutput: "worker-node",
-			expectError:    false,
-			expectedHost:   "worker-node",
-		},
-		{
-			name:           "여러 도메인 레벨",
-			hostnameOutput: "test.example.com",
-			expectError:    false,
-			expectedHost:   "test",
-		},
-		{
-			name:           "빈 호스트네임",
-			hostnameOutput: "",
-			expectError:    true,
-			expectedHost:   "",
-		},
+func TestInterfaceNamingService_GenerateNextName_ConfiguredScheme(t *testing.T) {
+	scheme, err := entities.NewNamingScheme("multinic", "{prefix}{index}", 16)
+	require.NoError(t, err)
+
+	mockFS := new(MockFileSystem)
+	for i := 0; i < 12; i++ {
+		mockFS.On("Exists", fmt.Sprintf("/sys/class/net/multinic%d", i)).Return(true)
 	}
+	mockFS.On("Exists", "/sys/class/net/multinic12").Return(false)
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			mockFS := new(MockFileSystem)
-			mockExecutor := new(MockCommandExecutor)
+	mockExecutor := new(MockCommandExecutor)
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
 
-			// 컨테이너 환경 체크 Mock 추가
-			mockExecutor.On("ExecuteWithTimeout", mock.Anything, 1*time.Second, "test", "-d", "/host").
-				Return([]byte(""), fmt.Errorf("not found")).Once()
+	service := NewInterfaceNamingService(mockFS, mockExecutor, scheme, nil)
+	result, err := service.GenerateNextName()
 
-			if tt.expectError && tt.hostnameOutput == "" {
-				mockExecutor.On("ExecuteWithTimeout", mock.Anything, 5*time.Second, "hostname").
-					Return([]byte(""), nil).Once()
-			} else {
-				mockExecutor.On("ExecuteWithTimeout", mock.Anything, 5*time.Second, "hostname").
-					Return([]byte(tt.hostnameOutput), nil).Once()
-			}
+	require.NoError(t, err)
+	assert.Equal(t, "multinic12", result.String())
+	assert.True(t, service.IsManagedName("multinic15"))
+	assert.False(t, service.IsManagedName("multinic16"))
+}
 
-			service := NewInterfaceNamingService(mockFS, mockExecutor)
-			hostname, err := service.GetHostname()
+func TestInterfaceNamingService_GenerateNameForInterface_NetworkTemplate(t *testing.T) {
+	scheme, err := entities.NewNamingScheme("n", "{prefix}{network}{index}", 4)
+	require.NoError(t, err)
+
+	mockFS := new(MockFileSystem)
+	mockFS.On("Exists", "/sys/class/net/nstorage0").Return(true)
+	mockFS.On("Exists", "/sys/class/net/nstorage1").Return(false)
+	mockFS.On("Exists", "/sys/class/net/nstorage2").Return(false)
+	mockFS.On("Exists", "/sys/class/net/nstorage3").Return(false)
+
+	mockExecutor := new(MockCommandExecutor)
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "ip", "addr", "show", "nstorage0").
+		Return([]byte("link/ether fa:16:3e:00:00:01 brd ff:ff:ff:ff:ff:ff"), nil)
+
+	service := NewInterfaceNamingService(mockFS, mockExecutor, scheme, nil)
+	result, err := service.GenerateNameForInterface(entities.NetworkInterface{
+		MacAddress:  "fa:16:3e:00:00:02",
+		NetworkName: "storage",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "nstorage1", result.String())
+}
 
-			if tt.expectError {
-				assert.Error(t, err)
-			} else {
-				assert.NoError(t, err)
-				assert.Equal(t, tt.expectedHost, hostname)
-			}
-			mockExecutor.AssertExpectations(t)
-		})
-	}
+func TestInterfaceNamingService_GenerateNameForInterface_DesiredName(t *testing.T) {
+	mockFS := new(MockFileSystem)
+	mockFS.On("Exists", "/sys/class/net/storage0").Return(false)
+	mockFS.On("Exists", "/sys/class/net/tenant-a").Return(true)
+
+	mockExecutor := new(MockCommandExecutor)
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "ip", "addr", "show", "tenant-a").
+		Return([]byte("link/ether fa:16:3e:00:00:01 brd ff:ff:ff:ff:ff:ff"), nil)
+
+	service := NewInterfaceNamingService(mockFS, mockExecutor, entities.DefaultNamingScheme(), nil)
+
+	t.Run("이름 규칙 밖의 지정 이름 사용", func(t *testing.T) {
+		result, err := service.GenerateNameForInterface(entities.NetworkInterface{MacAddress: "fa:16:3e:00:00:02", DesiredName: "storage0"})
+		require.NoError(t, err)
+		assert.Equal(t, "storage0", result.String())
+	})
+
+	t.Run("같은 MAC의 기존 링크는 충돌이 아님", func(t *testing.T) {
+		result, err := service.GenerateNameForInterface(entities.NetworkInterface{MacAddress: "FA:16:3E:00:00:01", DesiredName: "tenant-a"})
+		require.NoError(t, err)
+		assert.Equal(t, "tenant-a", result.String())
+	})
+
+	t.Run("다른 MAC의 링크가 사용 중인 이름", func(t *testing.T) {
+		_, err := service.GenerateNameForInterface(entities.NetworkInterface{MacAddress: "fa:16:3e:00:00:03", DesiredName: "tenant-a"})
+		assert.Error(t, err)
+	})
+
+	t.Run("IFNAMSIZ를 초과하는 이름", func(t *testing.T) {
+		_, err := service.GenerateNameForInterface(entities.NetworkInterface{MacAddress: "fa:16:3e:00:00:03", DesiredName: "storage-network-0"})
+		assert.ErrorIs(t, err, entities.ErrInvalidInterfaceName)
+	})
 }
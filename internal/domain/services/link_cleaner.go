@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"multinic-agent/internal/domain/errors"
+	"multinic-agent/internal/domain/interfaces"
+)
+
+// LinkCleanupResult는 삭제된 인터페이스의 커널 링크 정리 결과입니다
+type LinkCleanupResult struct {
+	InterfaceName string // 정리 대상 인터페이스 이름
+	FinalName     string // 정리 후 링크 이름 (원래 커널 이름으로 변경된 경우 해당 이름)
+	LinkPresent   bool   // 정리 시점에 링크가 커널에 존재했는지 여부
+	Flushed       bool   // 주소/라우트/규칙 제거 완료 여부
+	Renamed       bool   // 원래 커널 이름으로 변경되었는지 여부
+	Verified      bool   // 정리 후 검증 통과 여부 (주소 없음, 링크 비활성화)
+	Error         string
+}
+
+// LinkCleaner는 설정 파일 삭제 후 커널에 남은 링크 상태를 정리하는 도메인 서비스입니다
+type LinkCleaner struct {
+	linkManager       interfaces.LinkManager
+	restoreKernelName bool
+}
+
+// NewLinkCleaner는 새로운 LinkCleaner를 생성합니다
+func NewLinkCleaner(linkManager interfaces.LinkManager, restoreKernelName bool) *LinkCleaner {
+	return &LinkCleaner{
+		linkManager:       linkManager,
+		restoreKernelName: restoreKernelName,
+	}
+}
+
+// Cleanup은 링크의 주소/라우트/규칙을 제거하고 비활성화한 뒤, 설정 시 원래 커널 이름으로 되돌리고 결과를 검증합니다
+// 링크가 이미 존재하지 않으면 (포트 분리 등) 정리가 필요 없으므로 검증 통과로 처리합니다
+func (c *LinkCleaner) Cleanup(ctx context.Context, name string) LinkCleanupResult {
+	return c.cleanup(ctx, name, c.restoreKernelName)
+}
+
+// Release는 설정 파일 없이 남은 링크를 정리하고 이름을 해제하기 위해 항상 원래 커널 이름으로 되돌립니다
+func (c *LinkCleaner) Release(ctx context.Context, name string) LinkCleanupResult {
+	return c.cleanup(ctx, name, true)
+}
+
+// cleanup은 링크 정리 절차를 수행합니다
+func (c *LinkCleaner) cleanup(ctx context.Context, name string, restoreKernelName bool) LinkCleanupResult {
+	result := LinkCleanupResult{
+		InterfaceName: name,
+		FinalName:     name,
+	}
+
+	if _, err := c.linkManager.GetLinkState(ctx, name); err != nil {
+		if errors.IsNotFoundError(err) {
+			result.Verified = true
+			return result
+		}
+		result.Error = err.Error()
+		return result
+	}
+	result.LinkPresent = true
+
+	if err := c.linkManager.FlushLink(ctx, name); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Flushed = true
+
+	if err := c.linkManager.SetLinkDown(ctx, name); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	if restoreKernelName {
+		if err := c.renameToKernelName(ctx, &result); err != nil {
+			result.Error = err.Error()
+		}
+	}
+
+	if err := c.verify(ctx, &result); err != nil && result.Error == "" {
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// renameToKernelName은 링크 이름을 udev가 부여하는 원래 커널 이름으로 되돌립니다
+func (c *LinkCleaner) renameToKernelName(ctx context.Context, result *LinkCleanupResult) error {
+	kernelName, err := c.linkManager.GetKernelName(ctx, result.InterfaceName)
+	if err != nil {
+		return fmt.Errorf("failed to resolve kernel name: %w", err)
+	}
+	if kernelName == result.InterfaceName {
+		return nil
+	}
+
+	if err := c.linkManager.RenameLink(ctx, result.InterfaceName, kernelName); err != nil {
+		return err
+	}
+	result.FinalName = kernelName
+	result.Renamed = true
+	return nil
+}
+
+// verify는 정리 후 링크에 주소가 남아있지 않고 비활성화되었는지 확인합니다
+func (c *LinkCleaner) verify(ctx context.Context, result *LinkCleanupResult) error {
+	state, err := c.linkManager.GetLinkState(ctx, result.FinalName)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			result.Verified = true
+			return nil
+		}
+		return fmt.Errorf("failed to verify link cleanup: %w", err)
+	}
+
+	if state.HasAddresses() {
+		return fmt.Errorf("link cleanup verification failed: %d addresses remain on %s", len(state.Addresses), result.FinalName)
+	}
+	if state.AdminUp {
+		return fmt.Errorf("link cleanup verification failed: %s is still up", result.FinalName)
+	}
+
+	result.Verified = true
+	return nil
+}
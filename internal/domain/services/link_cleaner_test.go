@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockLinkManager는 LinkManager 인터페이스의 목 구현체입니다
+type MockLinkManager struct {
+	mock.Mock
+}
+
+func (m *MockLinkManager) SetLinkUp(ctx context.Context, name string) error {
+	return m.Called(ctx, name).Error(0)
+}
+
+func (m *MockLinkManager) SetLinkDown(ctx context.Context, name string) error {
+	return m.Called(ctx, name).Error(0)
+}
+
+func (m *MockLinkManager) FlushLink(ctx context.Context, name string) error {
+	return m.Called(ctx, name).Error(0)
+}
+
+func (m *MockLinkManager) RenameLink(ctx context.Context, name, newName string) error {
+	return m.Called(ctx, name, newName).Error(0)
+}
+
+func (m *MockLinkManager) GetKernelName(ctx context.Context, name string) (string, error) {
+	args := m.Called(ctx, name)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockLinkManager) ListLinks(ctx context.Context) ([]entities.LinkState, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.LinkState), args.Error(1)
+}
+
+func (m *MockLinkManager) GetLinkState(ctx context.Context, name string) (*entities.LinkState, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.LinkState), args.Error(1)
+}
+
+func TestLinkCleaner_Cleanup(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("링크가 없으면 정리 없이 검증 통과", func(t *testing.T) {
+		linkManager := new(MockLinkManager)
+		linkManager.On("GetLinkState", ctx, "multinic1").Return(nil, errors.NewNotFoundError("link multinic1 does not exist"))
+
+		result := NewLinkCleaner(linkManager, true).Cleanup(ctx, "multinic1")
+
+		assert.False(t, result.LinkPresent)
+		assert.True(t, result.Verified)
+		assert.Empty(t, result.Error)
+		linkManager.AssertNotCalled(t, "FlushLink", mock.Anything, mock.Anything)
+	})
+
+	t.Run("주소가 남아있으면 검증 실패", func(t *testing.T) {
+		linkManager := new(MockLinkManager)
+		linkManager.On("GetLinkState", ctx, "multinic1").Return(&entities.LinkState{Name: "multinic1", Addresses: []string{"10.0.0.5/24"}}, nil)
+		linkManager.On("FlushLink", ctx, "multinic1").Return(nil)
+		linkManager.On("SetLinkDown", ctx, "multinic1").Return(nil)
+
+		result := NewLinkCleaner(linkManager, false).Cleanup(ctx, "multinic1")
+
+		assert.True(t, result.Flushed)
+		assert.False(t, result.Verified)
+		assert.Contains(t, result.Error, "1 addresses remain")
+		linkManager.AssertNotCalled(t, "GetKernelName", mock.Anything, mock.Anything)
+	})
+
+	t.Run("커널 이름 조회 실패 시 현재 이름으로 검증", func(t *testing.T) {
+		linkManager := new(MockLinkManager)
+		linkManager.On("GetLinkState", ctx, "multinic1").Return(&entities.LinkState{Name: "multinic1"}, nil)
+		linkManager.On("FlushLink", ctx, "multinic1").Return(nil)
+		linkManager.On("SetLinkDown", ctx, "multinic1").Return(nil)
+		linkManager.On("GetKernelName", ctx, "multinic1").Return("", errors.NewNotFoundError("no kernel name found"))
+
+		result := NewLinkCleaner(linkManager, true).Cleanup(ctx, "multinic1")
+
+		assert.Equal(t, "multinic1", result.FinalName)
+		assert.False(t, result.Renamed)
+		assert.True(t, result.Verified)
+		assert.Contains(t, result.Error, "failed to resolve kernel name")
+	})
+}
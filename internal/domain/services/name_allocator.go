@@ -0,0 +1,294 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/interfaces"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// NameAllocator는 MAC 주소별 인터페이스 이름 할당을 영구 저장소에 유지하는 도메인 서비스입니다
+// 한 번 할당된 이름은 재부팅이나 삭제 후 재추가에도 같은 MAC에 다시 할당되며,
+// 해제된 이름은 재사용 대기 시간이 지나야 다른 MAC에 할당됩니다
+type NameAllocator struct {
+	store      interfaces.NameAllocationStore
+	clock      interfaces.Clock
+	reuseDelay time.Duration
+	mu         sync.Mutex
+}
+
+// NewNameAllocator는 새로운 NameAllocator를 생성합니다
+func NewNameAllocator(store interfaces.NameAllocationStore, clock interfaces.Clock, reuseDelay time.Duration) *NameAllocator {
+	return &NameAllocator{
+		store:      store,
+		clock:      clock,
+		reuseDelay: reuseDelay,
+	}
+}
+
+// List는 저장된 할당 목록을 이름 순으로 반환합니다
+func (a *NameAllocator) List(ctx context.Context) ([]entities.NameAllocation, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	allocations, err := a.store.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load name allocations: %w", err)
+	}
+
+	sort.Slice(allocations, func(i, j int) bool {
+		return allocations[i].Name < allocations[j].Name
+	})
+	return allocations, nil
+}
+
+// Resolve는 MAC에 할당된 이름을 반환하고, 할당이 없으면 choose로 새 이름을 골라 저장합니다
+// choose에는 다른 MAC에 할당되었거나 재사용 대기 중인 이름인지 확인하는 함수가 전달됩니다
+// 해제된 할당이라도 이름이 아직 다른 MAC에 넘어가지 않았다면 같은 이름을 다시 사용합니다
+func (a *NameAllocator) Resolve(ctx context.Context, macAddress string, choose func(isReserved func(name string) bool) (string, error)) (string, error) {
+	return a.resolve(ctx, macAddress, choose, true)
+}
+
+// Preview는 Resolve와 같은 이름을 결정하지만 할당을 저장하지 않습니다 (dry-run용)
+func (a *NameAllocator) Preview(ctx context.Context, macAddress string, choose func(isReserved func(name string) bool) (string, error)) (string, error) {
+	return a.resolve(ctx, macAddress, choose, false)
+}
+
+// resolve는 MAC의 이름을 결정하고, persist가 true이면 바뀐 할당을 저장합니다
+func (a *NameAllocator) resolve(ctx context.Context, macAddress string, choose func(isReserved func(name string) bool) (string, error), persist bool) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	allocations, err := a.store.Load(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to load name allocations: %w", err)
+	}
+
+	mac := strings.ToLower(macAddress)
+	now := a.clock.Now()
+	isReserved := func(name string) bool {
+		return a.isReserved(allocations, name, mac, now)
+	}
+
+	if i := findAllocation(allocations, mac); i >= 0 {
+		allocation := &allocations[i]
+		if allocation.IsActive() {
+			return allocation.Name, nil
+		}
+		if !isReserved(allocation.Name) {
+			allocation.ReleasedAt = nil
+			allocation.AllocatedAt = now
+			return allocation.Name, a.saveIf(ctx, persist, allocations, now)
+		}
+		// 이름이 이미 다른 MAC에 넘어간 경우 기존 기록을 지우고 새로 할당
+		allocations = append(allocations[:i], allocations[i+1:]...)
+	}
+
+	name, err := choose(isReserved)
+	if err != nil {
+		return "", err
+	}
+
+	allocations = append(allocations, entities.NameAllocation{
+		MacAddress:  mac,
+		Name:        name,
+		AllocatedAt: now,
+	})
+	return name, a.saveIf(ctx, persist, allocations, now)
+}
+
+// Assign은 DB에서 지정한 이름을 MAC에 할당합니다
+// 다른 MAC에 할당되었거나 재사용 대기 중인 이름은 할당할 수 없으며, 기존 할당의 고정 여부는 유지합니다
+func (a *NameAllocator) Assign(ctx context.Context, macAddress, name string) error {
+	return a.assign(ctx, macAddress, name, true)
+}
+
+// CheckAssign은 Assign이 성공할지 확인하지만 할당을 저장하지 않습니다 (dry-run용)
+func (a *NameAllocator) CheckAssign(ctx context.Context, macAddress, name string) error {
+	return a.assign(ctx, macAddress, name, false)
+}
+
+// assign은 MAC에 이름을 할당하고, persist가 true이면 바뀐 할당을 저장합니다
+func (a *NameAllocator) assign(ctx context.Context, macAddress, name string, persist bool) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	allocations, err := a.store.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load name allocations: %w", err)
+	}
+
+	mac := strings.ToLower(macAddress)
+	now := a.clock.Now()
+	if a.isReserved(allocations, name, mac, now) {
+		return fmt.Errorf("name %s is allocated to another MAC address", name)
+	}
+
+	i := findAllocation(allocations, mac)
+	if i < 0 {
+		allocations = append(allocations, entities.NameAllocation{
+			MacAddress:  mac,
+			Name:        name,
+			AllocatedAt: now,
+		})
+		return a.saveIf(ctx, persist, allocations, now)
+	}
+
+	allocation := &allocations[i]
+	if allocation.IsActive() && allocation.Name == name {
+		// 매 주기마다 저장소를 다시 쓰지 않도록 변경이 없으면 저장하지 않음
+		return nil
+	}
+	allocation.Name = name
+	allocation.ReleasedAt = nil
+	allocation.AllocatedAt = now
+	return a.saveIf(ctx, persist, allocations, now)
+}
+
+// Lookup은 MAC에 현재 할당된 이름을 반환합니다
+func (a *NameAllocator) Lookup(ctx context.Context, macAddress string) (string, bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	allocations, err := a.store.Load(ctx)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to load name allocations: %w", err)
+	}
+
+	i := findAllocation(allocations, strings.ToLower(macAddress))
+	if i < 0 || !allocations[i].IsActive() {
+		return "", false, nil
+	}
+	return allocations[i].Name, true, nil
+}
+
+// Release는 인터페이스 삭제 후 MAC의 이름 할당을 해제합니다
+// 해제된 이름은 재사용 대기 시간 동안 다른 MAC에 할당되지 않으며, 고정된 할당은 해제하지 않습니다
+func (a *NameAllocator) Release(ctx context.Context, macAddress string) error {
+	return a.update(ctx, func(allocations []entities.NameAllocation, now time.Time) ([]entities.NameAllocation, error) {
+		i := findAllocation(allocations, strings.ToLower(macAddress))
+		if i < 0 || allocations[i].Pinned || !allocations[i].IsActive() {
+			return allocations, nil
+		}
+		allocations[i].ReleasedAt = &now
+		return allocations, nil
+	})
+}
+
+// Forget은 MAC의 할당 기록을 즉시 제거하여 재사용 대기 없이 이름을 반환합니다
+func (a *NameAllocator) Forget(ctx context.Context, macAddress string) error {
+	return a.update(ctx, func(allocations []entities.NameAllocation, now time.Time) ([]entities.NameAllocation, error) {
+		i := findAllocation(allocations, strings.ToLower(macAddress))
+		if i < 0 {
+			return allocations, fmt.Errorf("no name allocation for MAC %s", macAddress)
+		}
+		return append(allocations[:i], allocations[i+1:]...), nil
+	})
+}
+
+// Pin은 MAC에 이름을 고정 할당합니다
+// 다른 MAC에 할당되었거나 재사용 대기 중인 이름은 고정할 수 없습니다
+func (a *NameAllocator) Pin(ctx context.Context, macAddress, name string) error {
+	return a.update(ctx, func(allocations []entities.NameAllocation, now time.Time) ([]entities.NameAllocation, error) {
+		mac := strings.ToLower(macAddress)
+		if a.isReserved(allocations, name, mac, now) {
+			return allocations, fmt.Errorf("name %s is allocated to another MAC address", name)
+		}
+
+		if i := findAllocation(allocations, mac); i >= 0 {
+			allocations[i].Name = name
+			allocations[i].Pinned = true
+			allocations[i].ReleasedAt = nil
+			return allocations, nil
+		}
+
+		return append(allocations, entities.NameAllocation{
+			MacAddress:  mac,
+			Name:        name,
+			Pinned:      true,
+			AllocatedAt: now,
+		}), nil
+	})
+}
+
+// Unpin은 MAC의 고정 할당을 일반 할당으로 되돌립니다
+func (a *NameAllocator) Unpin(ctx context.Context, macAddress string) error {
+	return a.update(ctx, func(allocations []entities.NameAllocation, now time.Time) ([]entities.NameAllocation, error) {
+		i := findAllocation(allocations, strings.ToLower(macAddress))
+		if i < 0 {
+			return allocations, fmt.Errorf("no name allocation for MAC %s", macAddress)
+		}
+		allocations[i].Pinned = false
+		return allocations, nil
+	})
+}
+
+// update는 저장소의 할당 목록을 읽고 수정한 뒤 저장합니다
+func (a *NameAllocator) update(ctx context.Context, modify func([]entities.NameAllocation, time.Time) ([]entities.NameAllocation, error)) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	allocations, err := a.store.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load name allocations: %w", err)
+	}
+
+	now := a.clock.Now()
+	allocations, err = modify(allocations, now)
+	if err != nil {
+		return err
+	}
+
+	return a.save(ctx, allocations, now)
+}
+
+// save는 재사용 대기 시간이 지난 해제 기록을 정리한 뒤 저장합니다
+func (a *NameAllocator) save(ctx context.Context, allocations []entities.NameAllocation, now time.Time) error {
+	kept := allocations[:0]
+	for _, allocation := range allocations {
+		if !allocation.IsActive() && now.Sub(*allocation.ReleasedAt) >= a.reuseDelay {
+			continue
+		}
+		kept = append(kept, allocation)
+	}
+
+	if err := a.store.Save(ctx, kept); err != nil {
+		return fmt.Errorf("failed to save name allocations: %w", err)
+	}
+	return nil
+}
+
+// saveIf는 persist가 true일 때만 할당 목록을 저장합니다
+func (a *NameAllocator) saveIf(ctx context.Context, persist bool, allocations []entities.NameAllocation, now time.Time) error {
+	if !persist {
+		return nil
+	}
+	return a.save(ctx, allocations, now)
+}
+
+// isReserved는 이름이 다른 MAC에 할당되어 있거나 재사용 대기 중인지 확인합니다
+func (a *NameAllocator) isReserved(allocations []entities.NameAllocation, name, mac string, now time.Time) bool {
+	for _, allocation := range allocations {
+		if allocation.Name != name || allocation.MacAddress == mac {
+			continue
+		}
+		if allocation.IsActive() || now.Sub(*allocation.ReleasedAt) < a.reuseDelay {
+			return true
+		}
+	}
+	return false
+}
+
+// findAllocation은 MAC의 할당 위치를 반환합니다 (없으면 -1)
+func findAllocation(allocations []entities.NameAllocation, mac string) int {
+	for i, allocation := range allocations {
+		if allocation.MacAddress == mac {
+			return i
+		}
+	}
+	return -1
+}
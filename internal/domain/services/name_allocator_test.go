@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"multinic-agent/internal/domain/entities"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryAllocationStore는 테스트용 메모리 기반 이름 할당 저장소입니다
+type memoryAllocationStore struct {
+	allocations []entities.NameAllocation
+	saves       int
+}
+
+func (s *memoryAllocationStore) Load(ctx context.Context) ([]entities.NameAllocation, error) {
+	return append([]entities.NameAllocation(nil), s.allocations...), nil
+}
+
+func (s *memoryAllocationStore) Save(ctx context.Context, allocations []entities.NameAllocation) error {
+	s.allocations = append([]entities.NameAllocation(nil), allocations...)
+	s.saves++
+	return nil
+}
+
+// firstFree는 예약되지 않은 가장 작은 multinic 이름을 고릅니다
+func firstFree(isReserved func(name string) bool) (string, error) {
+	for i := 0; i < 10; i++ {
+		if name := fmt.Sprintf("multinic%d", i); !isReserved(name) {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("no free name")
+}
+
+func TestNameAllocator_StickyAcrossRelease(t *testing.T) {
+	ctx := context.Background()
+	clock := &mutableClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := &memoryAllocationStore{}
+	allocator := NewNameAllocator(store, clock, time.Hour)
+
+	name, err := allocator.Resolve(ctx, "FA:16:3E:00:00:01", firstFree)
+	require.NoError(t, err)
+	assert.Equal(t, "multinic0", name)
+
+	name, err = allocator.Resolve(ctx, "fa:16:3e:00:00:02", firstFree)
+	require.NoError(t, err)
+	assert.Equal(t, "multinic1", name)
+
+	// 재시작 후에도 저장된 이름을 그대로 사용
+	name, err = NewNameAllocator(store, clock, time.Hour).Resolve(ctx, "fa:16:3e:00:00:01", firstFree)
+	require.NoError(t, err)
+	assert.Equal(t, "multinic0", name)
+
+	// 해제된 이름은 재사용 대기 시간 동안 다른 MAC에 할당되지 않음
+	require.NoError(t, allocator.Release(ctx, "fa:16:3e:00:00:01"))
+	name, err = allocator.Resolve(ctx, "fa:16:3e:00:00:03", firstFree)
+	require.NoError(t, err)
+	assert.Equal(t, "multinic2", name)
+
+	// 같은 MAC이 다시 나타나면 원래 이름을 되돌려 받음
+	name, err = allocator.Resolve(ctx, "fa:16:3e:00:00:01", firstFree)
+	require.NoError(t, err)
+	assert.Equal(t, "multinic0", name)
+
+	// 재사용 대기 시간이 지나면 다른 MAC에 할당 가능
+	require.NoError(t, allocator.Release(ctx, "fa:16:3e:00:00:01"))
+	clock.now = clock.now.Add(2 * time.Hour)
+	name, err = allocator.Resolve(ctx, "fa:16:3e:00:00:04", firstFree)
+	require.NoError(t, err)
+	assert.Equal(t, "multinic0", name)
+
+	allocations, err := allocator.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, allocations, 3, "재사용 대기 시간이 지난 해제 기록은 정리됨")
+}
+
+func TestNameAllocator_Pin(t *testing.T) {
+	ctx := context.Background()
+	clock := &mutableClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	allocator := NewNameAllocator(&memoryAllocationStore{}, clock, time.Hour)
+
+	_, err := allocator.Resolve(ctx, "fa:16:3e:00:00:01", firstFree)
+	require.NoError(t, err)
+
+	// 다른 MAC에 할당된 이름은 고정할 수 없음
+	assert.Error(t, allocator.Pin(ctx, "fa:16:3e:00:00:02", "multinic0"))
+
+	require.NoError(t, allocator.Pin(ctx, "fa:16:3e:00:00:02", "multinic5"))
+	name, err := allocator.Resolve(ctx, "fa:16:3e:00:00:02", firstFree)
+	require.NoError(t, err)
+	assert.Equal(t, "multinic5", name)
+
+	// 고정된 할당은 인터페이스 삭제로 해제되지 않음
+	require.NoError(t, allocator.Release(ctx, "fa:16:3e:00:00:02"))
+	allocations, err := allocator.List(ctx)
+	require.NoError(t, err)
+	for _, allocation := range allocations {
+		if allocation.MacAddress == "fa:16:3e:00:00:02" {
+			assert.True(t, allocation.IsActive())
+			assert.True(t, allocation.Pinned)
+		}
+	}
+
+	// 할당 제거 후에는 즉시 재사용 가능
+	require.NoError(t, allocator.Forget(ctx, "fa:16:3e:00:00:01"))
+	name, err = allocator.Resolve(ctx, "fa:16:3e:00:00:03", firstFree)
+	require.NoError(t, err)
+	assert.Equal(t, "multinic0", name)
+}
+
+func TestInterfaceNamingService_GenerateNameForInterface_WithAllocator(t *testing.T) {
+	clock := &mutableClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := &memoryAllocationStore{allocations: []entities.NameAllocation{
+		{MacAddress: "fa:16:3e:00:00:02", Name: "multinic2", AllocatedAt: clock.now},
+		{MacAddress: "fa:16:3e:00:00:09", Name: "multinic0", AllocatedAt: clock.now},
+	}}
+
+	mockFS := new(MockFileSystem)
+	for i := 0; i < 10; i++ {
+		mockFS.On("Exists", fmt.Sprintf("/sys/class/net/multinic%d", i)).Return(false).Maybe()
+	}
+	mockExecutor := new(MockCommandExecutor)
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").Return([]byte{}, fmt.Errorf("not in container")).Maybe()
+
+	service := NewInterfaceNamingService(mockFS, mockExecutor, entities.DefaultNamingScheme(), NewNameAllocator(store, clock, time.Hour))
+
+	// 재부팅 후 /sys/class/net이 비어 있어도 저장된 이름을 유지
+	name, err := service.GenerateNameForInterface(entities.NetworkInterface{MacAddress: "fa:16:3e:00:00:02"})
+	require.NoError(t, err)
+	assert.Equal(t, "multinic2", name.String())
+
+	// 새 MAC은 다른 MAC에 할당된 multinic0을 건너뜀
+	name, err = service.GenerateNameForInterface(entities.NetworkInterface{MacAddress: "fa:16:3e:00:00:03"})
+	require.NoError(t, err)
+	assert.Equal(t, "multinic1", name.String())
+	assert.Len(t, store.allocations, 3)
+}
+
+func TestNameAllocator_Assign(t *testing.T) {
+	ctx := context.Background()
+	clock := &mutableClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := &memoryAllocationStore{}
+	allocator := NewNameAllocator(store, clock, time.Hour)
+
+	_, err := allocator.Resolve(ctx, "fa:16:3e:00:00:01", firstFree)
+	require.NoError(t, err)
+
+	// DB 지정 이름은 기존 할당을 대체
+	require.NoError(t, allocator.Assign(ctx, "fa:16:3e:00:00:01", "storage0"))
+	name, ok, err := allocator.Lookup(ctx, "fa:16:3e:00:00:01")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "storage0", name)
+
+	// 변경이 없으면 저장소를 다시 쓰지 않음
+	saves := store.saves
+	require.NoError(t, allocator.Assign(ctx, "FA:16:3E:00:00:01", "storage0"))
+	assert.Equal(t, saves, store.saves)
+
+	// 다른 MAC에 할당된 이름은 지정할 수 없음
+	assert.Error(t, allocator.Assign(ctx, "fa:16:3e:00:00:02", "storage0"))
+}
+
+func TestNameAllocator_PreviewDoesNotSave(t *testing.T) {
+	ctx := context.Background()
+	clock := &mutableClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := &memoryAllocationStore{}
+	allocator := NewNameAllocator(store, clock, time.Hour)
+
+	name, err := allocator.Preview(ctx, "fa:16:3e:00:00:01", firstFree)
+	require.NoError(t, err)
+	assert.Equal(t, "multinic0", name)
+	require.NoError(t, allocator.CheckAssign(ctx, "fa:16:3e:00:00:02", "storage0"))
+	assert.Equal(t, 0, store.saves)
+	assert.Empty(t, store.allocations)
+
+	// 다른 MAC에 할당된 이름은 미리보기에서도 거부
+	require.NoError(t, allocator.Assign(ctx, "fa:16:3e:00:00:02", "storage0"))
+	assert.Error(t, allocator.CheckAssign(ctx, "fa:16:3e:00:00:03", "storage0"))
+	assert.Equal(t, 1, store.saves)
+}
@@ -0,0 +1,246 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"multinic-agent/internal/domain/constants"
+	"multinic-agent/internal/domain/errors"
+	"multinic-agent/internal/domain/interfaces"
+	"strings"
+	"sync"
+)
+
+// 노드 식별자 출처
+const (
+	NodeIdentitySourceNodeName     = "node-name"     // Downward API로 주입된 NODE_NAME (spec.nodeName)
+	NodeIdentitySourceHostname     = "hostname"      // 도메인 접미사를 제거한 호스트네임
+	NodeIdentitySourceInstanceUUID = "instance-uuid" // OpenStack 인스턴스 UUID (DMI product_uuid)
+	NodeIdentitySourceMapping      = "mapping"       // 호스트네임 또는 인스턴스 UUID에서 노드 이름으로의 매핑
+)
+
+// DefaultNodeIdentitySources는 기본 출처 순서를 반환합니다 (NODE_NAME이 없으면 호스트네임)
+func DefaultNodeIdentitySources() []string {
+	return []string{NodeIdentitySourceNodeName, NodeIdentitySourceHostname}
+}
+
+// IsNodeIdentitySource는 지원하는 노드 식별자 출처인지 확인합니다
+func IsNodeIdentitySource(source string) bool {
+	switch source {
+	case NodeIdentitySourceNodeName, NodeIdentitySourceHostname, NodeIdentitySourceInstanceUUID, NodeIdentitySourceMapping:
+		return true
+	}
+	return false
+}
+
+// ParseNodeIdentityMapping은 "키=노드 이름" 형식의 매핑 항목을 파싱합니다
+// 키는 전체/짧은 호스트네임 또는 인스턴스 UUID이며 대소문자를 구분하지 않습니다
+func ParseNodeIdentityMapping(entries []string) (map[string]string, error) {
+	mapping := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		key, name, found := strings.Cut(entry, "=")
+		key, name = strings.ToLower(strings.TrimSpace(key)), strings.TrimSpace(name)
+		if !found || key == "" || name == "" {
+			return nil, errors.NewValidationError(fmt.Sprintf("invalid node identity mapping %q (expected key=node-name)", entry), nil)
+		}
+		if _, exists := mapping[key]; exists {
+			return nil, errors.NewValidationError(fmt.Sprintf("duplicate node identity mapping for %q", key), nil)
+		}
+		mapping[key] = name
+	}
+	return mapping, nil
+}
+
+// NodeIdentity는 확인된 노드 이름과 그 출처입니다
+type NodeIdentity struct {
+	Name   string
+	Source string
+}
+
+// NodeIdentityResolver는 설정된 출처 순서대로 노드 이름을 확인하는 도메인 서비스입니다
+// 저장소에 행이 있는 후보로 확정된 식별자는 프로세스가 끝날 때까지 모든 유스케이스가 같은 값을 사용하며,
+// 행이 있는 후보가 없으면 첫 후보를 임시로 사용하고 Resolve를 다시 호출할 때마다 후보를 다시 확인합니다
+type NodeIdentityResolver struct {
+	sources    []string
+	nodeName   string            // NODE_NAME 값 (미설정 시 빈 값)
+	mapping    map[string]string // 소문자 키 → 노드 이름
+	fileSystem interfaces.FileSystem
+	hostname   func() (string, error)
+
+	mu       sync.Mutex
+	resolved *NodeIdentity
+	matched  bool // resolved가 저장소에 행이 있는 후보로 확정되었는지 여부 (false면 임시 식별자)
+}
+
+// NewNodeIdentityResolver는 새로운 NodeIdentityResolver를 생성합니다
+// sources가 비어 있으면 기본 출처 순서를 사용합니다
+func NewNodeIdentityResolver(sources []string, nodeName string, mapping map[string]string, fs interfaces.FileSystem, hostname func() (string, error)) *NodeIdentityResolver {
+	if len(sources) == 0 {
+		sources = DefaultNodeIdentitySources()
+	}
+	return &NodeIdentityResolver{
+		sources:    sources,
+		nodeName:   nodeName,
+		mapping:    mapping,
+		fileSystem: fs,
+		hostname:   hostname,
+	}
+}
+
+// Candidates는 설정된 순서대로 각 출처의 노드 이름을 조회합니다
+// 값을 얻을 수 없는 출처와 앞선 출처와 같은 이름은 제외하며, 후보가 하나도 없으면 에러를 반환합니다
+func (r *NodeIdentityResolver) Candidates() ([]NodeIdentity, error) {
+	var (
+		candidates []NodeIdentity
+		failures   []string
+		seen       = make(map[string]bool)
+	)
+	for _, source := range r.sources {
+		name, err := r.lookup(source)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", source, err))
+			continue
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		candidates = append(candidates, NodeIdentity{Name: name, Source: source})
+	}
+
+	if len(candidates) == 0 {
+		return nil, errors.NewValidationError(fmt.Sprintf("no node identity source produced a node name (%s)", strings.Join(failures, "; ")), nil)
+	}
+	return candidates, nil
+}
+
+// Resolve는 저장소에 인터페이스 행이 있는 첫 후보를 노드 식별자로 확정합니다
+// 어느 후보에도 행이 없으면(아직 인터페이스가 할당되지 않은 노드) 첫 후보를 임시로 사용하며, 이때 matched는 false입니다
+// 임시 식별자는 확정하지 않으므로 다음 호출에서 후보를 다시 확인하고, 확정된 뒤에는 저장소를 조회하지 않습니다
+func (r *NodeIdentityResolver) Resolve(ctx context.Context, repository interfaces.NetworkInterfaceRepository) (identity NodeIdentity, matched bool, err error) {
+	r.mu.Lock()
+	if r.matched {
+		identity = *r.resolved
+		r.mu.Unlock()
+		return identity, true, nil
+	}
+	r.mu.Unlock()
+
+	candidates, err := r.Candidates()
+	if err != nil {
+		return NodeIdentity{}, false, err
+	}
+
+	identity = candidates[0]
+	for _, candidate := range candidates {
+		rows, err := repository.GetAllNodeInterfaces(ctx, candidate.Name)
+		if err != nil {
+			return NodeIdentity{}, false, err
+		}
+		if len(rows) > 0 {
+			identity, matched = candidate, true
+			break
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.resolved = &identity
+	r.matched = matched
+	return identity, matched, nil
+}
+
+// Identity는 확정된 노드 식별자를 반환합니다 (Resolve에서 행이 있는 후보를 찾지 못했으면 임시 식별자)
+// Resolve 전에 호출되면 저장소 확인 없이 첫 후보를 사용합니다 (DB 없이 동작하는 소스와 사전 점검용)
+func (r *NodeIdentityResolver) Identity() (NodeIdentity, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.resolved != nil {
+		return *r.resolved, nil
+	}
+
+	candidates, err := r.Candidates()
+	if err != nil {
+		return NodeIdentity{}, err
+	}
+	r.resolved = &candidates[0]
+	return *r.resolved, nil
+}
+
+// NodeName은 확정된 노드 이름을 반환합니다
+func (r *NodeIdentityResolver) NodeName() (string, error) {
+	identity, err := r.Identity()
+	if err != nil {
+		return "", err
+	}
+	return identity.Name, nil
+}
+
+// lookup은 출처 하나에서 노드 이름을 조회합니다
+func (r *NodeIdentityResolver) lookup(source string) (string, error) {
+	switch source {
+	case NodeIdentitySourceNodeName:
+		if r.nodeName == "" {
+			return "", fmt.Errorf("NODE_NAME is not set")
+		}
+		return r.nodeName, nil
+	case NodeIdentitySourceHostname:
+		_, short, err := r.hostnames()
+		return short, err
+	case NodeIdentitySourceInstanceUUID:
+		return r.instanceUUID()
+	case NodeIdentitySourceMapping:
+		return r.lookupMapping()
+	default:
+		return "", fmt.Errorf("unknown node identity source")
+	}
+}
+
+// lookupMapping은 전체 호스트네임, 짧은 호스트네임, 인스턴스 UUID 순서로 매핑을 찾습니다
+func (r *NodeIdentityResolver) lookupMapping() (string, error) {
+	var keys []string
+	if full, short, err := r.hostnames(); err == nil {
+		keys = append(keys, full, short)
+	}
+	if uuid, err := r.instanceUUID(); err == nil {
+		keys = append(keys, uuid)
+	}
+
+	for _, key := range keys {
+		if name, ok := r.mapping[strings.ToLower(key)]; ok {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("no mapping for %s", strings.Join(keys, ", "))
+}
+
+// hostnames는 전체 호스트네임과 도메인 접미사(.novalocal 등)를 제거한 호스트네임을 반환합니다
+func (r *NodeIdentityResolver) hostnames() (full, short string, err error) {
+	full, err = r.hostname()
+	if err != nil {
+		return "", "", err
+	}
+	full = strings.TrimSpace(full)
+	if full == "" {
+		return "", "", fmt.Errorf("hostname is empty")
+	}
+
+	short = full
+	if idx := strings.Index(short, "."); idx != -1 {
+		short = short[:idx]
+	}
+	return full, short, nil
+}
+
+// instanceUUID는 Nova가 인스턴스 UUID로 설정하는 시스템 UUID를 읽습니다
+func (r *NodeIdentityResolver) instanceUUID() (string, error) {
+	content, err := r.fileSystem.ReadFile(constants.DMIProductUUIDFile)
+	if err != nil {
+		return "", err
+	}
+	uuid := strings.ToLower(strings.TrimSpace(string(content)))
+	if uuid == "" {
+		return "", fmt.Errorf("%s is empty", constants.DMIProductUUIDFile)
+	}
+	return uuid, nil
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"multinic-agent/internal/domain/constants"
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/errors"
+	"multinic-agent/internal/domain/interfaces"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNodeRepository는 노드 이름별 인터페이스 행만 반환하는 저장소입니다
+type fakeNodeRepository struct {
+	interfaces.NetworkInterfaceRepository
+	rows    map[string][]entities.NetworkInterface
+	err     error
+	queried []string
+}
+
+func (r *fakeNodeRepository) GetAllNodeInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error) {
+	r.queried = append(r.queried, nodeName)
+	return r.rows[nodeName], r.err
+}
+
+func staticHostname(name string) func() (string, error) {
+	return func() (string, error) { return name, nil }
+}
+
+func TestNodeIdentityResolver_Resolve(t *testing.T) {
+	row := []entities.NetworkInterface{{ID: 1, MacAddress: "fa:16:3e:00:00:01"}}
+
+	tests := []struct {
+		name        string
+		sources     []string
+		nodeName    string
+		rows        map[string][]entities.NetworkInterface
+		wantName    string
+		wantSource  string
+		wantMatched bool
+	}{
+		{
+			name:        "NODE_NAME에 행이 있으면 NODE_NAME 사용",
+			sources:     []string{NodeIdentitySourceNodeName, NodeIdentitySourceHostname},
+			nodeName:    "worker-1.cluster.local",
+			rows:        map[string][]entities.NetworkInterface{"worker-1.cluster.local": row},
+			wantName:    "worker-1.cluster.local",
+			wantSource:  NodeIdentitySourceNodeName,
+			wantMatched: true,
+		},
+		{
+			name:        "NODE_NAME에 행이 없으면 행이 있는 호스트네임으로 대체",
+			sources:     []string{NodeIdentitySourceNodeName, NodeIdentitySourceHostname},
+			nodeName:    "worker-1.cluster.local",
+			rows:        map[string][]entities.NetworkInterface{"worker-1": row},
+			wantName:    "worker-1",
+			wantSource:  NodeIdentitySourceHostname,
+			wantMatched: true,
+		},
+		{
+			name:       "어느 후보에도 행이 없으면 첫 후보 사용",
+			sources:    []string{NodeIdentitySourceNodeName, NodeIdentitySourceHostname},
+			nodeName:   "worker-1.cluster.local",
+			wantName:   "worker-1.cluster.local",
+			wantSource: NodeIdentitySourceNodeName,
+		},
+		{
+			name:        "NODE_NAME 미설정 시 다음 출처 사용",
+			sources:     []string{NodeIdentitySourceNodeName, NodeIdentitySourceHostname},
+			rows:        map[string][]entities.NetworkInterface{"worker-1": row},
+			wantName:    "worker-1",
+			wantSource:  NodeIdentitySourceHostname,
+			wantMatched: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := NewNodeIdentityResolver(tt.sources, tt.nodeName, nil, new(MockFileSystem), staticHostname("worker-1.novalocal"))
+			identity, matched, err := resolver.Resolve(context.Background(), &fakeNodeRepository{rows: tt.rows})
+
+			require.NoError(t, err)
+			assert.Equal(t, NodeIdentity{Name: tt.wantName, Source: tt.wantSource}, identity)
+			assert.Equal(t, tt.wantMatched, matched)
+
+			// 확정된 식별자는 이후 조회에서 그대로 사용
+			name, err := resolver.NodeName()
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, name)
+		})
+	}
+}
+
+func TestNodeIdentityResolver_Resolve_RowsAppearLater(t *testing.T) {
+	repository := &fakeNodeRepository{rows: map[string][]entities.NetworkInterface{}}
+	resolver := NewNodeIdentityResolver([]string{NodeIdentitySourceNodeName, NodeIdentitySourceHostname}, "worker-1.cluster.local", nil, new(MockFileSystem), staticHostname("worker-1.novalocal"))
+	ctx := context.Background()
+
+	// 시작 시 어느 후보에도 행이 없으면 첫 후보를 임시로 사용
+	identity, matched, err := resolver.Resolve(ctx, repository)
+	require.NoError(t, err)
+	assert.False(t, matched)
+	assert.Equal(t, "worker-1.cluster.local", identity.Name)
+
+	// 이후 다른 후보에 행이 생기면 다시 확인하여 그 후보로 확정
+	repository.rows["worker-1"] = []entities.NetworkInterface{{ID: 1, MacAddress: "fa:16:3e:00:00:01"}}
+	identity, matched, err = resolver.Resolve(ctx, repository)
+	require.NoError(t, err)
+	assert.True(t, matched)
+	assert.Equal(t, NodeIdentity{Name: "worker-1", Source: NodeIdentitySourceHostname}, identity)
+
+	name, err := resolver.NodeName()
+	require.NoError(t, err)
+	assert.Equal(t, "worker-1", name)
+
+	// 확정된 뒤에는 저장소를 다시 조회하지 않음
+	queried := len(repository.queried)
+	identity, matched, err = resolver.Resolve(ctx, repository)
+	require.NoError(t, err)
+	assert.True(t, matched)
+	assert.Equal(t, "worker-1", identity.Name)
+	assert.Len(t, repository.queried, queried)
+}
+
+func TestNodeIdentityResolver_InstanceUUIDAndMapping(t *testing.T) {
+	fs := new(MockFileSystem)
+	fs.On("ReadFile", constants.DMIProductUUIDFile).Return([]byte("6A1F3C2E-0000-4B5E-9C1D-2F3A4B5C6D7E\n"), nil)
+
+	resolver := NewNodeIdentityResolver([]string{NodeIdentitySourceInstanceUUID}, "", nil, fs, staticHostname("vm-1"))
+	name, err := resolver.NodeName()
+	require.NoError(t, err)
+	assert.Equal(t, "6a1f3c2e-0000-4b5e-9c1d-2f3a4b5c6d7e", name)
+
+	// 매핑은 전체/짧은 호스트네임과 인스턴스 UUID로 찾음
+	mapping, err := ParseNodeIdentityMapping([]string{"6a1f3c2e-0000-4b5e-9c1d-2f3a4b5c6d7e=compute-07", "other=compute-08"})
+	require.NoError(t, err)
+	resolver = NewNodeIdentityResolver([]string{NodeIdentitySourceMapping}, "", mapping, fs, staticHostname("vm-1.novalocal"))
+	name, err = resolver.NodeName()
+	require.NoError(t, err)
+	assert.Equal(t, "compute-07", name)
+
+	mapping, err = ParseNodeIdentityMapping([]string{"VM-1=compute-01"})
+	require.NoError(t, err)
+	resolver = NewNodeIdentityResolver([]string{NodeIdentitySourceMapping}, "", mapping, fs, staticHostname("vm-1.novalocal"))
+	name, err = resolver.NodeName()
+	require.NoError(t, err)
+	assert.Equal(t, "compute-01", name)
+}
+
+func TestNodeIdentityResolver_Errors(t *testing.T) {
+	fs := new(MockFileSystem)
+	fs.On("ReadFile", constants.DMIProductUUIDFile).Return([]byte(nil), fmt.Errorf("no such file"))
+	failingHostname := func() (string, error) { return "", fmt.Errorf("uname failed") }
+
+	// 값을 얻을 수 있는 출처가 없으면 에러
+	resolver := NewNodeIdentityResolver([]string{NodeIdentitySourceNodeName, NodeIdentitySourceInstanceUUID, NodeIdentitySourceMapping}, "", nil, fs, failingHostname)
+	_, err := resolver.NodeName()
+	assert.True(t, errors.IsValidationError(err))
+
+	// 저장소 조회 실패는 그대로 반환하고 식별자를 확정하지 않음
+	resolver = NewNodeIdentityResolver([]string{NodeIdentitySourceHostname}, "", nil, fs, staticHostname("worker-1"))
+	_, _, err = resolver.Resolve(context.Background(), &fakeNodeRepository{err: fmt.Errorf("connection refused")})
+	assert.Error(t, err)
+
+	for _, entries := range [][]string{{"worker-1"}, {"=node"}, {"worker-1="}, {"a=x", "A=y"}} {
+		_, err := ParseNodeIdentityMapping(entries)
+		assert.True(t, errors.IsValidationError(err), entries)
+	}
+}
@@ -0,0 +1,189 @@
+package services
+
+import (
+	"fmt"
+	"multinic-agent/internal/domain/interfaces"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 고아 삭제 차단 사유
+const (
+	BlockReasonMaxDeletions = "max_deletions"
+	BlockReasonMaxPercent   = "max_percent"
+)
+
+// minPercentRuleCandidates는 비율 규칙을 적용하는 최소 후보 수입니다
+// 인터페이스가 한두 개인 노드에서는 포트 하나만 삭제되어도 비율이 50~100%가 되어 삭제가 영원히 차단되므로,
+// 후보가 하나뿐이면 비율 규칙을 적용하지 않습니다 (한 번에 하나씩은 확인 사이클/유예 시간으로 보호)
+const minPercentRuleCandidates = 2
+
+// DeletionSafetyPolicy는 고아 인터페이스 대량 삭제를 막기 위한 안전 규칙입니다
+// 0 값은 해당 규칙을 비활성화합니다
+type DeletionSafetyPolicy struct {
+	MaxDeletionsPerCycle int           // 한 사이클에 삭제할 수 있는 최대 인터페이스 수
+	MaxDeletionPercent   float64       // 관리 중인 인터페이스 대비 한 사이클 최대 삭제 비율 (%)
+	ConfirmCycles        int           // 삭제 전 MAC이 연속으로 누락되어야 하는 사이클 수
+	GracePeriod          time.Duration // 삭제 전 MAC이 누락된 상태로 유지되어야 하는 시간
+}
+
+// DeletionDecision은 고아 삭제 후보에 대한 안전 규칙 평가 결과입니다
+type DeletionDecision struct {
+	Approved    []string // 삭제가 허용된 MAC 주소
+	Deferred    []string // 확인 사이클/유예 시간을 기다리는 MAC 주소
+	Blocked     []string // 임계값 초과로 차단된 MAC 주소
+	BlockReason string
+}
+
+// IsBlocked는 이번 사이클의 삭제가 차단되었는지 확인합니다
+func (d DeletionDecision) IsBlocked() bool {
+	return len(d.Blocked) > 0
+}
+
+// orphanObservation은 DB에서 누락된 MAC의 관찰 기록입니다
+type orphanObservation struct {
+	firstSeen time.Time
+	cycles    int
+}
+
+// OrphanDeletionGuard는 사이클 간 고아 관찰 상태를 유지하며 삭제 허용 여부를 결정하는 도메인 서비스입니다
+type OrphanDeletionGuard struct {
+	mu           sync.Mutex
+	policy       DeletionSafetyPolicy
+	clock        interfaces.Clock
+	observations map[string]*orphanObservation
+}
+
+// NewOrphanDeletionGuard는 새로운 OrphanDeletionGuard를 생성합니다
+func NewOrphanDeletionGuard(policy DeletionSafetyPolicy, clock interfaces.Clock) *OrphanDeletionGuard {
+	return &OrphanDeletionGuard{
+		policy:       policy,
+		clock:        clock,
+		observations: make(map[string]*orphanObservation),
+	}
+}
+
+// Evaluate는 이번 사이클에 감지된 고아 MAC 목록과 관리 중인 인터페이스 수로 삭제 허용 여부를 평가합니다
+// 후보에서 빠진 MAC(다시 DB에 나타난 MAC)의 관찰 기록은 초기화됩니다
+func (g *OrphanDeletionGuard) Evaluate(candidateMACs []string, managedCount int) DeletionDecision {
+	return g.evaluate(candidateMACs, managedCount, true)
+}
+
+// Preview는 관찰 기록을 갱신하지 않고 이번 사이클의 Evaluate 결과를 미리 계산합니다 (dry-run용)
+func (g *OrphanDeletionGuard) Preview(candidateMACs []string, managedCount int) DeletionDecision {
+	return g.evaluate(candidateMACs, managedCount, false)
+}
+
+// evaluate는 이번 사이클의 관찰을 반영하여 삭제 허용 여부를 평가하고, record가 true이면 관찰 기록을 저장합니다
+func (g *OrphanDeletionGuard) evaluate(candidateMACs []string, managedCount int, record bool) DeletionDecision {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.clock.Now()
+	decision := DeletionDecision{}
+
+	observed := make(map[string]*orphanObservation, len(candidateMACs))
+	for _, mac := range candidateMACs {
+		key := strings.ToLower(mac)
+		obs, ok := observed[key]
+		if !ok {
+			obs = &orphanObservation{firstSeen: now}
+			if existing, found := g.observations[key]; found {
+				*obs = *existing
+			}
+			observed[key] = obs
+		}
+		obs.cycles++
+	}
+	if record {
+		g.observations = observed
+	}
+
+	if len(candidateMACs) == 0 {
+		return decision
+	}
+
+	// 대량 삭제 임계값은 전체 후보 기준으로 평가 (DB 장애 시 모든 파일이 후보가 됨)
+	if reason := g.exceedsThreshold(len(candidateMACs), managedCount); reason != "" {
+		decision.Blocked = append(decision.Blocked, candidateMACs...)
+		decision.BlockReason = reason
+		return decision
+	}
+
+	for _, mac := range candidateMACs {
+		if g.isConfirmed(observed[strings.ToLower(mac)], now) {
+			decision.Approved = append(decision.Approved, mac)
+		} else {
+			decision.Deferred = append(decision.Deferred, mac)
+		}
+	}
+
+	return decision
+}
+
+// EvaluatePurge는 보존 기간이 지난 격리 항목의 영구 삭제 후보를 한 사이클 최대 삭제 수로 평가합니다
+// 격리 보존 기간이 확인 사이클/유예 시간 역할을 하므로 관찰 기록은 사용하지 않으며,
+// DB 장애로 격리 항목의 MAC이 한꺼번에 돌아오지 않는 경우에도 대량 삭제되지 않도록 임계값을 넘으면 전체를 차단합니다
+func (g *OrphanDeletionGuard) EvaluatePurge(candidateMACs []string) DeletionDecision {
+	decision := DeletionDecision{}
+	if len(candidateMACs) == 0 {
+		return decision
+	}
+
+	if g.policy.MaxDeletionsPerCycle > 0 && len(candidateMACs) > g.policy.MaxDeletionsPerCycle {
+		decision.Blocked = append(decision.Blocked, candidateMACs...)
+		decision.BlockReason = BlockReasonMaxDeletions
+		return decision
+	}
+
+	decision.Approved = append(decision.Approved, candidateMACs...)
+	return decision
+}
+
+// Forget은 삭제가 완료된 MAC의 관찰 기록을 제거합니다
+func (g *OrphanDeletionGuard) Forget(mac string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	delete(g.observations, strings.ToLower(mac))
+}
+
+// exceedsThreshold는 후보 수가 대량 삭제 임계값을 넘는지 확인하고 차단 사유를 반환합니다
+func (g *OrphanDeletionGuard) exceedsThreshold(candidates, managedCount int) string {
+	if g.policy.MaxDeletionsPerCycle > 0 && candidates > g.policy.MaxDeletionsPerCycle {
+		return BlockReasonMaxDeletions
+	}
+
+	if g.policy.MaxDeletionPercent > 0 && managedCount > 0 && candidates >= minPercentRuleCandidates {
+		percent := float64(candidates) / float64(managedCount) * 100
+		if percent > g.policy.MaxDeletionPercent {
+			return BlockReasonMaxPercent
+		}
+	}
+
+	return ""
+}
+
+// isConfirmed는 확인 사이클 수와 유예 시간을 모두 충족했는지 확인합니다
+func (g *OrphanDeletionGuard) isConfirmed(obs *orphanObservation, now time.Time) bool {
+	if g.policy.ConfirmCycles > 1 && obs.cycles < g.policy.ConfirmCycles {
+		return false
+	}
+	if g.policy.GracePeriod > 0 && now.Sub(obs.firstSeen) < g.policy.GracePeriod {
+		return false
+	}
+	return true
+}
+
+// DescribeBlock은 차단 사유를 사람이 읽을 수 있는 문자열로 변환합니다
+func (g *OrphanDeletionGuard) DescribeBlock(decision DeletionDecision, managedCount int) string {
+	switch decision.BlockReason {
+	case BlockReasonMaxDeletions:
+		return fmt.Sprintf("%d orphan candidates exceed limit of %d per cycle", len(decision.Blocked), g.policy.MaxDeletionsPerCycle)
+	case BlockReasonMaxPercent:
+		return fmt.Sprintf("%d of %d managed interfaces exceed limit of %.0f%% per cycle", len(decision.Blocked), managedCount, g.policy.MaxDeletionPercent)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,139 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mutableClock은 테스트 중 시간을 진행시킬 수 있는 Clock입니다
+type mutableClock struct {
+	now time.Time
+}
+
+func (c *mutableClock) Now() time.Time {
+	return c.now
+}
+
+func TestOrphanDeletionGuard_Thresholds(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       DeletionSafetyPolicy
+		candidates   []string
+		managedCount int
+		wantReason   string
+		wantApproved int
+	}{
+		{
+			name:         "규칙 없음 - 모두 허용",
+			policy:       DeletionSafetyPolicy{},
+			candidates:   []string{"fa:16:3e:00:00:01", "fa:16:3e:00:00:02"},
+			managedCount: 2,
+			wantApproved: 2,
+		},
+		{
+			name:         "최대 삭제 수 초과 - 전체 차단",
+			policy:       DeletionSafetyPolicy{MaxDeletionsPerCycle: 1},
+			candidates:   []string{"fa:16:3e:00:00:01", "fa:16:3e:00:00:02"},
+			managedCount: 4,
+			wantReason:   BlockReasonMaxDeletions,
+		},
+		{
+			name:         "최대 삭제 비율 초과 - 전체 차단",
+			policy:       DeletionSafetyPolicy{MaxDeletionPercent: 50},
+			candidates:   []string{"fa:16:3e:00:00:01", "fa:16:3e:00:00:02", "fa:16:3e:00:00:03"},
+			managedCount: 4,
+			wantReason:   BlockReasonMaxPercent,
+		},
+		{
+			name:         "인터페이스가 하나인 노드의 삭제는 비율 규칙에서 제외",
+			policy:       DeletionSafetyPolicy{MaxDeletionPercent: 50},
+			candidates:   []string{"fa:16:3e:00:00:01"},
+			managedCount: 1,
+			wantApproved: 1,
+		},
+		{
+			name:         "인터페이스가 둘인 노드의 한 개 삭제도 비율 규칙에서 제외",
+			policy:       DeletionSafetyPolicy{MaxDeletionPercent: 30},
+			candidates:   []string{"fa:16:3e:00:00:01"},
+			managedCount: 2,
+			wantApproved: 1,
+		},
+		{
+			name:         "최대 삭제 비율 이하 - 허용",
+			policy:       DeletionSafetyPolicy{MaxDeletionPercent: 50},
+			candidates:   []string{"fa:16:3e:00:00:01", "fa:16:3e:00:00:02"},
+			managedCount: 4,
+			wantApproved: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			guard := NewOrphanDeletionGuard(tt.policy, &mutableClock{now: time.Now()})
+
+			decision := guard.Evaluate(tt.candidates, tt.managedCount)
+
+			assert.Equal(t, tt.wantReason, decision.BlockReason)
+			assert.Len(t, decision.Approved, tt.wantApproved)
+			if tt.wantReason != "" {
+				assert.True(t, decision.IsBlocked())
+				assert.ElementsMatch(t, tt.candidates, decision.Blocked)
+			}
+		})
+	}
+}
+
+func TestOrphanDeletionGuard_ConfirmCycles(t *testing.T) {
+	clock := &mutableClock{now: time.Now()}
+	guard := NewOrphanDeletionGuard(DeletionSafetyPolicy{ConfirmCycles: 3}, clock)
+	mac := "fa:16:3e:00:00:01"
+
+	// 1, 2번째 사이클은 보류
+	assert.Equal(t, []string{mac}, guard.Evaluate([]string{mac}, 2).Deferred)
+	assert.Equal(t, []string{mac}, guard.Evaluate([]string{mac}, 2).Deferred)
+
+	// 3번째 연속 사이클에서 허용
+	assert.Equal(t, []string{mac}, guard.Evaluate([]string{mac}, 2).Approved)
+
+	// MAC이 다시 나타나면 카운터 초기화
+	guard.Evaluate([]string{}, 2)
+	assert.Equal(t, []string{mac}, guard.Evaluate([]string{mac}, 2).Deferred)
+}
+
+func TestOrphanDeletionGuard_GracePeriod(t *testing.T) {
+	clock := &mutableClock{now: time.Now()}
+	guard := NewOrphanDeletionGuard(DeletionSafetyPolicy{GracePeriod: 5 * time.Minute}, clock)
+	mac := "FA:16:3E:00:00:01"
+
+	assert.Equal(t, []string{mac}, guard.Evaluate([]string{mac}, 1).Deferred)
+
+	clock.now = clock.now.Add(4 * time.Minute)
+	assert.Equal(t, []string{mac}, guard.Evaluate([]string{mac}, 1).Deferred)
+
+	clock.now = clock.now.Add(2 * time.Minute)
+	assert.Equal(t, []string{mac}, guard.Evaluate([]string{mac}, 1).Approved)
+
+	// 삭제 완료 후 기록 제거 - 다시 감지되면 유예 시간이 새로 시작됨
+	guard.Forget(mac)
+	assert.Equal(t, []string{mac}, guard.Evaluate([]string{mac}, 1).Deferred)
+}
+
+func TestOrphanDeletionGuard_Preview(t *testing.T) {
+	clock := &mutableClock{now: time.Now()}
+	guard := NewOrphanDeletionGuard(DeletionSafetyPolicy{ConfirmCycles: 2}, clock)
+	mac := "fa:16:3e:00:00:01"
+
+	// 미리보기는 관찰 기록을 남기지 않으므로 몇 번을 호출해도 첫 사이클로 평가
+	assert.Equal(t, []string{mac}, guard.Preview([]string{mac}, 1).Deferred)
+	assert.Equal(t, []string{mac}, guard.Preview([]string{mac}, 1).Deferred)
+
+	// 실제 평가 후의 미리보기는 다음 사이클 결과를 보여줌
+	assert.Equal(t, []string{mac}, guard.Evaluate([]string{mac}, 1).Deferred)
+	assert.Equal(t, []string{mac}, guard.Preview([]string{mac}, 1).Approved)
+
+	// 빈 후보로 미리보기해도 기록이 초기화되지 않음
+	guard.Preview([]string{}, 1)
+	assert.Equal(t, []string{mac}, guard.Evaluate([]string{mac}, 1).Approved)
+}
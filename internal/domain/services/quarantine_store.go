@@ -0,0 +1,147 @@
+package services
+
+import (
+	"fmt"
+	"multinic-agent/internal/domain/interfaces"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// QuarantineEntry는 격리 디렉토리에 보관된 설정 파일입니다
+type QuarantineEntry struct {
+	Path          string    // 격리된 파일의 전체 경로
+	FileName      string    // 원래 설정 파일 이름
+	QuarantinedAt time.Time // 격리된 시각
+}
+
+// QuarantineStore는 고아 인터페이스 설정 파일을 보존 기간 동안 격리 보관하는 도메인 서비스입니다
+// 격리 파일 이름은 "<unix 타임스탬프>_<원래 파일 이름>" 형식입니다
+type QuarantineStore struct {
+	fileSystem interfaces.FileSystem
+	clock      interfaces.Clock
+	dir        string
+	retention  time.Duration
+}
+
+// NewQuarantineStore는 새로운 QuarantineStore를 생성합니다
+func NewQuarantineStore(fs interfaces.FileSystem, clock interfaces.Clock, dir string, retention time.Duration) *QuarantineStore {
+	return &QuarantineStore{
+		fileSystem: fs,
+		clock:      clock,
+		dir:        dir,
+		retention:  retention,
+	}
+}
+
+// Dir은 격리 디렉토리 경로를 반환합니다
+func (s *QuarantineStore) Dir() string {
+	return s.dir
+}
+
+// Quarantine은 설정 파일을 타임스탬프와 함께 격리 디렉토리에 복사합니다
+// 원본 파일 삭제는 호출자가 롤백을 통해 수행합니다
+func (s *QuarantineStore) Quarantine(srcPath string) (QuarantineEntry, error) {
+	content, err := s.fileSystem.ReadFile(srcPath)
+	if err != nil {
+		return QuarantineEntry{}, fmt.Errorf("failed to read config file %s: %w", srcPath, err)
+	}
+
+	if err := s.fileSystem.MkdirAll(s.dir, 0755); err != nil {
+		return QuarantineEntry{}, fmt.Errorf("failed to create quarantine directory: %w", err)
+	}
+
+	now := s.clock.Now()
+	fileName := filepath.Base(srcPath)
+	entry := QuarantineEntry{
+		Path:          filepath.Join(s.dir, fmt.Sprintf("%d_%s", now.Unix(), fileName)),
+		FileName:      fileName,
+		QuarantinedAt: now,
+	}
+
+	if err := s.fileSystem.WriteFile(entry.Path, content, 0600); err != nil {
+		return QuarantineEntry{}, fmt.Errorf("failed to write quarantine file: %w", err)
+	}
+
+	return entry, nil
+}
+
+// List는 격리 디렉토리의 항목을 반환합니다
+func (s *QuarantineStore) List() ([]QuarantineEntry, error) {
+	if !s.fileSystem.Exists(s.dir) {
+		return nil, nil
+	}
+
+	files, err := s.fileSystem.ListFiles(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quarantine directory: %w", err)
+	}
+
+	var entries []QuarantineEntry
+	for _, file := range files {
+		parts := strings.SplitN(file, "_", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		ts, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, QuarantineEntry{
+			Path:          filepath.Join(s.dir, file),
+			FileName:      parts[1],
+			QuarantinedAt: time.Unix(ts, 0),
+		})
+	}
+
+	return entries, nil
+}
+
+// Read는 격리된 파일 내용을 읽습니다
+func (s *QuarantineStore) Read(entry QuarantineEntry) ([]byte, error) {
+	return s.fileSystem.ReadFile(entry.Path)
+}
+
+// IsExpired는 항목의 보존 기간이 지났는지 확인합니다
+func (s *QuarantineStore) IsExpired(entry QuarantineEntry) bool {
+	return s.clock.Now().Sub(entry.QuarantinedAt) >= s.retention
+}
+
+// Restore는 격리된 파일을 원래 디렉토리로 되돌리고 격리 항목을 제거합니다
+// 대상 파일이 이미 존재하면 덮어쓰지 않고 격리 항목만 제거합니다 (restored=false)
+func (s *QuarantineStore) Restore(entry QuarantineEntry, configDir string) (bool, error) {
+	destPath := filepath.Join(configDir, entry.FileName)
+	restored := false
+
+	if !s.fileSystem.Exists(destPath) {
+		content, err := s.fileSystem.ReadFile(entry.Path)
+		if err != nil {
+			return false, fmt.Errorf("failed to read quarantine file: %w", err)
+		}
+		if err := s.fileSystem.WriteFile(destPath, content, 0644); err != nil {
+			return false, fmt.Errorf("failed to restore config file %s: %w", destPath, err)
+		}
+		restored = true
+	}
+
+	if err := s.fileSystem.Remove(entry.Path); err != nil {
+		return restored, fmt.Errorf("failed to remove quarantine file: %w", err)
+	}
+
+	return restored, nil
+}
+
+// Purge는 보존 기간이 지난 격리 항목을 영구 삭제합니다
+func (s *QuarantineStore) Purge(entry QuarantineEntry) error {
+	return s.fileSystem.Remove(entry.Path)
+}
+
+// Discard는 원본 파일을 삭제하지 못해 격리가 완료되지 않은 항목을 제거합니다
+// 원본이 남아 있으므로 항목을 남겨 두면 다음 사이클에 같은 파일이 중복 격리됩니다
+func (s *QuarantineStore) Discard(entry QuarantineEntry) error {
+	if err := s.fileSystem.Remove(entry.Path); err != nil {
+		return fmt.Errorf("failed to discard quarantine file: %w", err)
+	}
+	return nil
+}
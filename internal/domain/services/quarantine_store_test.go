@@ -0,0 +1,49 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuarantineStore_List(t *testing.T) {
+	mockFS := new(MockFileSystem)
+	clock := &mutableClock{now: time.Unix(1700003600, 0)}
+	store := NewQuarantineStore(mockFS, clock, "/q", time.Hour)
+
+	mockFS.On("Exists", "/q").Return(true)
+	mockFS.On("ListFiles", "/q").Return([]string{
+		"1700000000_91-multinic1.yaml",
+		"1700003000_ifcfg-multinic2",
+		"not-a-quarantine-file",
+	}, nil)
+
+	entries, err := store.List()
+
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "91-multinic1.yaml", entries[0].FileName)
+	assert.Equal(t, "/q/1700000000_91-multinic1.yaml", entries[0].Path)
+	assert.True(t, store.IsExpired(entries[0]))
+	assert.Equal(t, "ifcfg-multinic2", entries[1].FileName)
+	assert.False(t, store.IsExpired(entries[1]))
+}
+
+func TestQuarantineStore_RestoreKeepsExistingConfig(t *testing.T) {
+	mockFS := new(MockFileSystem)
+	store := NewQuarantineStore(mockFS, &mutableClock{now: time.Now()}, "/q", time.Hour)
+	entry := QuarantineEntry{Path: "/q/1700000000_91-multinic1.yaml", FileName: "91-multinic1.yaml"}
+
+	// 설정 파일이 이미 다시 생성된 경우 덮어쓰지 않고 격리 항목만 제거
+	mockFS.On("Exists", "/etc/netplan/91-multinic1.yaml").Return(true)
+	mockFS.On("Remove", entry.Path).Return(nil)
+
+	restored, err := store.Restore(entry, "/etc/netplan")
+
+	require.NoError(t, err)
+	assert.False(t, restored)
+	mockFS.AssertExpectations(t)
+	mockFS.AssertNotCalled(t, "WriteFile")
+}
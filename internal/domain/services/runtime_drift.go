@@ -0,0 +1,92 @@
+package services
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"multinic-agent/internal/domain/entities"
+)
+
+// 커널 런타임 드리프트 유형 (설정 파일 드리프트와 구분하기 위해 runtime_ 접두사 사용)
+const (
+	DriftTypeRuntimeAddress    = "runtime_address"
+	DriftTypeRuntimeMTU        = "runtime_mtu"
+	DriftTypeRuntimeAdminState = "runtime_admin_state" // ip link set down으로 관리자가 비활성화한 링크 (캐리어 상태인 operstate는 비교하지 않음)
+	DriftTypeRuntimeName       = "runtime_name"
+)
+
+// DetectRuntimeDrift는 DB의 원하는 상태와 커널 링크의 실제 상태를 비교하여 드리프트 유형 목록을 반환합니다
+// state는 MAC 주소로 찾은 링크 상태이며, 이름이 expectedName과 다르면 이름 드리프트로 판단합니다
+func DetectRuntimeDrift(dbIface entities.NetworkInterface, expectedName string, state *entities.LinkState) []string {
+	desired := entities.NewInterfaceConfig(dbIface, expectedName)
+	var drifts []string
+
+	if state.Name != expectedName {
+		drifts = append(drifts, DriftTypeRuntimeName)
+	}
+
+	if !addressesMatch(desired, state.Addresses) {
+		drifts = append(drifts, DriftTypeRuntimeAddress)
+	}
+
+	if desired.MTU > 0 && state.MTU != desired.MTU {
+		drifts = append(drifts, DriftTypeRuntimeMTU)
+	}
+
+	if !state.AdminUp {
+		drifts = append(drifts, DriftTypeRuntimeAdminState)
+	}
+
+	return drifts
+}
+
+// DiffLinkState는 dry-run 계획에 표시할 커널 링크 상태와 원하는 상태의 필드별 차이를 반환합니다
+func DiffLinkState(desired entities.InterfaceConfig, state *entities.LinkState) []entities.FieldDiff {
+	var diffs []entities.FieldDiff
+	diffs = appendFieldDiff(diffs, "link_name", state.Name, desired.Name)
+
+	if !addressesMatch(desired, state.Addresses) {
+		wanted := ""
+		if desired.HasAddress() {
+			wanted = desired.Address + desired.CIDR[strings.Index(desired.CIDR, "/"):]
+		}
+		diffs = appendFieldDiff(diffs, "link_addresses", strings.Join(ipv4Addresses(state.Addresses), ","), wanted)
+	}
+
+	if desired.MTU > 0 {
+		diffs = appendFieldDiff(diffs, "link_mtu", formatMTU(state.MTU), formatMTU(desired.MTU))
+	}
+
+	return appendFieldDiff(diffs, "link_admin_up", strconv.FormatBool(state.AdminUp), "true")
+}
+
+// addressesMatch는 원하는 정적 IPv4 주소/프리픽스가 링크에 있는지 확인합니다
+// 다른 주소(keepalived VIP, 수동으로 추가한 주소, IPv6 링크 로컬 등)는 에이전트가 관리하지 않으므로 비교하지 않습니다
+// 재적용해도 사라지지 않는 주소를 드리프트로 보면 매 사이클 netplan을 다시 적용하며 수렴하지 않습니다
+func addressesMatch(desired entities.InterfaceConfig, addresses []string) bool {
+	if !desired.HasAddress() {
+		return true
+	}
+
+	wanted := desired.Address + desired.CIDR[strings.Index(desired.CIDR, "/"):]
+	for _, addr := range ipv4Addresses(addresses) {
+		if addr == wanted {
+			return true
+		}
+	}
+	return false
+}
+
+// ipv4Addresses는 CIDR 표기 주소 목록에서 IPv4 주소만 골라냅니다
+func ipv4Addresses(addresses []string) []string {
+	var ipv4 []string
+	for _, addr := range addresses {
+		ip, _, err := net.ParseCIDR(addr)
+		if err != nil || ip.To4() == nil {
+			continue
+		}
+		ipv4 = append(ipv4, addr)
+	}
+	return ipv4
+}
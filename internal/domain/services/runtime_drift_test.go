@@ -0,0 +1,103 @@
+package services
+
+import (
+	"testing"
+
+	"multinic-agent/internal/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectRuntimeDrift(t *testing.T) {
+	dbIface := entities.NetworkInterface{
+		MacAddress: "fa:16:3e:00:be:63",
+		Address:    "192.168.1.10",
+		CIDR:       "192.168.1.0/24",
+		MTU:        1450,
+	}
+	inSync := entities.LinkState{
+		Name:       "multinic0",
+		MacAddress: "fa:16:3e:00:be:63",
+		MTU:        1450,
+		OperState:  "UP",
+		AdminUp:    true,
+		Addresses:  []string{"192.168.1.10/24", "fe80::f816:3eff:fe00:be63/64"},
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(*entities.LinkState)
+		expected []string
+	}{
+		{
+			name:     "동기화된 상태 (IPv6 링크 로컬 무시)",
+			modify:   func(s *entities.LinkState) {},
+			expected: nil,
+		},
+		{
+			name:     "ip addr del로 주소 제거",
+			modify:   func(s *entities.LinkState) { s.Addresses = []string{"fe80::f816:3eff:fe00:be63/64"} },
+			expected: []string{DriftTypeRuntimeAddress},
+		},
+		{
+			name:     "추가 IPv4 주소 (VIP 등)는 드리프트 아님",
+			modify:   func(s *entities.LinkState) { s.Addresses = append(s.Addresses, "10.0.0.5/8") },
+			expected: nil,
+		},
+		{
+			name:     "원하는 주소 대신 다른 주소만 있음",
+			modify:   func(s *entities.LinkState) { s.Addresses = []string{"10.0.0.5/8"} },
+			expected: []string{DriftTypeRuntimeAddress},
+		},
+		{
+			name:     "프리픽스가 다른 주소",
+			modify:   func(s *entities.LinkState) { s.Addresses = []string{"192.168.1.10/16", "10.0.0.5/8"} },
+			expected: []string{DriftTypeRuntimeAddress},
+		},
+		{
+			name:     "ip link set mtu로 MTU 변경",
+			modify:   func(s *entities.LinkState) { s.MTU = 1500 },
+			expected: []string{DriftTypeRuntimeMTU},
+		},
+		{
+			name:     "링크 비활성화 및 이름 변경",
+			modify:   func(s *entities.LinkState) { s.Name, s.AdminUp = "ens5", false },
+			expected: []string{DriftTypeRuntimeName, DriftTypeRuntimeAdminState},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := inSync
+			state.Addresses = append([]string(nil), inSync.Addresses...)
+			tt.modify(&state)
+
+			assert.Equal(t, tt.expected, DetectRuntimeDrift(dbIface, "multinic0", &state))
+		})
+	}
+}
+
+func TestDiffLinkState(t *testing.T) {
+	desired := entities.InterfaceConfig{
+		Name:       "multinic0",
+		MacAddress: "fa:16:3e:00:be:63",
+		Address:    "192.168.1.10",
+		CIDR:       "192.168.1.0/24",
+		MTU:        1450,
+	}
+	state := &entities.LinkState{
+		Name:      "eth1",
+		MTU:       1500,
+		AdminUp:   false,
+		Addresses: []string{"192.168.1.99/24", "fe80::1/64"},
+	}
+
+	diffs := DiffLinkState(desired, state)
+
+	assert.Equal(t, []entities.FieldDiff{
+		{Field: "link_name", Current: "eth1", Desired: "multinic0"},
+		{Field: "link_addresses", Current: "192.168.1.99/24", Desired: "192.168.1.10/24"},
+		{Field: "link_mtu", Current: "1500", Desired: "1450"},
+		{Field: "link_admin_up", Current: "false", Desired: "true"},
+	}, diffs)
+}
@@ -46,6 +46,54 @@ func (fs *RealFileSystem) Remove(path string) error {
 	return os.Remove(path)
 }
 
+// Rename은 파일 이름을 변경합니다
+func (fs *RealFileSystem) Rename(oldPath, newPath string) error {
+	return os.Rename(oldPath, newPath)
+}
+
+// WriteFileAtomic은 임시 파일에 쓰고 fsync한 뒤 대상 파일로 이름을 변경합니다
+func (fs *RealFileSystem) WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath) // 이름 변경에 성공하면 이미 없으므로 무시됨
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return err
+	}
+
+	// 이름 변경이 재부팅 후에도 유지되도록 디렉토리도 동기화
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
 // ListFiles는 디렉토리의 파일 목록을 반환합니다
 func (fs *RealFileSystem) ListFiles(path string) ([]string, error) {
 	entries, err := os.ReadDir(path)
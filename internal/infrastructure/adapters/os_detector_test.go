@@ -44,6 +44,16 @@ func (m *MockFileSystemForOSDetector) Remove(path string) error {
 	return args.Error(0)
 }
 
+func (m *MockFileSystemForOSDetector) Rename(oldPath, newPath string) error {
+	args := m.Called(oldPath, newPath)
+	return args.Error(0)
+}
+
+func (m *MockFileSystemForOSDetector) WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	args := m.Called(path, data, perm)
+	return args.Error(0)
+}
+
 func (m *MockFileSystemForOSDetector) ListFiles(path string) ([]string, error) {
 	args := m.Called(path)
 	return args.Get(0).([]string), args.Error(1)
@@ -0,0 +1,242 @@
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"multinic-agent/internal/application/usecases"
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/errors"
+	"multinic-agent/internal/domain/interfaces"
+	"multinic-agent/internal/domain/services"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ReconcileController controls the reconcile polling loop
+type ReconcileController interface {
+	// Trigger requests an immediate reconcile cycle, returning false while paused
+	Trigger() bool
+
+	// Pause stops periodic reconcile cycles (maintenance freeze)
+	Pause()
+
+	// Resume restarts periodic reconcile cycles
+	Resume()
+
+	// Paused reports whether reconcile cycles are paused
+	Paused() bool
+}
+
+// API serves authenticated admin endpoints for manual control of the agent on this node
+type API struct {
+	token         string
+	statusUseCase *usecases.NetworkStatusUseCase
+	repository    interfaces.NetworkInterfaceRepository
+	namingService *services.InterfaceNamingService
+	controller    ReconcileController
+	nodeName      func() (string, error)
+	logger        *logrus.Logger
+}
+
+// NewAPI creates a new admin API that accepts requests bearing the given token
+func NewAPI(
+	token string,
+	statusUseCase *usecases.NetworkStatusUseCase,
+	repo interfaces.NetworkInterfaceRepository,
+	naming *services.InterfaceNamingService,
+	controller ReconcileController,
+	nodeName func() (string, error),
+	logger *logrus.Logger,
+) *API {
+	return &API{
+		token:         token,
+		statusUseCase: statusUseCase,
+		repository:    repo,
+		namingService: naming,
+		controller:    controller,
+		nodeName:      nodeName,
+		logger:        logger,
+	}
+}
+
+// stateResponse is the response of endpoints that change the reconcile loop state
+type stateResponse struct {
+	Paused    bool   `json:"paused"`
+	Triggered bool   `json:"triggered"`
+	Message   string `json:"message,omitempty"`
+}
+
+// Handler returns the HTTP handler for the admin endpoints, mounted under /admin/
+func (a *API) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /admin/interfaces", a.listInterfaces)
+	mux.HandleFunc("POST /admin/interfaces/{id}/reapply", a.reapplyInterface)
+	mux.HandleFunc("GET /admin/reconcile", a.reconcileState)
+	mux.HandleFunc("POST /admin/reconcile", a.triggerReconcile)
+	mux.HandleFunc("POST /admin/pause", a.pause)
+	mux.HandleFunc("POST /admin/resume", a.resume)
+	mux.HandleFunc("DELETE /admin/names/{mac}", a.releaseName)
+	return a.authenticate(mux)
+}
+
+// authenticate rejects requests without the expected bearer token
+func (a *API) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) != 1 {
+			a.logger.WithFields(logrus.Fields{
+				"remote_addr": r.RemoteAddr,
+				"path":        r.URL.Path,
+			}).Warn("Rejected unauthenticated admin request")
+			w.Header().Set("WWW-Authenticate", `Bearer realm="multinic-agent"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// listInterfaces serves the managed interfaces with their DB row, config file and live link state
+func (a *API) listInterfaces(w http.ResponseWriter, r *http.Request) {
+	nodeName, err := a.nodeName()
+	if err != nil {
+		a.writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	output, err := a.statusUseCase.Execute(r.Context(), usecases.NetworkStatusInput{NodeName: nodeName})
+	if err != nil {
+		a.writeError(w, http.StatusServiceUnavailable, err)
+		return
+	}
+
+	views := make([]usecases.InterfaceStatusView, 0, len(output.Interfaces))
+	for _, status := range output.Interfaces {
+		views = append(views, status.View())
+	}
+	a.writeJSON(w, http.StatusOK, views)
+}
+
+// reapplyInterface marks an interface of this node as pending so that the next cycle rewrites its configuration
+func (a *API) reapplyInterface(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		a.writeError(w, http.StatusBadRequest, fmt.Errorf("invalid interface id %q", r.PathValue("id")))
+		return
+	}
+
+	iface, err := a.findNodeInterface(r.Context(), id)
+	if err != nil {
+		status := http.StatusServiceUnavailable
+		if errors.IsNotFoundError(err) {
+			status = http.StatusNotFound
+		}
+		a.writeError(w, status, err)
+		return
+	}
+
+	if err := a.repository.UpdateInterfaceStatus(r.Context(), iface.ID, entities.StatusPending); err != nil {
+		a.writeError(w, http.StatusServiceUnavailable, err)
+		return
+	}
+
+	a.audit(r, "reapply").WithFields(logrus.Fields{
+		"interface_id": iface.ID,
+		"mac_address":  iface.MacAddress,
+	}).Info("Interface marked for re-apply")
+
+	response := stateResponse{Paused: a.controller.Paused(), Triggered: a.controller.Trigger()}
+	if response.Paused {
+		response.Message = "reconcile is paused, the interface will be re-applied after resume"
+	}
+	a.writeJSON(w, http.StatusAccepted, response)
+}
+
+// findNodeInterface looks up an interface by ID and makes sure it is attached to this node
+func (a *API) findNodeInterface(ctx context.Context, id int) (*entities.NetworkInterface, error) {
+	nodeName, err := a.nodeName()
+	if err != nil {
+		return nil, err
+	}
+	iface, err := a.repository.GetInterfaceByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if iface.AttachedNodeName != nodeName {
+		return nil, errors.NewNotFoundError(fmt.Sprintf("interface %d is not attached to node %s", id, nodeName))
+	}
+	return iface, nil
+}
+
+// reconcileState serves whether reconcile cycles are paused
+func (a *API) reconcileState(w http.ResponseWriter, r *http.Request) {
+	a.writeJSON(w, http.StatusOK, stateResponse{Paused: a.controller.Paused()})
+}
+
+// triggerReconcile starts a reconcile cycle without waiting for the next polling interval
+func (a *API) triggerReconcile(w http.ResponseWriter, r *http.Request) {
+	if !a.controller.Trigger() {
+		a.writeJSON(w, http.StatusConflict, stateResponse{Paused: true, Message: "reconcile is paused, resume it first"})
+		return
+	}
+	a.audit(r, "reconcile").Info("Immediate reconcile requested")
+	a.writeJSON(w, http.StatusAccepted, stateResponse{Triggered: true})
+}
+
+// pause freezes reconciliation until resume is called or the agent restarts
+func (a *API) pause(w http.ResponseWriter, r *http.Request) {
+	a.controller.Pause()
+	a.audit(r, "pause").Warn("Reconcile paused for maintenance")
+	a.writeJSON(w, http.StatusOK, stateResponse{Paused: true})
+}
+
+// resume ends a maintenance freeze and runs a cycle right away
+func (a *API) resume(w http.ResponseWriter, r *http.Request) {
+	a.controller.Resume()
+	a.audit(r, "resume").Info("Reconcile resumed")
+	a.writeJSON(w, http.StatusOK, stateResponse{Triggered: a.controller.Trigger()})
+}
+
+// releaseName drops the name allocation of a MAC address so the name can be reused immediately
+func (a *API) releaseName(w http.ResponseWriter, r *http.Request) {
+	allocator := a.namingService.Allocator()
+	if allocator == nil {
+		a.writeError(w, http.StatusConflict, fmt.Errorf("name allocation store is disabled"))
+		return
+	}
+
+	mac := r.PathValue("mac")
+	if err := allocator.Forget(r.Context(), mac); err != nil {
+		a.writeError(w, http.StatusNotFound, err)
+		return
+	}
+
+	a.audit(r, "release_name").WithField("mac_address", mac).Info("Name allocation released")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// audit returns a log entry identifying an admin action and its caller
+func (a *API) audit(r *http.Request, action string) *logrus.Entry {
+	return a.logger.WithFields(logrus.Fields{
+		"admin_action": action,
+		"remote_addr":  r.RemoteAddr,
+	})
+}
+
+// writeError writes an error as a JSON response
+func (a *API) writeError(w http.ResponseWriter, status int, err error) {
+	a.writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+// writeJSON writes a value as a JSON response with the given status code
+func (a *API) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		a.logger.WithError(err).Error("failed to encode admin API response")
+	}
+}
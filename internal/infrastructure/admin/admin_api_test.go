@@ -0,0 +1,151 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testToken = "0123456789abcdef"
+
+// fakeController is a ReconcileController that records trigger requests
+type fakeController struct {
+	paused   bool
+	triggers int
+}
+
+func (c *fakeController) Trigger() bool {
+	if c.paused {
+		return false
+	}
+	c.triggers++
+	return true
+}
+
+func (c *fakeController) Pause()       { c.paused = true }
+func (c *fakeController) Resume()      { c.paused = false }
+func (c *fakeController) Paused() bool { return c.paused }
+
+// mockRepository is a mock NetworkInterfaceRepository
+type mockRepository struct {
+	mock.Mock
+}
+
+func (m *mockRepository) GetPendingInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error) {
+	args := m.Called(ctx, nodeName)
+	return args.Get(0).([]entities.NetworkInterface), args.Error(1)
+}
+
+func (m *mockRepository) GetConfiguredInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error) {
+	args := m.Called(ctx, nodeName)
+	return args.Get(0).([]entities.NetworkInterface), args.Error(1)
+}
+
+func (m *mockRepository) UpdateInterfaceStatus(ctx context.Context, interfaceID int, status entities.InterfaceStatus) error {
+	return m.Called(ctx, interfaceID, status).Error(0)
+}
+
+func (m *mockRepository) GetInterfaceByID(ctx context.Context, id int) (*entities.NetworkInterface, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.NetworkInterface), args.Error(1)
+}
+
+func (m *mockRepository) GetActiveInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error) {
+	args := m.Called(ctx, nodeName)
+	return args.Get(0).([]entities.NetworkInterface), args.Error(1)
+}
+
+func (m *mockRepository) GetAllNodeInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error) {
+	args := m.Called(ctx, nodeName)
+	return args.Get(0).([]entities.NetworkInterface), args.Error(1)
+}
+
+func newTestAPI(repo *mockRepository, controller *fakeController) http.Handler {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+	nodeName := func() (string, error) { return "worker-1", nil }
+	return NewAPI(testToken, nil, repo, nil, controller, nodeName, logger).Handler()
+}
+
+func serve(handler http.Handler, method, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAPI_Authentication(t *testing.T) {
+	controller := &fakeController{}
+	handler := newTestAPI(new(mockRepository), controller)
+
+	tests := []struct {
+		name       string
+		token      string
+		wantStatus int
+	}{
+		{name: "no token", token: "", wantStatus: http.StatusUnauthorized},
+		{name: "wrong token", token: "fedcba9876543210", wantStatus: http.StatusUnauthorized},
+		{name: "valid token", token: testToken, wantStatus: http.StatusAccepted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(handler, http.MethodPost, "/admin/reconcile", tt.token)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+	assert.Equal(t, 1, controller.triggers)
+}
+
+func TestAPI_PauseAndResume(t *testing.T) {
+	controller := &fakeController{}
+	handler := newTestAPI(new(mockRepository), controller)
+
+	assert.Equal(t, http.StatusOK, serve(handler, http.MethodPost, "/admin/pause", testToken).Code)
+	assert.True(t, controller.Paused())
+
+	// Reconcile requests are rejected during a maintenance freeze
+	rec := serve(handler, http.MethodPost, "/admin/reconcile", testToken)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.JSONEq(t, `{"paused":true,"triggered":false,"message":"reconcile is paused, resume it first"}`, rec.Body.String())
+
+	rec = serve(handler, http.MethodPost, "/admin/resume", testToken)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.False(t, controller.Paused())
+	assert.Equal(t, 1, controller.triggers)
+}
+
+func TestAPI_ReapplyInterface(t *testing.T) {
+	repo := new(mockRepository)
+	repo.On("GetInterfaceByID", mock.Anything, 1).Return(&entities.NetworkInterface{ID: 1, MacAddress: "fa:16:3e:00:00:01", AttachedNodeName: "worker-1"}, nil)
+	repo.On("GetInterfaceByID", mock.Anything, 2).Return(&entities.NetworkInterface{ID: 2, MacAddress: "fa:16:3e:00:00:02", AttachedNodeName: "worker-2"}, nil)
+	repo.On("GetInterfaceByID", mock.Anything, 3).Return(nil, errors.NewNotFoundError("interface not found: ID=3"))
+	repo.On("UpdateInterfaceStatus", mock.Anything, 1, entities.StatusPending).Return(nil)
+
+	controller := &fakeController{}
+	handler := newTestAPI(repo, controller)
+
+	assert.Equal(t, http.StatusAccepted, serve(handler, http.MethodPost, "/admin/interfaces/1/reapply", testToken).Code)
+	assert.Equal(t, 1, controller.triggers)
+
+	// Interfaces of other nodes and unknown IDs are not touched
+	assert.Equal(t, http.StatusNotFound, serve(handler, http.MethodPost, "/admin/interfaces/2/reapply", testToken).Code)
+	assert.Equal(t, http.StatusNotFound, serve(handler, http.MethodPost, "/admin/interfaces/3/reapply", testToken).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(handler, http.MethodPost, "/admin/interfaces/abc/reapply", testToken).Code)
+
+	repo.AssertNumberOfCalls(t, "UpdateInterfaceStatus", 1)
+}
@@ -1,61 +1,218 @@
 package config
 
 import (
+	"fmt"
 	"multinic-agent/internal/domain/constants"
+	"multinic-agent/internal/domain/entities"
 	"multinic-agent/internal/domain/errors"
+	"multinic-agent/internal/domain/services"
 	"os"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
+
+	"github.com/sirupsen/logrus"
 )
 
+// sqlIdentifierRegex matches column names that can be safely interpolated into queries
+var sqlIdentifierRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
 // Config is a struct that holds application configuration
 type Config struct {
-	Database DatabaseConfig
-	Agent    AgentConfig
-	Health   HealthConfig
+	Database   DatabaseConfig   `yaml:"database"`
+	Source     SourceConfig     `yaml:"source"`
+	Agent      AgentConfig      `yaml:"agent"`
+	Health     HealthConfig     `yaml:"health"`
+	Kubernetes KubernetesConfig `yaml:"kubernetes"`
 }
 
 // DatabaseConfig is a struct that holds database configuration
 type DatabaseConfig struct {
-	Host         string
-	Port         string
-	User         string
-	Password     string
-	Database     string
-	MaxOpenConns int
-	MaxIdleConns int
-	MaxLifetime  time.Duration
+	Host         string        `yaml:"host"`
+	Port         string        `yaml:"port"`
+	User         string        `yaml:"user"`
+	Password     string        `yaml:"password"`
+	Database     string        `yaml:"name"`
+	MaxOpenConns int           `yaml:"maxOpenConns"`
+	MaxIdleConns int           `yaml:"maxIdleConns"`
+	MaxLifetime  time.Duration `yaml:"maxLifetime"`
+	// DesiredNameColumn is the optional multi_interface column holding centrally managed interface names (empty to disable)
+	DesiredNameColumn string `yaml:"desiredNameColumn"`
+}
+
+// Interface sources
+const (
+	InterfaceSourceDB                = "db"
+	InterfaceSourceOpenStackMetadata = "openstack-metadata" // network_data.json of the instance, without the central DB
+	InterfaceSourceNeutron           = "openstack-neutron"  // Neutron ports of the instance, without the multi_interface table
+)
+
+// SourceConfig is a struct that holds where the desired interfaces of the node are read from
+type SourceConfig struct {
+	Type     string               `yaml:"type"` // "db", "openstack-metadata" or "openstack-neutron"
+	Metadata MetadataSourceConfig `yaml:"metadata"`
+	Neutron  NeutronSourceConfig  `yaml:"neutron"`
+}
+
+// MetadataSourceConfig is a struct that holds the OpenStack metadata interface source configuration
+type MetadataSourceConfig struct {
+	ConfigDriveFile string        `yaml:"configDriveFile"` // network_data.json on a mounted config drive (tried first, empty to skip)
+	URL             string        `yaml:"url"`             // network_data.json of the metadata service
+	Timeout         time.Duration `yaml:"timeout"`         // metadata service request timeout
+	StatusFile      string        `yaml:"statusFile"`      // host file keeping the configuration status of each interface
+}
+
+// NeutronSourceConfig is a struct that holds the Neutron port interface source configuration
+type NeutronSourceConfig struct {
+	AuthURL           string        `yaml:"authURL"` // Keystone v3 URL
+	Username          string        `yaml:"username"`
+	Password          string        `yaml:"password"`
+	UserDomainName    string        `yaml:"userDomainName"`
+	ProjectName       string        `yaml:"projectName"`
+	ProjectDomainName string        `yaml:"projectDomainName"`
+	RegionName        string        `yaml:"regionName"`
+	Interface         string        `yaml:"interface"`       // catalog endpoint interface ("public", "internal" or "admin")
+	Endpoint          string        `yaml:"endpoint"`        // Neutron URL overriding the catalog (empty to use the catalog)
+	DeviceID          string        `yaml:"deviceID"`        // instance UUID of the node (empty to read the DMI system UUID)
+	PrimaryNetworks   []string      `yaml:"primaryNetworks"` // IDs or names of the primary NIC's networks, whose ports are never configured
+	Timeout           time.Duration `yaml:"timeout"`
 }
 
 // AgentConfig is a struct that holds agent configuration
 type AgentConfig struct {
-	PollInterval       time.Duration
-	MaxRetries         int
-	RetryDelay         time.Duration
-	CommandTimeout     time.Duration
-	BackupDirectory    string
-	Backoff            BackoffConfig
-	MaxConcurrentTasks int // 동시에 처리할 최대 인터페이스 수
+	PollInterval       time.Duration         `yaml:"pollInterval"`
+	MaxRetries         int                   `yaml:"maxRetries"`
+	RetryDelay         time.Duration         `yaml:"retryDelay"`
+	CommandTimeout     time.Duration         `yaml:"commandTimeout"`
+	BackupDirectory    string                `yaml:"backupDirectory"`
+	Backoff            BackoffConfig         `yaml:"backoff"`
+	MaxConcurrentTasks int                   `yaml:"maxConcurrentTasks"` // 동시에 처리할 최대 인터페이스 수
+	OrphanSafety       OrphanSafetyConfig    `yaml:"orphanSafety"`
+	Quarantine         QuarantineConfig      `yaml:"quarantine"`
+	LinkCleanup        LinkCleanupConfig     `yaml:"linkCleanup"`
+	RuntimeDriftCheck  bool                  `yaml:"runtimeDriftCheck"` // compare live kernel link state with the DB in addition to config files
+	ChangeDetection    ChangeDetectionConfig `yaml:"changeDetection"`
+	Naming             NamingConfig          `yaml:"naming"`
+	NameAllocation     NameAllocationConfig  `yaml:"nameAllocation"`
+	NodeIdentity       NodeIdentityConfig    `yaml:"nodeIdentity"`
+	AgentID            string                `yaml:"agentID"` // recorded in the ownership header of rendered files (empty means the node name)
+	DryRun             bool                  `yaml:"dryRun"`  // compute and report the reconcile plan without changing the node
+	LogLevel           string                `yaml:"logLevel"`
+	// ConfigReloadInterval is how often the config file is checked for changes (0 disables hot reload)
+	ConfigReloadInterval time.Duration `yaml:"configReloadInterval"`
+}
+
+// NodeIdentityConfig is a struct that holds how the agent determines its node name in the interface source
+type NodeIdentityConfig struct {
+	Sources []string `yaml:"sources"` // tried in order: node-name, hostname, instance-uuid, mapping (empty means node-name, hostname)
+	Mapping []string `yaml:"mapping"` // "hostname-or-instance-uuid=node-name" entries for the mapping source
+}
+
+// Name allocation store backends
+const (
+	NameAllocationStoreFile = "file"
+	NameAllocationStoreDB   = "db"
+	NameAllocationStoreNone = "none"
+)
+
+// NameAllocationConfig is a struct that holds the durable MAC-to-name allocation configuration
+type NameAllocationConfig struct {
+	Store      string        `yaml:"store"`      // "file", "db" or "none"
+	File       string        `yaml:"file"`       // allocation file path for the file store
+	ReuseDelay time.Duration `yaml:"reuseDelay"` // how long a released name is kept from other MAC addresses
+}
+
+// ChangeDetectionConfig is a struct that holds skipping of reconcile cycles while the interface source is unchanged
+type ChangeDetectionConfig struct {
+	Enabled            bool          `yaml:"enabled"`
+	FullResyncInterval time.Duration `yaml:"fullResyncInterval"` // full reconcile interval for node-side drift while the source is unchanged
+}
+
+// NamingConfig is a struct that holds the interface naming scheme
+type NamingConfig struct {
+	Prefix        string `yaml:"prefix"`
+	Template      string `yaml:"template"` // e.g., "{prefix}{index}" or "{prefix}{network}{index}"
+	MaxInterfaces int    `yaml:"maxInterfaces"`
+}
+
+// OrphanSafetyConfig is a struct that holds safety rules for orphaned interface deletion
+// A zero value disables the corresponding rule. The defaults wait three cycles and five minutes
+// and block more than five deletions per cycle, so that an empty source result cannot remove every interface at once
+type OrphanSafetyConfig struct {
+	MaxDeletionsPerCycle int           `yaml:"maxDeletionsPerCycle"`
+	MaxDeletionPercent   float64       `yaml:"maxDeletionPercent"`
+	ConfirmCycles        int           `yaml:"confirmCycles"`
+	GracePeriod          time.Duration `yaml:"gracePeriod"`
 }
 
 // BackoffConfig is a struct that holds backoff configuration
 type BackoffConfig struct {
-	Enabled     bool
-	MaxInterval time.Duration
-	Multiplier  float64
+	Enabled     bool          `yaml:"enabled"`
+	MaxInterval time.Duration `yaml:"maxInterval"`
+	Multiplier  float64       `yaml:"multiplier"`
+}
+
+// QuarantineConfig is a struct that holds two-phase soft delete configuration for orphaned interfaces
+type QuarantineConfig struct {
+	Enabled   bool          `yaml:"enabled"`
+	Directory string        `yaml:"directory"`
+	Retention time.Duration `yaml:"retention"`
+}
+
+// LinkCleanupConfig is a struct that holds live link cleanup configuration for deleted interfaces
+type LinkCleanupConfig struct {
+	RestoreKernelName bool `yaml:"restoreKernelName"` // rename the link back to its udev kernel name after cleanup
 }
 
 // HealthConfig is a struct that holds health check configuration
 type HealthConfig struct {
-	Port string
+	Port       string `yaml:"port"`
+	AdminToken string `yaml:"adminToken"` // bearer token for the admin API on the health server (empty disables the admin API)
+	// LivenessStaleAfter is how long the polling loop may go without progress before /livez fails
+	LivenessStaleAfter time.Duration `yaml:"livenessStaleAfter"`
+	// ReadinessDBGrace is how long the database may be unreachable before /readyz fails
+	ReadinessDBGrace time.Duration `yaml:"readinessDBGrace"`
+}
+
+// Node readiness gate modes
+const (
+	ReadinessGateNone  = "none"
+	ReadinessGateTaint = "taint" // keep a NoSchedule taint on the node until its interfaces are configured
+	ReadinessGateLabel = "label" // keep a readiness label on the node only while its interfaces are configured
+)
+
+// KubernetesConfig is a struct that holds publishing of NIC configuration results on the node object
+type KubernetesConfig struct {
+	NodeReporting bool   `yaml:"nodeReporting"` // emit node events and maintain the MultiNICReady condition and interfaces annotation
+	NodeName      string `yaml:"nodeName"`      // name of the node object (empty means the agent's node name)
+	ReadinessGate string `yaml:"readinessGate"` // "none", "taint" or "label"
+	// NetworkAttachments publishes Multus NetworkAttachmentDefinitions for configured interfaces
+	NetworkAttachments NetworkAttachmentConfig `yaml:"networkAttachments"`
+}
+
+// NetworkAttachmentConfig is a struct that holds Multus NetworkAttachmentDefinition publishing configuration
+type NetworkAttachmentConfig struct {
+	Enabled   bool   `yaml:"enabled"`
+	Namespace string `yaml:"namespace"` // namespace of the published definitions
+	CNIType   string `yaml:"cniType"`   // "macvlan", "ipvlan" or "host-device"
+	IPAM      string `yaml:"ipam"`      // "whereabouts", "host-local", "static", "dhcp" or "none"
 }
 
+// minLivenessStaleAfter is the lower bound of the default liveness threshold, so that a
+// long reconcile cycle (netplan try waits up to two minutes per interface) is not mistaken for a wedged loop
+const minLivenessStaleAfter = 10 * time.Minute
+
+// minAdminTokenLength is the minimum admin API token length, to rule out guessable tokens
+const minAdminTokenLength = 16
+
 // ConfigLoader is an interface for loading configuration
 type ConfigLoader interface {
 	Load() (*Config, error)
 }
 
 // EnvironmentConfigLoader is an implementation that loads configuration from environment variables
+// Malformed values (e.g. MAX_RETRIES=abc) are validation errors instead of silently falling back to the defaults
 type EnvironmentConfigLoader struct{}
 
 // NewEnvironmentConfigLoader creates a new EnvironmentConfigLoader
@@ -65,45 +222,208 @@ func NewEnvironmentConfigLoader() ConfigLoader {
 
 // Load loads configuration from environment variables
 func (l *EnvironmentConfigLoader) Load() (*Config, error) {
-	config := &Config{
+	config := defaultConfig()
+	env := &envReader{}
+	applyEnvironment(config, env)
+	if err := env.err(); err != nil {
+		return nil, err
+	}
+	applyDerivedDefaults(config)
+
+	// Validate configuration
+	if err := l.validate(config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// validate validates the configuration
+func (l *EnvironmentConfigLoader) validate(config *Config) error {
+	return validateConfig(config)
+}
+
+// defaultConfig returns the configuration used for settings that neither the config file nor the environment sets
+func defaultConfig() *Config {
+	return &Config{
 		Database: DatabaseConfig{
-			Host:         getEnvOrDefault("DB_HOST", constants.DefaultDBHost),
-			Port:         getEnvOrDefault("DB_PORT", constants.DefaultDBPort),
-			User:         getEnvOrDefault("DB_USER", "root"), // TODO: 기본값 제거, 환경변수 필수로 변경
-			Password:     getEnvOrDefault("DB_PASSWORD", ""), // 보안: 기본값 제거
-			Database:     getEnvOrDefault("DB_NAME", constants.DefaultDBName),
-			MaxOpenConns: getEnvIntOrDefault("DB_MAX_OPEN_CONNS", 10),
-			MaxIdleConns: getEnvIntOrDefault("DB_MAX_IDLE_CONNS", 5),
-			MaxLifetime:  getEnvDurationOrDefault("DB_MAX_LIFETIME", 5*time.Minute),
+			Host:         constants.DefaultDBHost,
+			Port:         constants.DefaultDBPort,
+			User:         "root", // TODO: 기본값 제거, 환경변수 필수로 변경
+			Database:     constants.DefaultDBName,
+			MaxOpenConns: 10,
+			MaxIdleConns: 5,
+			MaxLifetime:  5 * time.Minute,
+		},
+		Source: SourceConfig{
+			Type: InterfaceSourceDB,
+			Metadata: MetadataSourceConfig{
+				URL:        constants.DefaultMetadataURL,
+				Timeout:    5 * time.Second,
+				StatusFile: constants.DefaultMetadataStatusFile,
+			},
+			Neutron: NeutronSourceConfig{
+				UserDomainName:    "Default",
+				ProjectDomainName: "Default",
+				Interface:         "public",
+				Timeout:           10 * time.Second,
+			},
 		},
 		Agent: AgentConfig{
-			PollInterval:       getEnvDurationOrDefault("POLL_INTERVAL", 30*time.Second),
-			MaxRetries:         getEnvIntOrDefault("MAX_RETRIES", 3),
-			RetryDelay:         getEnvDurationOrDefault("RETRY_DELAY", 2*time.Second),
-			CommandTimeout:     getEnvDurationOrDefault("COMMAND_TIMEOUT", 30*time.Second),
-			BackupDirectory:    getEnvOrDefault("BACKUP_DIR", constants.DefaultBackupDir),
-			MaxConcurrentTasks: getEnvIntOrDefault("MAX_CONCURRENT_TASKS", 5),
+			PollInterval:       30 * time.Second,
+			MaxRetries:         3,
+			RetryDelay:         2 * time.Second,
+			CommandTimeout:     30 * time.Second,
+			BackupDirectory:    constants.DefaultBackupDir,
+			MaxConcurrentTasks: 5,
 			Backoff: BackoffConfig{
-				Enabled:     getEnvBoolOrDefault("BACKOFF_ENABLED", true),
-				MaxInterval: getEnvDurationOrDefault("BACKOFF_MAX_INTERVAL", getEnvDurationOrDefault("POLL_INTERVAL", 30*time.Second)*10),
-				Multiplier:  getEnvFloatOrDefault("BACKOFF_MULTIPLIER", 2.0),
+				Enabled:    true,
+				Multiplier: 2.0,
+				// 0 means derived from the polling interval
+			},
+			OrphanSafety: OrphanSafetyConfig{
+				MaxDeletionsPerCycle: 5,
+				ConfirmCycles:        3,
+				GracePeriod:          5 * time.Minute,
+			},
+			Quarantine: QuarantineConfig{
+				Directory: constants.DefaultQuarantineDir,
+				Retention: 24 * time.Hour,
 			},
+			RuntimeDriftCheck: true,
+			ChangeDetection: ChangeDetectionConfig{
+				Enabled:            true,
+				FullResyncInterval: 5 * time.Minute,
+			},
+			Naming: NamingConfig{
+				Prefix:        constants.InterfacePrefix,
+				Template:      constants.DefaultNamingTemplate,
+				MaxInterfaces: constants.MaxInterfaces,
+			},
+			NameAllocation: NameAllocationConfig{
+				Store:      NameAllocationStoreFile,
+				File:       constants.DefaultNameAllocationFile,
+				ReuseDelay: 24 * time.Hour,
+			},
+			NodeIdentity: NodeIdentityConfig{
+				Sources: services.DefaultNodeIdentitySources(),
+			},
+			LogLevel:             logrus.InfoLevel.String(),
+			ConfigReloadInterval: 10 * time.Second,
 		},
 		Health: HealthConfig{
-			Port: getEnvOrDefault("HEALTH_PORT", constants.DefaultHealthPort),
+			Port: constants.DefaultHealthPort,
+			// 0 means derived from the polling intervals
+		},
+		Kubernetes: KubernetesConfig{
+			ReadinessGate: ReadinessGateNone,
+			NetworkAttachments: NetworkAttachmentConfig{
+				Namespace: "default",
+				CNIType:   "macvlan",
+				IPAM:      "whereabouts",
+			},
 		},
 	}
+}
 
-	// Validate configuration
-	if err := l.validate(config); err != nil {
-		return nil, err
-	}
+// applyEnvironment overrides the configuration with the environment variables that are set
+func applyEnvironment(config *Config, env *envReader) {
+	db := &config.Database
+	db.Host = env.stringValue("DB_HOST", db.Host)
+	db.Port = env.stringValue("DB_PORT", db.Port)
+	db.User = env.stringValue("DB_USER", db.User)
+	db.Password = env.stringValue("DB_PASSWORD", db.Password)
+	db.Database = env.stringValue("DB_NAME", db.Database)
+	db.MaxOpenConns = env.intValue("DB_MAX_OPEN_CONNS", db.MaxOpenConns)
+	db.MaxIdleConns = env.intValue("DB_MAX_IDLE_CONNS", db.MaxIdleConns)
+	db.MaxLifetime = env.durationValue("DB_MAX_LIFETIME", db.MaxLifetime)
+	db.DesiredNameColumn = env.stringValue("DB_DESIRED_NAME_COLUMN", db.DesiredNameColumn)
 
-	return config, nil
+	source := &config.Source
+	source.Type = env.stringValue("INTERFACE_SOURCE", source.Type)
+	metadata := &source.Metadata
+	metadata.ConfigDriveFile = env.stringValue("METADATA_CONFIG_DRIVE_FILE", metadata.ConfigDriveFile)
+	metadata.URL = env.stringValue("METADATA_URL", metadata.URL)
+	metadata.Timeout = env.durationValue("METADATA_TIMEOUT", metadata.Timeout)
+	metadata.StatusFile = env.stringValue("METADATA_STATUS_FILE", metadata.StatusFile)
+	neutron := &source.Neutron
+	neutron.AuthURL = env.stringValue("OS_AUTH_URL", neutron.AuthURL)
+	neutron.Username = env.stringValue("OS_USERNAME", neutron.Username)
+	neutron.Password = env.stringValue("OS_PASSWORD", neutron.Password)
+	neutron.UserDomainName = env.stringValue("OS_USER_DOMAIN_NAME", neutron.UserDomainName)
+	neutron.ProjectName = env.stringValue("OS_PROJECT_NAME", neutron.ProjectName)
+	neutron.ProjectDomainName = env.stringValue("OS_PROJECT_DOMAIN_NAME", neutron.ProjectDomainName)
+	neutron.RegionName = env.stringValue("OS_REGION_NAME", neutron.RegionName)
+	neutron.Interface = env.stringValue("OS_INTERFACE", neutron.Interface)
+	neutron.Endpoint = env.stringValue("NEUTRON_ENDPOINT", neutron.Endpoint)
+	neutron.DeviceID = env.stringValue("NEUTRON_DEVICE_ID", neutron.DeviceID)
+	neutron.PrimaryNetworks = env.listValue("NEUTRON_PRIMARY_NETWORKS", neutron.PrimaryNetworks)
+	neutron.Timeout = env.durationValue("NEUTRON_TIMEOUT", neutron.Timeout)
+
+	agent := &config.Agent
+	agent.PollInterval = env.durationValue("POLL_INTERVAL", agent.PollInterval)
+	agent.MaxRetries = env.intValue("MAX_RETRIES", agent.MaxRetries)
+	agent.RetryDelay = env.durationValue("RETRY_DELAY", agent.RetryDelay)
+	agent.CommandTimeout = env.durationValue("COMMAND_TIMEOUT", agent.CommandTimeout)
+	agent.BackupDirectory = env.stringValue("BACKUP_DIR", agent.BackupDirectory)
+	agent.MaxConcurrentTasks = env.intValue("MAX_CONCURRENT_TASKS", agent.MaxConcurrentTasks)
+	agent.Backoff.Enabled = env.boolValue("BACKOFF_ENABLED", agent.Backoff.Enabled)
+	agent.Backoff.MaxInterval = env.durationValue("BACKOFF_MAX_INTERVAL", agent.Backoff.MaxInterval)
+	agent.Backoff.Multiplier = env.floatValue("BACKOFF_MULTIPLIER", agent.Backoff.Multiplier)
+	safety := &agent.OrphanSafety
+	safety.MaxDeletionsPerCycle = env.intValue("ORPHAN_MAX_DELETIONS_PER_CYCLE", safety.MaxDeletionsPerCycle)
+	safety.MaxDeletionPercent = env.floatValue("ORPHAN_MAX_DELETION_PERCENT", safety.MaxDeletionPercent)
+	safety.ConfirmCycles = env.intValue("ORPHAN_CONFIRM_CYCLES", safety.ConfirmCycles)
+	safety.GracePeriod = env.durationValue("ORPHAN_GRACE_PERIOD", safety.GracePeriod)
+	agent.Quarantine.Enabled = env.boolValue("QUARANTINE_ENABLED", agent.Quarantine.Enabled)
+	agent.Quarantine.Directory = env.stringValue("QUARANTINE_DIR", agent.Quarantine.Directory)
+	agent.Quarantine.Retention = env.durationValue("QUARANTINE_RETENTION", agent.Quarantine.Retention)
+	agent.RuntimeDriftCheck = env.boolValue("RUNTIME_DRIFT_CHECK", agent.RuntimeDriftCheck)
+	agent.ChangeDetection.Enabled = env.boolValue("CHANGE_DETECTION_ENABLED", agent.ChangeDetection.Enabled)
+	agent.ChangeDetection.FullResyncInterval = env.durationValue("FULL_RESYNC_INTERVAL", agent.ChangeDetection.FullResyncInterval)
+	agent.Naming.Prefix = env.stringValue("INTERFACE_PREFIX", agent.Naming.Prefix)
+	agent.Naming.Template = env.stringValue("INTERFACE_NAME_TEMPLATE", agent.Naming.Template)
+	agent.Naming.MaxInterfaces = env.intValue("MAX_INTERFACES", agent.Naming.MaxInterfaces)
+	agent.NameAllocation.Store = env.stringValue("NAME_ALLOCATION_STORE", agent.NameAllocation.Store)
+	agent.NameAllocation.File = env.stringValue("NAME_ALLOCATION_FILE", agent.NameAllocation.File)
+	agent.NameAllocation.ReuseDelay = env.durationValue("NAME_REUSE_DELAY", agent.NameAllocation.ReuseDelay)
+	agent.LinkCleanup.RestoreKernelName = env.boolValue("LINK_CLEANUP_RESTORE_NAME", agent.LinkCleanup.RestoreKernelName)
+	agent.NodeIdentity.Sources = env.listValue("NODE_IDENTITY_SOURCES", agent.NodeIdentity.Sources)
+	agent.NodeIdentity.Mapping = env.listValue("NODE_IDENTITY_MAPPING", agent.NodeIdentity.Mapping)
+	agent.AgentID = env.stringValue("AGENT_ID", agent.AgentID)
+	agent.DryRun = env.boolValue("DRY_RUN", agent.DryRun)
+	agent.LogLevel = env.logLevelValue("LOG_LEVEL", agent.LogLevel)
+	agent.ConfigReloadInterval = env.durationValue("CONFIG_RELOAD_INTERVAL", agent.ConfigReloadInterval)
+
+	health := &config.Health
+	health.Port = env.stringValue("HEALTH_PORT", health.Port)
+	health.AdminToken = env.stringValue("ADMIN_TOKEN", health.AdminToken)
+	health.LivenessStaleAfter = env.durationValue("HEALTH_LIVENESS_STALE_AFTER", health.LivenessStaleAfter)
+	health.ReadinessDBGrace = env.durationValue("HEALTH_READINESS_DB_GRACE", health.ReadinessDBGrace)
+
+	kubernetes := &config.Kubernetes
+	kubernetes.NodeReporting = env.boolValue("NODE_REPORTING_ENABLED", kubernetes.NodeReporting)
+	kubernetes.NodeName = env.stringValue("NODE_NAME", kubernetes.NodeName)
+	kubernetes.ReadinessGate = env.stringValue("NODE_READINESS_GATE", kubernetes.ReadinessGate)
+	nad := &kubernetes.NetworkAttachments
+	nad.Enabled = env.boolValue("MULTUS_NAD_ENABLED", nad.Enabled)
+	nad.Namespace = env.stringValue("MULTUS_NAD_NAMESPACE", nad.Namespace)
+	nad.CNIType = env.stringValue("MULTUS_CNI_TYPE", nad.CNIType)
+	nad.IPAM = env.stringValue("MULTUS_IPAM", nad.IPAM)
 }
 
-// validate validates the configuration
-func (l *EnvironmentConfigLoader) validate(config *Config) error {
+// applyDerivedDefaults fills in the settings whose defaults depend on other settings
+func applyDerivedDefaults(config *Config) {
+	if config.Agent.Backoff.MaxInterval == 0 {
+		config.Agent.Backoff.MaxInterval = config.Agent.PollInterval * 10
+	}
+	if config.Health.LivenessStaleAfter == 0 {
+		config.Health.LivenessStaleAfter = defaultLivenessStaleAfter(config.Agent)
+	}
+}
+
+// validateConfig validates the configuration
+func validateConfig(config *Config) error {
 	// Validate database configuration
 	if config.Database.Host == "" {
 		return errors.NewValidationError("database host not configured", nil)
@@ -117,6 +437,47 @@ func (l *EnvironmentConfigLoader) validate(config *Config) error {
 	if config.Database.Database == "" {
 		return errors.NewValidationError("database name not configured", nil)
 	}
+	if column := config.Database.DesiredNameColumn; column != "" && !sqlIdentifierRegex.MatchString(column) {
+		return errors.NewValidationError("desired name column must be a plain SQL identifier", nil)
+	}
+
+	// Validate interface source configuration
+	switch config.Source.Type {
+	case InterfaceSourceDB, "":
+	case InterfaceSourceOpenStackMetadata:
+		metadata := config.Source.Metadata
+		if metadata.ConfigDriveFile == "" && metadata.URL == "" {
+			return errors.NewValidationError("metadata source requires a config drive file or a metadata URL", nil)
+		}
+		if metadata.Timeout <= 0 {
+			return errors.NewValidationError("invalid metadata request timeout", nil)
+		}
+		if metadata.StatusFile == "" {
+			return errors.NewValidationError("metadata interface status file not configured", nil)
+		}
+	case InterfaceSourceNeutron:
+		neutron := config.Source.Neutron
+		if neutron.AuthURL == "" || neutron.Username == "" || neutron.Password == "" || neutron.ProjectName == "" {
+			return errors.NewValidationError("Neutron source requires OS_AUTH_URL, OS_USERNAME, OS_PASSWORD and OS_PROJECT_NAME", nil)
+		}
+		// Without it the primary NIC would be renamed and reconfigured like a secondary interface
+		if len(neutron.PrimaryNetworks) == 0 {
+			return errors.NewValidationError("Neutron source requires the primary networks to exclude", nil)
+		}
+		switch neutron.Interface {
+		case "public", "internal", "admin":
+		default:
+			return errors.NewValidationError("OpenStack endpoint interface must be one of public, internal or admin", nil)
+		}
+		if neutron.Timeout <= 0 {
+			return errors.NewValidationError("invalid Neutron request timeout", nil)
+		}
+	default:
+		return errors.NewValidationError("interface source must be one of db, openstack-metadata or openstack-neutron", nil)
+	}
+	if source := config.Source.Type; source != InterfaceSourceDB && source != "" && config.Agent.NameAllocation.Store == NameAllocationStoreDB {
+		return errors.NewValidationError("name allocation store db requires the db interface source", nil)
+	}
 
 	// Validate agent configuration
 	if config.Agent.PollInterval <= 0 {
@@ -125,56 +486,225 @@ func (l *EnvironmentConfigLoader) validate(config *Config) error {
 	if config.Agent.MaxRetries < 0 {
 		return errors.NewValidationError("invalid max retry count", nil)
 	}
+	if level := config.Agent.LogLevel; level != "" {
+		if _, err := logrus.ParseLevel(level); err != nil {
+			return errors.NewValidationError("log level must be one of panic, fatal, error, warn, info, debug or trace", err)
+		}
+	}
+	if config.Agent.ConfigReloadInterval < 0 {
+		return errors.NewValidationError("invalid config reload interval", nil)
+	}
+
+	// Validate orphan deletion safety configuration
+	safety := config.Agent.OrphanSafety
+	if safety.MaxDeletionsPerCycle < 0 {
+		return errors.NewValidationError("invalid orphan max deletions per cycle", nil)
+	}
+	if safety.MaxDeletionPercent < 0 || safety.MaxDeletionPercent > 100 {
+		return errors.NewValidationError("orphan max deletion percent must be between 0 and 100", nil)
+	}
+	if safety.ConfirmCycles < 0 {
+		return errors.NewValidationError("invalid orphan confirm cycles", nil)
+	}
+	if safety.GracePeriod < 0 {
+		return errors.NewValidationError("invalid orphan grace period", nil)
+	}
+
+	// Validate quarantine configuration
+	if config.Agent.Quarantine.Enabled {
+		if config.Agent.Quarantine.Directory == "" {
+			return errors.NewValidationError("quarantine directory not configured", nil)
+		}
+		if config.Agent.Quarantine.Retention <= 0 {
+			return errors.NewValidationError("invalid quarantine retention period", nil)
+		}
+	}
+
+	// Validate change detection configuration
+	if detection := config.Agent.ChangeDetection; detection.Enabled && detection.FullResyncInterval <= 0 {
+		return errors.NewValidationError("invalid full resync interval", nil)
+	}
+
+	// Validate node identity configuration
+	identity := config.Agent.NodeIdentity
+	usesMapping := false
+	for _, source := range identity.Sources {
+		if !services.IsNodeIdentitySource(source) {
+			return errors.NewValidationError("node identity source must be one of node-name, hostname, instance-uuid or mapping", nil)
+		}
+		usesMapping = usesMapping || source == services.NodeIdentitySourceMapping
+	}
+	if _, err := services.ParseNodeIdentityMapping(identity.Mapping); err != nil {
+		return err
+	}
+	if usesMapping && len(identity.Mapping) == 0 {
+		return errors.NewValidationError("node identity source mapping requires NODE_IDENTITY_MAPPING", nil)
+	}
+
+	// Validate interface naming scheme
+	naming := config.Agent.Naming
+	if _, err := entities.NewNamingScheme(naming.Prefix, naming.Template, naming.MaxInterfaces); err != nil {
+		return errors.NewValidationError("invalid interface naming scheme", err)
+	}
+
+	// Validate name allocation configuration
+	allocation := config.Agent.NameAllocation
+	switch allocation.Store {
+	case NameAllocationStoreFile:
+		if allocation.File == "" {
+			return errors.NewValidationError("name allocation file not configured", nil)
+		}
+	case NameAllocationStoreDB, NameAllocationStoreNone, "":
+	default:
+		return errors.NewValidationError("name allocation store must be one of file, db or none", nil)
+	}
+	if allocation.ReuseDelay < 0 {
+		return errors.NewValidationError("invalid name reuse delay", nil)
+	}
 
 	// Validate health check configuration
 	if config.Health.Port == "" {
 		return errors.NewValidationError("health check port not configured", nil)
 	}
+	if config.Health.LivenessStaleAfter < 0 {
+		return errors.NewValidationError("invalid liveness stale threshold", nil)
+	}
+	if config.Health.ReadinessDBGrace < 0 {
+		return errors.NewValidationError("invalid readiness database grace period", nil)
+	}
+	if token := config.Health.AdminToken; token != "" && len(token) < minAdminTokenLength {
+		return errors.NewValidationError("admin token must be at least 16 characters", nil)
+	}
+
+	// Validate node readiness gate mode
+	switch config.Kubernetes.ReadinessGate {
+	case ReadinessGateNone, ReadinessGateTaint, ReadinessGateLabel, "":
+	default:
+		return errors.NewValidationError("node readiness gate must be one of none, taint or label", nil)
+	}
+
+	// Validate network attachment definition publishing
+	if nad := config.Kubernetes.NetworkAttachments; nad.Enabled {
+		if nad.Namespace == "" {
+			return errors.NewValidationError("network attachment definition namespace not configured", nil)
+		}
+		switch nad.CNIType {
+		case "macvlan", "ipvlan", "host-device":
+		default:
+			return errors.NewValidationError("Multus CNI type must be one of macvlan, ipvlan or host-device", nil)
+		}
+		switch nad.IPAM {
+		case "whereabouts", "host-local", "static", "dhcp", "none":
+		default:
+			return errors.NewValidationError("Multus IPAM mode must be one of whereabouts, host-local, static, dhcp or none", nil)
+		}
+	}
 
 	return nil
 }
 
-// Environment variable helper functions
+// defaultLivenessStaleAfter allows three of the longest polling intervals without loop progress
+func defaultLivenessStaleAfter(agent AgentConfig) time.Duration {
+	interval := agent.PollInterval
+	if agent.Backoff.Enabled && agent.Backoff.MaxInterval > interval {
+		interval = agent.Backoff.MaxInterval
+	}
+	if stale := 3 * interval; stale > minLivenessStaleAfter {
+		return stale
+	}
+	return minLivenessStaleAfter
+}
 
-func getEnvOrDefault(key, defaultValue string) string {
+// envReader reads settings from environment variables, keeping the current value for unset variables
+// Malformed variables also keep the current value and are recorded, so that the loader can reject them all at once
+type envReader struct {
+	errs []string
+}
+
+// invalid records a malformed environment variable
+func (r *envReader) invalid(key, value string, err error) {
+	r.errs = append(r.errs, fmt.Sprintf("%s=%q: %v", key, value, err))
+}
+
+// err returns the malformed environment variables as a validation error
+func (r *envReader) err() error {
+	if len(r.errs) == 0 {
+		return nil
+	}
+	return errors.NewValidationError("invalid environment variables: "+strings.Join(r.errs, "; "), nil)
+}
+
+func (r *envReader) stringValue(key, current string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
 	}
-	return defaultValue
+	return current
 }
 
-func getEnvIntOrDefault(key string, defaultValue int) int {
+func (r *envReader) listValue(key string, current []string) []string {
 	if value := os.Getenv(key); value != "" {
-		if intValue, err := strconv.Atoi(value); err == nil {
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		return items
+	}
+	return current
+}
+
+func (r *envReader) intValue(key string, current int) int {
+	if value := os.Getenv(key); value != "" {
+		intValue, err := strconv.Atoi(value)
+		if err == nil {
 			return intValue
 		}
+		r.invalid(key, value, err)
 	}
-	return defaultValue
+	return current
 }
 
-func getEnvDurationOrDefault(key string, defaultValue time.Duration) time.Duration {
+func (r *envReader) durationValue(key string, current time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
-		if duration, err := time.ParseDuration(value); err == nil {
+		duration, err := time.ParseDuration(value)
+		if err == nil {
 			return duration
 		}
+		r.invalid(key, value, err)
 	}
-	return defaultValue
+	return current
 }
 
-func getEnvBoolOrDefault(key string, defaultValue bool) bool {
+func (r *envReader) boolValue(key string, current bool) bool {
 	if value := os.Getenv(key); value != "" {
-		if boolValue, err := strconv.ParseBool(value); err == nil {
+		boolValue, err := strconv.ParseBool(value)
+		if err == nil {
 			return boolValue
 		}
+		r.invalid(key, value, err)
 	}
-	return defaultValue
+	return current
 }
 
-func getEnvFloatOrDefault(key string, defaultValue float64) float64 {
+func (r *envReader) floatValue(key string, current float64) float64 {
 	if value := os.Getenv(key); value != "" {
-		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+		floatValue, err := strconv.ParseFloat(value, 64)
+		if err == nil {
 			return floatValue
 		}
+		r.invalid(key, value, err)
+	}
+	return current
+}
+
+func (r *envReader) logLevelValue(key, current string) string {
+	if value := os.Getenv(key); value != "" {
+		if _, err := logrus.ParseLevel(value); err != nil {
+			r.invalid(key, value, err)
+			return current
+		}
+		return value
 	}
-	return defaultValue
+	return current
 }
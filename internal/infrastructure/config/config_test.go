@@ -2,9 +2,13 @@ package config
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"multinic-agent/internal/domain/errors"
+
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -20,6 +24,15 @@ func TestEnvironmentConfigLoader_Load(t *testing.T) {
 		"POLL_INTERVAL": os.Getenv("POLL_INTERVAL"),
 		"HEALTH_PORT":   os.Getenv("HEALTH_PORT"),
 		"BACKUP_DIR":    os.Getenv("BACKUP_DIR"),
+
+		"ORPHAN_MAX_DELETIONS_PER_CYCLE": os.Getenv("ORPHAN_MAX_DELETIONS_PER_CYCLE"),
+		"ORPHAN_MAX_DELETION_PERCENT":    os.Getenv("ORPHAN_MAX_DELETION_PERCENT"),
+		"ORPHAN_CONFIRM_CYCLES":          os.Getenv("ORPHAN_CONFIRM_CYCLES"),
+		"ORPHAN_GRACE_PERIOD":            os.Getenv("ORPHAN_GRACE_PERIOD"),
+
+		"BACKOFF_MAX_INTERVAL":        os.Getenv("BACKOFF_MAX_INTERVAL"),
+		"HEALTH_LIVENESS_STALE_AFTER": os.Getenv("HEALTH_LIVENESS_STALE_AFTER"),
+		"HEALTH_READINESS_DB_GRACE":   os.Getenv("HEALTH_READINESS_DB_GRACE"),
 	}
 
 	// 테스트 후 환경 변수 복원
@@ -54,6 +67,10 @@ func TestEnvironmentConfigLoader_Load(t *testing.T) {
 				assert.Equal(t, "multinic", cfg.Database.Database)
 				assert.Equal(t, 30*time.Second, cfg.Agent.PollInterval)
 				assert.Equal(t, "8080", cfg.Health.Port)
+				// 기본 백오프 최대 간격(5분)의 3배
+				assert.Equal(t, 15*time.Minute, cfg.Health.LivenessStaleAfter)
+				// NODE_NAME을 먼저 사용하고 없으면 호스트네임 사용
+				assert.Equal(t, []string{"node-name", "hostname"}, cfg.Agent.NodeIdentity.Sources)
 			},
 		},
 		{
@@ -85,12 +102,172 @@ func TestEnvironmentConfigLoader_Load(t *testing.T) {
 			envVars: map[string]string{
 				"POLL_INTERVAL": "invalid-duration",
 			},
+			wantError: true,
+		},
+		{
+			name: "유효하지 않은 정수 형식",
+			envVars: map[string]string{
+				"MAX_RETRIES": "abc",
+			},
+			wantError: true,
+		},
+		{
+			name: "고아 삭제 안전 규칙 설정",
+			envVars: map[string]string{
+				"ORPHAN_MAX_DELETIONS_PER_CYCLE": "2",
+				"ORPHAN_MAX_DELETION_PERCENT":    "50",
+				"ORPHAN_CONFIRM_CYCLES":          "3",
+				"ORPHAN_GRACE_PERIOD":            "10m",
+			},
 			wantError: false,
 			validate: func(t *testing.T, cfg *Config) {
-				// 잘못된 형식일 때는 기본값 사용
-				assert.Equal(t, 30*time.Second, cfg.Agent.PollInterval)
+				assert.Equal(t, 2, cfg.Agent.OrphanSafety.MaxDeletionsPerCycle)
+				assert.Equal(t, 50.0, cfg.Agent.OrphanSafety.MaxDeletionPercent)
+				assert.Equal(t, 3, cfg.Agent.OrphanSafety.ConfirmCycles)
+				assert.Equal(t, 10*time.Minute, cfg.Agent.OrphanSafety.GracePeriod)
 			},
 		},
+		{
+			name: "liveness 기준은 가장 긴 폴링 간격에서 유도",
+			envVars: map[string]string{
+				"BACKOFF_MAX_INTERVAL": "10m",
+			},
+			wantError: false,
+			validate: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, 30*time.Minute, cfg.Health.LivenessStaleAfter)
+				assert.Equal(t, time.Duration(0), cfg.Health.ReadinessDBGrace)
+			},
+		},
+		{
+			name: "liveness/readiness 기준 설정",
+			envVars: map[string]string{
+				"HEALTH_LIVENESS_STALE_AFTER": "20m",
+				"HEALTH_READINESS_DB_GRACE":   "2m",
+			},
+			wantError: false,
+			validate: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, 20*time.Minute, cfg.Health.LivenessStaleAfter)
+				assert.Equal(t, 2*time.Minute, cfg.Health.ReadinessDBGrace)
+			},
+		},
+		{
+			name: "잘못된 고아 삭제 비율",
+			envVars: map[string]string{
+				"ORPHAN_MAX_DELETION_PERCENT": "150",
+			},
+			wantError: true,
+		},
+		{
+			name: "알 수 없는 노드 준비 상태 게이트",
+			envVars: map[string]string{
+				"NODE_READINESS_GATE": "cordon",
+			},
+			wantError: true,
+		},
+		{
+			name: "지원하지 않는 Multus IPAM 모드",
+			envVars: map[string]string{
+				"MULTUS_NAD_ENABLED": "true",
+				"MULTUS_IPAM":        "calico-ipam",
+			},
+			wantError: true,
+		},
+		{
+			name: "OpenStack 메타데이터 인터페이스 소스",
+			envVars: map[string]string{
+				"INTERFACE_SOURCE":           "openstack-metadata",
+				"METADATA_CONFIG_DRIVE_FILE": "/host/mnt/config/openstack/latest/network_data.json",
+			},
+			wantError: false,
+			validate: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, InterfaceSourceOpenStackMetadata, cfg.Source.Type)
+				assert.Equal(t, "http://169.254.169.254/openstack/latest/network_data.json", cfg.Source.Metadata.URL)
+				assert.Equal(t, "/var/lib/multinic/metadata-status.json", cfg.Source.Metadata.StatusFile)
+			},
+		},
+		{
+			name: "메타데이터 소스에서 DB 이름 할당 저장소 사용",
+			envVars: map[string]string{
+				"INTERFACE_SOURCE":      "openstack-metadata",
+				"NAME_ALLOCATION_STORE": "db",
+			},
+			wantError: true,
+		},
+		{
+			name: "Neutron 포트 인터페이스 소스",
+			envVars: map[string]string{
+				"INTERFACE_SOURCE":         "openstack-neutron",
+				"OS_AUTH_URL":              "https://keystone.example.com:5000/v3",
+				"OS_USERNAME":              "multinic-agent",
+				"OS_PASSWORD":              "secret",
+				"OS_PROJECT_NAME":          "infra",
+				"NEUTRON_PRIMARY_NETWORKS": "provider, 3f6c0a1e-mgmt",
+			},
+			wantError: false,
+			validate: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, []string{"provider", "3f6c0a1e-mgmt"}, cfg.Source.Neutron.PrimaryNetworks)
+				assert.Equal(t, "Default", cfg.Source.Neutron.UserDomainName)
+				assert.Equal(t, "public", cfg.Source.Neutron.Interface)
+			},
+		},
+		{
+			name: "기본 네트워크 없는 Neutron 소스",
+			envVars: map[string]string{
+				"INTERFACE_SOURCE": "openstack-neutron",
+				"OS_AUTH_URL":      "https://keystone.example.com:5000/v3",
+				"OS_USERNAME":      "multinic-agent",
+				"OS_PASSWORD":      "secret",
+				"OS_PROJECT_NAME":  "infra",
+			},
+			wantError: true,
+		},
+		{
+			name: "잘못된 전체 재동기화 주기",
+			envVars: map[string]string{
+				"FULL_RESYNC_INTERVAL": "-1m",
+			},
+			wantError: true,
+		},
+		{
+			name: "노드 식별자 출처와 매핑",
+			envVars: map[string]string{
+				"NODE_IDENTITY_SOURCES": "mapping, node-name",
+				"NODE_IDENTITY_MAPPING": "vm-1=compute-01,6a1f3c2e-0000-4b5e-9c1d-2f3a4b5c6d7e=compute-02",
+			},
+			wantError: false,
+			validate: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, []string{"mapping", "node-name"}, cfg.Agent.NodeIdentity.Sources)
+				assert.Len(t, cfg.Agent.NodeIdentity.Mapping, 2)
+			},
+		},
+		{
+			name: "알 수 없는 노드 식별자 출처",
+			envVars: map[string]string{
+				"NODE_IDENTITY_SOURCES": "node-name,fqdn",
+			},
+			wantError: true,
+		},
+		{
+			name: "매핑 없이 mapping 출처 사용",
+			envVars: map[string]string{
+				"NODE_IDENTITY_SOURCES": "mapping",
+			},
+			wantError: true,
+		},
+		{
+			name: "잘못된 노드 식별자 매핑 항목",
+			envVars: map[string]string{
+				"NODE_IDENTITY_MAPPING": "vm-1",
+			},
+			wantError: true,
+		},
+		{
+			name: "알 수 없는 인터페이스 소스",
+			envVars: map[string]string{
+				"INTERFACE_SOURCE": "consul",
+			},
+			wantError: true,
+		},
 		{
 			name: "빈 DB_HOST로 유효성 검증 실패",
 			envVars: map[string]string{
@@ -105,13 +282,14 @@ func TestEnvironmentConfigLoader_Load(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// 환경 변수 설정
+			// 환경 변수 설정 (다음 케이스에 영향이 없도록 종료 시 해제)
 			for key, value := range tt.envVars {
 				if value == "" {
 					os.Unsetenv(key)
 				} else {
 					os.Setenv(key, value)
 				}
+				defer os.Unsetenv(key)
 			}
 
 			loader := NewEnvironmentConfigLoader()
@@ -150,6 +328,27 @@ func TestEnvironmentConfigLoader_validate(t *testing.T) {
 				Agent: AgentConfig{
 					PollInterval: 30 * time.Second,
 					MaxRetries:   3,
+					Naming:       NamingConfig{Prefix: "multinic", Template: "{prefix}{index}", MaxInterfaces: 10},
+				},
+				Health: HealthConfig{
+					Port: "8080",
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "10개 초과 인터페이스 이름 규칙",
+			config: &Config{
+				Database: DatabaseConfig{
+					Host:     "localhost",
+					Port:     "5432",
+					User:     "user",
+					Password: "pass",
+					Database: "db",
+				},
+				Agent: AgentConfig{
+					PollInterval: 30 * time.Second,
+					Naming:       NamingConfig{Prefix: "nfv", Template: "{prefix}{network}{index}", MaxInterfaces: 32},
 				},
 				Health: HealthConfig{
 					Port: "8080",
@@ -157,6 +356,68 @@ func TestEnvironmentConfigLoader_validate(t *testing.T) {
 			},
 			wantError: false,
 		},
+		{
+			name: "IFNAMSIZ를 초과하는 이름 규칙",
+			config: &Config{
+				Database: DatabaseConfig{
+					Host:     "localhost",
+					Port:     "5432",
+					User:     "user",
+					Password: "pass",
+					Database: "db",
+				},
+				Agent: AgentConfig{
+					PollInterval: 30 * time.Second,
+					Naming:       NamingConfig{Prefix: "multinic-secondary", Template: "{prefix}{index}", MaxInterfaces: 10},
+				},
+				Health: HealthConfig{
+					Port: "8080",
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "SQL 식별자가 아닌 희망 이름 컬럼",
+			config: &Config{
+				Database: DatabaseConfig{
+					Host:              "localhost",
+					Port:              "5432",
+					User:              "user",
+					Password:          "pass",
+					Database:          "db",
+					DesiredNameColumn: "name; DROP TABLE multi_interface",
+				},
+				Agent: AgentConfig{
+					PollInterval: 30 * time.Second,
+					Naming:       NamingConfig{Prefix: "multinic", Template: "{prefix}{index}", MaxInterfaces: 10},
+				},
+				Health: HealthConfig{
+					Port: "8080",
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "너무 짧은 관리 API 토큰",
+			config: &Config{
+				Database: DatabaseConfig{
+					Host:     "localhost",
+					Port:     "5432",
+					User:     "user",
+					Password: "pass",
+					Database: "db",
+				},
+				Agent: AgentConfig{
+					PollInterval: 30 * time.Second,
+					Naming:       NamingConfig{Prefix: "multinic", Template: "{prefix}{index}", MaxInterfaces: 10},
+				},
+				Health: HealthConfig{
+					Port:       "8080",
+					AdminToken: "secret",
+				},
+			},
+			wantError: true,
+		},
 		{
 			name: "빈 DB 호스트",
 			config: &Config{
@@ -229,57 +490,227 @@ func TestEnvironmentConfigLoader_validate(t *testing.T) {
 	}
 }
 
-func TestGetEnvHelpers(t *testing.T) {
-	t.Run("getEnvOrDefault", func(t *testing.T) {
+func TestFileConfigLoader_Load(t *testing.T) {
+	tests := []struct {
+		name      string
+		file      string
+		envVars   map[string]string
+		wantError string
+		validate  func(*testing.T, *Config)
+	}{
+		{
+			name: "파일 설정 적용",
+			file: `
+database:
+  host: db.example
+agent:
+  pollInterval: 1m
+  logLevel: debug
+  nodeIdentity:
+    sources: [hostname]
+`,
+			validate: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, "db.example", cfg.Database.Host)
+				assert.Equal(t, "3306", cfg.Database.Port) // 파일에 없는 값은 기본값
+				assert.Equal(t, time.Minute, cfg.Agent.PollInterval)
+				assert.Equal(t, 10*time.Minute, cfg.Agent.Backoff.MaxInterval) // 폴링 간격에서 유도
+				assert.Equal(t, "debug", cfg.Agent.LogLevel)
+				assert.Equal(t, []string{"hostname"}, cfg.Agent.NodeIdentity.Sources)
+			},
+		},
+		{
+			name:    "환경 변수가 파일보다 우선",
+			file:    "agent:\n  pollInterval: 1m\n",
+			envVars: map[string]string{"POLL_INTERVAL": "45s"},
+			validate: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, 45*time.Second, cfg.Agent.PollInterval)
+			},
+		},
+		{
+			name: "빈 파일은 기본값 사용",
+			file: "",
+			validate: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, 30*time.Second, cfg.Agent.PollInterval)
+			},
+		},
+		{
+			name:      "알 수 없는 키",
+			file:      "agent:\n  pollIntervl: 1m\n",
+			wantError: "pollIntervl",
+		},
+		{
+			name:      "단위 없는 시간 값",
+			file:      "agent:\n  pollInterval: 30\n",
+			wantError: "time.Duration",
+		},
+		{
+			name:      "잘못된 환경 변수",
+			file:      "agent:\n  pollInterval: 1m\n",
+			envVars:   map[string]string{"MAX_RETRIES": "abc"},
+			wantError: "MAX_RETRIES",
+		},
+		{
+			name:      "잘못된 로그 레벨",
+			file:      "agent:\n  logLevel: loud\n",
+			wantError: "log level",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.envVars {
+				t.Setenv(key, value)
+			}
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.file), 0o644))
+
+			cfg, err := NewFileConfigLoader(path).Load()
+
+			if tt.wantError != "" {
+				require.Error(t, err)
+				assert.True(t, errors.IsValidationError(err))
+				assert.Contains(t, err.Error(), tt.wantError)
+				assert.Nil(t, cfg)
+				return
+			}
+			require.NoError(t, err)
+			tt.validate(t, cfg)
+		})
+	}
+
+	t.Run("파일 없음", func(t *testing.T) {
+		_, err := NewFileConfigLoader(filepath.Join(t.TempDir(), "missing.yaml")).Load()
+		assert.Error(t, err)
+	})
+}
+
+func TestWatcher_check(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	write := func(content string) {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	write("agent:\n  pollInterval: 30s\n  maxConcurrentTasks: 5\n")
+
+	loader := NewFileConfigLoader(path)
+	current, err := loader.Load()
+	require.NoError(t, err)
+	watcher := NewWatcher(loader, path, time.Second, logger)
+
+	// 재로드 가능한 설정 변경이 없으면 적용하지 않음
+	_, changed := watcher.check(current)
+	assert.False(t, changed)
+
+	// 재로드 가능한 설정만 반영하고 재시작이 필요한 설정은 유지
+	write("database:\n  host: db.example\nagent:\n  pollInterval: 1m\n  maxConcurrentTasks: 8\n  logLevel: debug\n")
+	next, changed := watcher.check(current)
+	require.True(t, changed)
+	assert.Equal(t, time.Minute, next.Agent.PollInterval)
+	assert.Equal(t, 10*time.Minute, next.Agent.Backoff.MaxInterval)
+	assert.Equal(t, 8, next.Agent.MaxConcurrentTasks)
+	assert.Equal(t, "debug", next.Agent.LogLevel)
+	assert.Equal(t, "localhost", next.Database.Host)
+	assert.Equal(t, []string{"database.host"}, changedSettings(next, mustLoad(t, loader)))
+	current = next
+
+	// 내용이 같으면 다시 읽지 않음
+	_, changed = watcher.check(current)
+	assert.False(t, changed)
+
+	// 잘못된 파일은 무시하고 현재 설정 유지
+	write("agent:\n  maxConcurrentTasks: many\n")
+	kept, changed := watcher.check(current)
+	assert.False(t, changed)
+	assert.Same(t, current, kept)
+}
+
+func mustLoad(t *testing.T, loader ConfigLoader) *Config {
+	cfg, err := loader.Load()
+	require.NoError(t, err)
+	return cfg
+}
+
+func TestEnvReader(t *testing.T) {
+	t.Run("stringValue", func(t *testing.T) {
+		env := &envReader{}
+
 		// 존재하지 않는 환경 변수
-		result := getEnvOrDefault("NON_EXISTENT_VAR", "default")
+		result := env.stringValue("NON_EXISTENT_VAR", "default")
 		assert.Equal(t, "default", result)
 
 		// 존재하는 환경 변수
 		os.Setenv("TEST_VAR", "test_value")
 		defer os.Unsetenv("TEST_VAR")
 
-		result = getEnvOrDefault("TEST_VAR", "default")
+		result = env.stringValue("TEST_VAR", "default")
 		assert.Equal(t, "test_value", result)
 	})
 
-	t.Run("getEnvIntOrDefault", func(t *testing.T) {
+	t.Run("intValue", func(t *testing.T) {
+		env := &envReader{}
+
 		// 존재하지 않는 환경 변수
-		result := getEnvIntOrDefault("NON_EXISTENT_INT", 42)
+		result := env.intValue("NON_EXISTENT_INT", 42)
 		assert.Equal(t, 42, result)
 
 		// 유효한 정수
 		os.Setenv("TEST_INT", "123")
 		defer os.Unsetenv("TEST_INT")
 
-		result = getEnvIntOrDefault("TEST_INT", 42)
+		result = env.intValue("TEST_INT", 42)
 		assert.Equal(t, 123, result)
 
 		// 잘못된 정수 형식
 		os.Setenv("TEST_BAD_INT", "not_a_number")
 		defer os.Unsetenv("TEST_BAD_INT")
 
-		result = getEnvIntOrDefault("TEST_BAD_INT", 42)
+		result = env.intValue("TEST_BAD_INT", 42)
 		assert.Equal(t, 42, result)
+		assert.Error(t, env.err())
 	})
 
-	t.Run("getEnvDurationOrDefault", func(t *testing.T) {
+	t.Run("durationValue", func(t *testing.T) {
+		env := &envReader{}
+
 		// 존재하지 않는 환경 변수
-		result := getEnvDurationOrDefault("NON_EXISTENT_DURATION", 30*time.Second)
+		result := env.durationValue("NON_EXISTENT_DURATION", 30*time.Second)
 		assert.Equal(t, 30*time.Second, result)
 
 		// 유효한 duration
 		os.Setenv("TEST_DURATION", "1m30s")
 		defer os.Unsetenv("TEST_DURATION")
 
-		result = getEnvDurationOrDefault("TEST_DURATION", 30*time.Second)
+		result = env.durationValue("TEST_DURATION", 30*time.Second)
 		assert.Equal(t, 90*time.Second, result)
 
 		// 잘못된 duration 형식
 		os.Setenv("TEST_BAD_DURATION", "invalid")
 		defer os.Unsetenv("TEST_BAD_DURATION")
 
-		result = getEnvDurationOrDefault("TEST_BAD_DURATION", 30*time.Second)
+		result = env.durationValue("TEST_BAD_DURATION", 30*time.Second)
 		assert.Equal(t, 30*time.Second, result)
 	})
+
+	t.Run("잘못된 값은 에러로 기록", func(t *testing.T) {
+		env := &envReader{}
+
+		os.Setenv("TEST_BAD_INT", "abc")
+		os.Setenv("TEST_BAD_BOOL", "maybe")
+		os.Setenv("TEST_BAD_LEVEL", "loud")
+		defer os.Unsetenv("TEST_BAD_INT")
+		defer os.Unsetenv("TEST_BAD_BOOL")
+		defer os.Unsetenv("TEST_BAD_LEVEL")
+
+		assert.Equal(t, 3, env.intValue("TEST_BAD_INT", 3))
+		assert.True(t, env.boolValue("TEST_BAD_BOOL", true))
+		assert.Equal(t, "info", env.logLevelValue("TEST_BAD_LEVEL", "info"))
+
+		err := env.err()
+		assert.True(t, errors.IsValidationError(err))
+		assert.Contains(t, err.Error(), "TEST_BAD_INT")
+		assert.Contains(t, err.Error(), "TEST_BAD_BOOL")
+		assert.Contains(t, err.Error(), "TEST_BAD_LEVEL")
+	})
 }
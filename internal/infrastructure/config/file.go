@@ -0,0 +1,59 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"multinic-agent/internal/domain/errors"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileConfigLoader is an implementation that loads configuration from a YAML file
+// Environment variables that are set override the file. Unknown keys and malformed
+// values, in the file or in the environment, are validation errors instead of being ignored
+type FileConfigLoader struct {
+	path string
+}
+
+// NewFileConfigLoader creates a new FileConfigLoader
+func NewFileConfigLoader(path string) ConfigLoader {
+	return &FileConfigLoader{path: path}
+}
+
+// Load loads configuration from the file and the environment
+func (l *FileConfigLoader) Load() (*Config, error) {
+	content, err := os.ReadFile(l.path)
+	if err != nil {
+		return nil, errors.NewSystemError(fmt.Sprintf("failed to read config file %s", l.path), err)
+	}
+
+	config := defaultConfig()
+	if err := decodeConfigFile(content, config); err != nil {
+		return nil, errors.NewValidationError(fmt.Sprintf("invalid config file %s", l.path), err)
+	}
+
+	env := &envReader{}
+	applyEnvironment(config, env)
+	if err := env.err(); err != nil {
+		return nil, err
+	}
+	applyDerivedDefaults(config)
+
+	if err := validateConfig(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// decodeConfigFile decodes a YAML config file over the given configuration, rejecting unknown keys
+// Settings missing from the file keep their current values, and an empty file changes nothing
+func decodeConfigFile(content []byte, config *Config) error {
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(config); err != nil && err != io.EOF {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package config
+
+import (
+	"context"
+	"crypto/sha256"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// applyReloadable copies the settings that can change without restarting the agent
+// (polling interval and backoff, log level, concurrency and probe thresholds) from src to dst
+func applyReloadable(dst, src *Config) {
+	dst.Agent.PollInterval = src.Agent.PollInterval
+	dst.Agent.Backoff = src.Agent.Backoff
+	dst.Agent.LogLevel = src.Agent.LogLevel
+	dst.Agent.MaxConcurrentTasks = src.Agent.MaxConcurrentTasks
+	dst.Health.LivenessStaleAfter = src.Health.LivenessStaleAfter
+	dst.Health.ReadinessDBGrace = src.Health.ReadinessDBGrace
+}
+
+// Watcher reloads a config file when its content changes and hands the reloadable settings to the agent
+// A mounted ConfigMap is updated by swapping a symlink, so the file content is polled rather than watched for events
+type Watcher struct {
+	loader   ConfigLoader
+	path     string
+	interval time.Duration
+	logger   *logrus.Logger
+	checksum [sha256.Size]byte
+}
+
+// NewWatcher creates a new Watcher for the file the loader reads
+func NewWatcher(loader ConfigLoader, path string, interval time.Duration, logger *logrus.Logger) *Watcher {
+	return &Watcher{
+		loader:   loader,
+		path:     path,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run checks the file every interval until ctx is done
+// current is the configuration the agent runs with; apply is called with the new configuration
+// whenever a reloadable setting changes. Changes to other settings are logged and take effect on restart
+func (w *Watcher) Run(ctx context.Context, current *Config, apply func(*Config)) {
+	if content, err := os.ReadFile(w.path); err == nil {
+		w.checksum = sha256.Sum256(content)
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if next, changed := w.check(current); changed {
+				apply(next)
+				current = next
+			}
+		}
+	}
+}
+
+// check reloads the file if its content changed since the last check
+// It returns the current configuration with the reloaded reloadable settings, and whether any of them changed
+func (w *Watcher) check(current *Config) (*Config, bool) {
+	content, err := os.ReadFile(w.path)
+	if err != nil {
+		w.logger.WithError(err).WithField("path", w.path).Warn("Failed to read config file - keeping the current settings")
+		return current, false
+	}
+	checksum := sha256.Sum256(content)
+	if checksum == w.checksum {
+		return current, false
+	}
+	w.checksum = checksum
+
+	loaded, err := w.loader.Load()
+	if err != nil {
+		w.logger.WithError(err).WithField("path", w.path).Error("Ignoring invalid config file - keeping the current settings")
+		return current, false
+	}
+
+	next := *current
+	applyReloadable(&next, loaded)
+
+	if pending := changedSettings(&next, loaded); len(pending) > 0 {
+		w.logger.WithField("settings", pending).Warn("Config file changes that require a restart were not applied")
+	}
+	reloaded := changedSettings(current, &next)
+	if len(reloaded) == 0 {
+		return current, false
+	}
+	w.logger.WithField("settings", reloaded).Info("Configuration reloaded")
+	return &next, true
+}
+
+// changedSettings returns the config file keys (e.g. agent.backoff.maxInterval) whose values differ
+// Only the keys are returned so that changed credentials are never logged
+func changedSettings(a, b *Config) []string {
+	var changed []string
+	diffSettings("", reflect.ValueOf(*a), reflect.ValueOf(*b), &changed)
+	return changed
+}
+
+func diffSettings(prefix string, a, b reflect.Value, changed *[]string) {
+	if a.Kind() != reflect.Struct {
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			*changed = append(*changed, prefix)
+		}
+		return
+	}
+	for i := 0; i < a.NumField(); i++ {
+		key := strings.Split(a.Type().Field(i).Tag.Get("yaml"), ",")[0]
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		diffSettings(key, a.Field(i), b.Field(i), changed)
+	}
+}
@@ -1,15 +1,22 @@
 package container
 
 import (
+	"context"
 	"database/sql"
 	"multinic-agent/internal/application/usecases"
+	"multinic-agent/internal/domain/entities"
 	"multinic-agent/internal/domain/interfaces"
 	"multinic-agent/internal/domain/services"
 	"multinic-agent/internal/infrastructure/adapters"
 	"multinic-agent/internal/infrastructure/config"
 	"multinic-agent/internal/infrastructure/health"
+	"multinic-agent/internal/infrastructure/kubernetes"
 	"multinic-agent/internal/infrastructure/network"
+	"multinic-agent/internal/infrastructure/openstack"
 	"multinic-agent/internal/infrastructure/persistence"
+	"net/http"
+	"os"
+	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/sirupsen/logrus"
@@ -30,13 +37,26 @@ type Container struct {
 	healthService  *health.HealthService
 	namingService  *services.InterfaceNamingService
 	networkFactory *network.NetworkManagerFactory
+	linkManager    interfaces.LinkManager
+	configRenderer interfaces.ConfigRenderer
+	nodeReporter   interfaces.NodeReporter
+	readinessGate  interfaces.NodeReadinessGate
+	nadPublisher   interfaces.NetworkAttachmentPublisher
+	changeTracker  *services.ChangeTracker
+	nodeIdentity   *services.NodeIdentityResolver
+	identityTemp   bool // 시작 시 행이 있는 후보가 없어 임시 노드 식별자를 사용 중 (폴링 루프에서만 접근)
 
 	// 레포지토리
 	repository interfaces.NetworkInterfaceRepository
 
 	// 유스케이스
-	configureNetworkUseCase *usecases.ConfigureNetworkUseCase
-	deleteNetworkUseCase    *usecases.DeleteNetworkUseCase
+	configureNetworkUseCase   *usecases.ConfigureNetworkUseCase
+	deleteNetworkUseCase      *usecases.DeleteNetworkUseCase
+	reconcileNetworkUseCase   *usecases.ReconcileNetworkUseCase
+	networkStatusUseCase      *usecases.NetworkStatusUseCase
+	nodeDoctorUseCase         *usecases.NodeDoctorUseCase
+	publishNodeStatusUseCase  *usecases.PublishNodeStatusUseCase
+	publishAttachmentsUseCase *usecases.PublishNetworkAttachmentsUseCase
 
 	// 데이터베이스
 	db *sql.DB
@@ -53,6 +73,20 @@ func NewContainer(cfg *config.Config, logger *logrus.Logger) (*Container, error)
 		return nil, err
 	}
 
+	// 연결 테스트 (DB 인터페이스 소스를 사용하는 경우에만)
+	if container.db != nil {
+		if err := container.db.Ping(); err != nil {
+			container.Close()
+			return nil, err
+		}
+	}
+
+	// 노드 식별자 확정 (이후 모든 유스케이스가 같은 노드 이름 사용)
+	if err := container.resolveNodeIdentity(); err != nil {
+		container.Close()
+		return nil, err
+	}
+
 	if err := container.initializeServices(); err != nil {
 		return nil, err
 	}
@@ -64,6 +98,23 @@ func NewContainer(cfg *config.Config, logger *logrus.Logger) (*Container, error)
 	return container, nil
 }
 
+// NewDiagnosticContainer는 노드 사전 점검(doctor)에 필요한 컴포넌트만 초기화한 Container를 생성합니다
+// 점검 대상인 DB 연결 테스트와 OS 감지를 생략하므로 환경이 잘못된 노드에서도 생성됩니다
+func NewDiagnosticContainer(cfg *config.Config, logger *logrus.Logger) (*Container, error) {
+	container := &Container{
+		config: cfg,
+		logger: logger,
+	}
+
+	if err := container.initializeInfrastructure(); err != nil {
+		return nil, err
+	}
+
+	container.nodeDoctorUseCase = container.newNodeDoctorUseCase()
+
+	return container, nil
+}
+
 // initializeInfrastructure는 인프라스트럭처 컴포넌트들을 초기화합니다
 func (c *Container) initializeInfrastructure() error {
 	// 기본 어댑터들 초기화
@@ -72,6 +123,54 @@ func (c *Container) initializeInfrastructure() error {
 	c.clock = adapters.NewRealClock()
 	c.osDetector = adapters.NewRealOSDetector(c.fileSystem)
 
+	// 노드 식별자 (설정된 출처 순서대로 노드 이름 확인)
+	identity := c.config.Agent.NodeIdentity
+	mapping, err := services.ParseNodeIdentityMapping(identity.Mapping)
+	if err != nil {
+		return err
+	}
+	c.nodeIdentity = services.NewNodeIdentityResolver(identity.Sources, c.config.Kubernetes.NodeName, mapping, c.fileSystem, os.Hostname)
+
+	// OpenStack 인터페이스 소스는 중앙 DB 없이 동작
+	switch c.config.Source.Type {
+	case config.InterfaceSourceOpenStackMetadata:
+		metadata := c.config.Source.Metadata
+		c.repository = openstack.NewMetadataRepository(
+			c.fileSystem,
+			&http.Client{Timeout: metadata.Timeout},
+			metadata.ConfigDriveFile,
+			metadata.URL,
+			metadata.StatusFile,
+			c.nodeName(),
+			c.logger,
+		)
+		return nil
+	case config.InterfaceSourceNeutron:
+		neutron := c.config.Source.Neutron
+		httpClient := &http.Client{Timeout: neutron.Timeout}
+		auth := openstack.NewKeystoneAuthenticator(openstack.KeystoneCredentials{
+			AuthURL:           neutron.AuthURL,
+			Username:          neutron.Username,
+			Password:          neutron.Password,
+			UserDomainName:    neutron.UserDomainName,
+			ProjectName:       neutron.ProjectName,
+			ProjectDomainName: neutron.ProjectDomainName,
+			RegionName:        neutron.RegionName,
+			Interface:         neutron.Interface,
+		}, httpClient, c.clock)
+		c.repository = openstack.NewNeutronRepository(
+			auth,
+			httpClient,
+			neutron.Endpoint,
+			neutron.DeviceID,
+			neutron.PrimaryNetworks,
+			c.fileSystem,
+			c.nodeName(),
+			c.logger,
+		)
+		return nil
+	}
+
 	// 데이터베이스 연결
 	dsn := c.buildDSN()
 	db, err := sql.Open("mysql", dsn)
@@ -84,15 +183,10 @@ func (c *Container) initializeInfrastructure() error {
 	db.SetMaxIdleConns(c.config.Database.MaxIdleConns)
 	db.SetConnMaxLifetime(c.config.Database.MaxLifetime)
 
-	// 연결 테스트
-	if err := db.Ping(); err != nil {
-		return err
-	}
-
 	c.db = db
 
 	// 레포지토리 초기화
-	c.repository = persistence.NewMySQLRepository(c.db, c.logger)
+	c.repository = persistence.NewMySQLRepository(c.db, c.logger, c.config.Database.DesiredNameColumn)
 
 	return nil
 }
@@ -100,10 +194,21 @@ func (c *Container) initializeInfrastructure() error {
 // initializeServices는 서비스들을 초기화합니다
 func (c *Container) initializeServices() error {
 	// 헬스 서비스
-	c.healthService = health.NewHealthService(c.clock, c.logger)
+	c.healthService = health.NewHealthService(c.clock, c.logger, health.ProbeThresholds{
+		LivenessStaleAfter: c.config.Health.LivenessStaleAfter,
+		ReadinessDBGrace:   c.config.Health.ReadinessDBGrace,
+	})
 
 	// 인터페이스 네이밍 서비스
-	c.namingService = services.NewInterfaceNamingService(c.fileSystem, c.commandExecutor)
+	naming := c.config.Agent.Naming
+	scheme, err := entities.NewNamingScheme(naming.Prefix, naming.Template, naming.MaxInterfaces)
+	if err != nil {
+		return err
+	}
+	c.namingService = services.NewInterfaceNamingService(c.fileSystem, c.commandExecutor, scheme, c.newNameAllocator())
+
+	// 커널 링크 관리자
+	c.linkManager = network.NewIPLinkManager(c.commandExecutor, c.logger)
 
 	// 네트워크 관리자 팩토리
 	c.networkFactory = network.NewNetworkManagerFactory(
@@ -111,11 +216,53 @@ func (c *Container) initializeServices() error {
 		c.commandExecutor,
 		c.fileSystem,
 		c.logger,
+		c.agentID(),
 	)
 
+	// 변경 감지 (저장소가 변경 토큰을 지원하지 않거나 비활성화 시 nil)
+	if detection := c.config.Agent.ChangeDetection; detection.Enabled {
+		if source, ok := c.repository.(interfaces.ChangeTokenSource); ok {
+			c.changeTracker = services.NewChangeTracker(source, c.clock, detection.FullResyncInterval)
+		} else {
+			c.logger.Info("Change detection disabled - interface source has no change token")
+		}
+	}
+
+	// 노드 오브젝트 상태 게시, 준비 상태 게이트, 보조 네트워크 정의 게시 (클러스터 밖에서 실행 중이면 비활성화)
+	c.initializeClusterServices()
+
 	return nil
 }
 
+// initializeClusterServices는 Kubernetes API 오브젝트를 갱신하는 서비스들을 초기화합니다
+func (c *Container) initializeClusterServices() {
+	k8s := c.config.Kubernetes
+	gateMode := k8s.ReadinessGate
+	gateEnabled := gateMode != "" && gateMode != config.ReadinessGateNone
+	if !k8s.NodeReporting && !gateEnabled && !k8s.NetworkAttachments.Enabled {
+		return
+	}
+
+	client, err := kubernetes.NewInClusterClient()
+	if err != nil {
+		c.logger.WithError(err).Warn("Kubernetes integrations disabled - Kubernetes API is not available")
+		return
+	}
+
+	if k8s.NodeReporting {
+		c.nodeReporter = kubernetes.NewNodeReporter(client, c.clock, c.logger)
+	}
+	switch gateMode {
+	case config.ReadinessGateTaint:
+		c.readinessGate = kubernetes.NewNodeTaintGate(client, c.logger)
+	case config.ReadinessGateLabel:
+		c.readinessGate = kubernetes.NewNodeLabelGate(client, c.logger)
+	}
+	if nad := k8s.NetworkAttachments; nad.Enabled {
+		c.nadPublisher = kubernetes.NewNADPublisher(client, nad.Namespace, nad.CNIType, nad.IPAM, c.logger)
+	}
+}
+
 // initializeUseCases는 유스케이스들을 초기화합니다
 func (c *Container) initializeUseCases() error {
 	// 네트워크 설정자 생성
@@ -130,17 +277,65 @@ func (c *Container) initializeUseCases() error {
 		return err
 	}
 
+	// 설정 파일 리더 생성
+	configReader, err := c.networkFactory.CreateConfigReader()
+	if err != nil {
+		return err
+	}
+
+	// 설정 파일 렌더러 생성 (render 명령용)
+	c.configRenderer, err = c.networkFactory.CreateConfigRenderer()
+	if err != nil {
+		return err
+	}
+
+	// 런타임 드리프트 검사용 링크 관리자 (비활성화 시 nil)
+	var runtimeLinkManager interfaces.LinkManager
+	if c.config.Agent.RuntimeDriftCheck {
+		runtimeLinkManager = c.linkManager
+	}
+
+	// 소유권 헤더에서 이 에이전트의 것으로 인정하는 다른 에이전트 ID
+	agentAliases := c.agentAliases()
+
 	// 네트워크 설정 유스케이스
 	c.configureNetworkUseCase = usecases.NewConfigureNetworkUseCase(
 		c.repository,
 		configurer,
 		rollbacker,
+		configReader,
+		runtimeLinkManager,
 		c.namingService,
 		c.fileSystem,
 		c.osDetector,
+		c.agentID(),
 		c.logger,
 		c.config.Agent.MaxConcurrentTasks,
 	)
+	c.configureNetworkUseCase.SetAgentAliases(agentAliases)
+
+	// 고아 삭제 안전 규칙
+	safety := c.config.Agent.OrphanSafety
+	orphanGuard := services.NewOrphanDeletionGuard(services.DeletionSafetyPolicy{
+		MaxDeletionsPerCycle: safety.MaxDeletionsPerCycle,
+		MaxDeletionPercent:   safety.MaxDeletionPercent,
+		ConfirmCycles:        safety.ConfirmCycles,
+		GracePeriod:          safety.GracePeriod,
+	}, c.clock)
+
+	// 고아 인터페이스 격리 저장소 (비활성화 시 즉시 삭제)
+	var quarantineStore *services.QuarantineStore
+	if c.config.Agent.Quarantine.Enabled {
+		quarantineStore = services.NewQuarantineStore(
+			c.fileSystem,
+			c.clock,
+			c.config.Agent.Quarantine.Directory,
+			c.config.Agent.Quarantine.Retention,
+		)
+	}
+
+	// 삭제된 인터페이스의 커널 링크 정리
+	linkCleaner := services.NewLinkCleaner(c.linkManager, c.config.Agent.LinkCleanup.RestoreKernelName)
 
 	// 네트워크 삭제 유스케이스
 	c.deleteNetworkUseCase = usecases.NewDeleteNetworkUseCase(
@@ -149,12 +344,172 @@ func (c *Container) initializeUseCases() error {
 		c.namingService,
 		c.repository,
 		c.fileSystem,
+		c.linkManager,
+		linkCleaner,
+		orphanGuard,
+		quarantineStore,
+		c.agentID(),
+		c.logger,
+	)
+	c.deleteNetworkUseCase.SetAgentAliases(agentAliases)
+
+	// 한 사이클의 스냅샷으로 삭제와 설정을 차례로 실행하는 reconcile 유스케이스
+	c.reconcileNetworkUseCase = usecases.NewReconcileNetworkUseCase(
+		c.configureNetworkUseCase,
+		c.deleteNetworkUseCase,
+		c.repository,
+		c.osDetector,
+		c.fileSystem,
+		c.linkManager,
+		c.clock,
+		c.logger,
+	)
+
+	// 상태 조회 유스케이스
+	c.networkStatusUseCase = usecases.NewNetworkStatusUseCase(
+		c.repository,
+		configReader,
+		c.linkManager,
+		c.namingService,
+		c.agentID(),
 		c.logger,
 	)
+	c.networkStatusUseCase.SetAgentAliases(agentAliases)
 
+	// 노드 사전 점검 유스케이스 (/doctor 엔드포인트용)
+	c.nodeDoctorUseCase = c.newNodeDoctorUseCase()
+
+	// 노드 상태 게시 유스케이스 (게시와 준비 상태 게이트가 모두 비활성화 시 nil)
+	if c.nodeReporter != nil || c.readinessGate != nil {
+		c.publishNodeStatusUseCase = usecases.NewPublishNodeStatusUseCase(c.nodeReporter, c.readinessGate, c.logger)
+	}
+
+	// 보조 네트워크 정의 게시 유스케이스 (비활성화 시 nil)
+	if c.nadPublisher != nil {
+		c.publishAttachmentsUseCase = usecases.NewPublishNetworkAttachmentsUseCase(c.nadPublisher, c.logger)
+	}
+
+	return nil
+}
+
+// newNodeDoctorUseCase는 노드 사전 점검 유스케이스를 생성합니다
+func (c *Container) newNodeDoctorUseCase() *usecases.NodeDoctorUseCase {
+	return usecases.NewNodeDoctorUseCase(
+		c.osDetector,
+		c.commandExecutor,
+		c.fileSystem,
+		c.repository,
+		c.clock,
+		c.logger,
+	)
+}
+
+// newNameAllocator는 설정된 저장소로 MAC별 이름 할당 관리자를 생성합니다 (비활성화 시 nil)
+func (c *Container) newNameAllocator() *services.NameAllocator {
+	allocation := c.config.Agent.NameAllocation
+
+	var store interfaces.NameAllocationStore
+	switch allocation.Store {
+	case config.NameAllocationStoreFile:
+		store = persistence.NewFileNameAllocationStore(c.fileSystem, allocation.File, c.logger)
+	case config.NameAllocationStoreDB:
+		store = persistence.NewMySQLNameAllocationStore(c.db, c.nodeName())
+	default:
+		return nil
+	}
+
+	return services.NewNameAllocator(store, c.clock, allocation.ReuseDelay)
+}
+
+// agentID는 설정 파일 소유권 헤더에 기록할 에이전트 ID를 반환합니다 (미설정 시 노드 이름)
+func (c *Container) agentID() string {
+	if c.config.Agent.AgentID != "" {
+		return c.config.Agent.AgentID
+	}
+	return c.nodeName()
+}
+
+// agentAliases는 에이전트 ID가 노드 이름일 때 이 에이전트의 것으로 인정할 다른 노드 식별자 후보 이름을 반환합니다
+// 노드 이름은 DB에 행이 생긴 후보로 바뀔 수 있으므로, 이전 이름으로 기록된 파일도 다른 에이전트의 파일로 보지 않도록 합니다
+// AGENT_ID를 지정했으면 노드 이름과 무관하게 고정되므로 별칭을 사용하지 않습니다
+func (c *Container) agentAliases() []string {
+	if c.config.Agent.AgentID != "" {
+		return nil
+	}
+
+	candidates, err := c.nodeIdentity.Candidates()
+	if err != nil {
+		return nil
+	}
+	aliases := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		aliases = append(aliases, candidate.Name)
+	}
+	return aliases
+}
+
+// resolveNodeIdentity는 노드 식별자를 확정하고 어느 출처의 이름을 사용하는지 기록합니다
+// DB 소스에서는 인터페이스 행이 있는 후보를 우선하므로 NODE_NAME과 DB의 노드 이름이 달라도 기존 행을 찾습니다
+func (c *Container) resolveNodeIdentity() error {
+	var (
+		identity services.NodeIdentity
+		matched  = true
+		err      error
+	)
+	if c.db != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		identity, matched, err = c.nodeIdentity.Resolve(ctx, c.repository)
+	} else {
+		identity, err = c.nodeIdentity.Identity()
+	}
+	if err != nil {
+		return err
+	}
+
+	fields := logrus.Fields{
+		"node_name": identity.Name,
+		"source":    identity.Source,
+	}
+	if !matched {
+		c.identityTemp = true
+		c.logger.WithFields(fields).Warn("No interfaces found in the database for any node identity candidate - using the first candidate until one has interfaces (check NODE_IDENTITY_SOURCES if this node should have interfaces)")
+		return nil
+	}
+	c.logger.WithFields(fields).Info("Node identity resolved")
 	return nil
 }
 
+// RefreshNodeIdentity는 임시 노드 식별자를 사용 중이면 후보를 다시 확인합니다
+// 시작 시 사용한 것과 다른 후보에 행이 생기면 true를 반환합니다. 소유권 헤더의 에이전트 ID와 이름 할당 저장소는
+// 시작 시의 노드 이름으로 구성되어 있으므로, 호출자는 에이전트를 다시 시작하여 새 식별자로 구성해야 합니다
+func (c *Container) RefreshNodeIdentity(ctx context.Context) (bool, error) {
+	if !c.identityTemp {
+		return false, nil
+	}
+
+	previous := c.nodeName()
+	identity, matched, err := c.nodeIdentity.Resolve(ctx, c.repository)
+	if err != nil || !matched {
+		return false, err
+	}
+	c.identityTemp = false
+
+	c.logger.WithFields(logrus.Fields{
+		"node_name":          identity.Name,
+		"source":             identity.Source,
+		"previous_node_name": previous,
+	}).Info("Node identity resolved")
+	return identity.Name != previous, nil
+}
+
+// nodeName은 확정된 노드 이름을 반환합니다 (확인할 수 없으면 빈 값)
+// NewContainer는 식별자를 확인할 수 없으면 실패하므로, 빈 값은 사전 점검용 컨테이너에서만 나타납니다
+func (c *Container) nodeName() string {
+	name, _ := c.nodeIdentity.NodeName()
+	return name
+}
+
 // buildDSN은 데이터베이스 연결 문자열을 생성합니다
 func (c *Container) buildDSN() string {
 	cfg := c.config.Database
@@ -181,6 +536,56 @@ func (c *Container) GetDeleteNetworkUseCase() *usecases.DeleteNetworkUseCase {
 	return c.deleteNetworkUseCase
 }
 
+// GetNodeIdentityResolver는 노드 식별자 서비스를 반환합니다
+func (c *Container) GetNodeIdentityResolver() *services.NodeIdentityResolver {
+	return c.nodeIdentity
+}
+
+// GetReconcileNetworkUseCase는 설정/삭제를 묶어 실행하는 reconcile 유스케이스를 반환합니다
+func (c *Container) GetReconcileNetworkUseCase() *usecases.ReconcileNetworkUseCase {
+	return c.reconcileNetworkUseCase
+}
+
+// GetNetworkStatusUseCase는 상태 조회 유스케이스를 반환합니다
+func (c *Container) GetNetworkStatusUseCase() *usecases.NetworkStatusUseCase {
+	return c.networkStatusUseCase
+}
+
+// GetNodeDoctorUseCase는 노드 사전 점검 유스케이스를 반환합니다
+func (c *Container) GetNodeDoctorUseCase() *usecases.NodeDoctorUseCase {
+	return c.nodeDoctorUseCase
+}
+
+// GetPublishNodeStatusUseCase는 노드 상태 게시 유스케이스를 반환합니다 (비활성화 시 nil)
+func (c *Container) GetPublishNodeStatusUseCase() *usecases.PublishNodeStatusUseCase {
+	return c.publishNodeStatusUseCase
+}
+
+// GetPublishNetworkAttachmentsUseCase는 보조 네트워크 정의 게시 유스케이스를 반환합니다 (비활성화 시 nil)
+func (c *Container) GetPublishNetworkAttachmentsUseCase() *usecases.PublishNetworkAttachmentsUseCase {
+	return c.publishAttachmentsUseCase
+}
+
+// GetChangeTracker는 변경 감지 서비스를 반환합니다 (비활성화 시 nil)
+func (c *Container) GetChangeTracker() *services.ChangeTracker {
+	return c.changeTracker
+}
+
+// GetRepository는 네트워크 인터페이스 레포지토리를 반환합니다
+func (c *Container) GetRepository() interfaces.NetworkInterfaceRepository {
+	return c.repository
+}
+
+// GetConfigRenderer는 OS에 맞는 설정 파일 렌더러를 반환합니다
+func (c *Container) GetConfigRenderer() interfaces.ConfigRenderer {
+	return c.configRenderer
+}
+
+// GetNamingService는 인터페이스 네이밍 서비스를 반환합니다
+func (c *Container) GetNamingService() *services.InterfaceNamingService {
+	return c.namingService
+}
+
 // GetOSDetector는 OS 감지기를 반환합니다
 func (c *Container) GetOSDetector() interfaces.OSDetector {
 	return c.osDetector
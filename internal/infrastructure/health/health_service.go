@@ -1,10 +1,13 @@
 package health
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"multinic-agent/internal/domain/entities"
 	"multinic-agent/internal/domain/interfaces"
 	"net/http"
+	"sort"
 	"sync"
 	"time"
 
@@ -22,6 +25,46 @@ type HealthService struct {
 	processedVMs   int64
 	failedConfigs  int64
 	networkManager string
+
+	blockedDeletions  []string
+	deferredDeletions []string
+	blockReason       string
+
+	plan *entities.ReconcilePlan // latest dry-run plan, nil when not running in dry-run mode
+
+	interfaces map[int]*InterfaceHealth // per-interface state of the interfaces in the latest cycle, by DB ID
+
+	thresholds     ProbeThresholds
+	loopProgress   func() time.Time // last polling loop progress, nil until the loop is attached
+	dbReadyOnce    bool             // the database was reachable at least once
+	dbFailingSince time.Time        // start of the current database outage, zero when reachable
+}
+
+// InterfaceHealth is the reconcile state of a single managed interface
+type InterfaceHealth struct {
+	InterfaceID   int                      `json:"interface_id"`
+	InterfaceName string                   `json:"interface_name,omitempty"`
+	MacAddress    string                   `json:"mac_address"`
+	LastResult    entities.ReconcileResult `json:"last_result"`
+	LastError     string                   `json:"last_error,omitempty"`
+	LastChecked   time.Time                `json:"last_checked"`
+	LastSuccess   *time.Time               `json:"last_success,omitempty"` // nil until the interface was configured or found in sync
+	Drift         string                   `json:"drift,omitempty"`        // why the interface needed a change in the latest cycle
+}
+
+// ProbeThresholds holds the thresholds of the liveness and readiness probes
+type ProbeThresholds struct {
+	// LivenessStaleAfter is how long the polling loop may go without progress before liveness fails
+	LivenessStaleAfter time.Duration
+	// ReadinessDBGrace is how long the database may be unreachable before readiness fails
+	ReadinessDBGrace time.Duration
+}
+
+// ProbeResponse is the liveness and readiness probe response struct
+type ProbeResponse struct {
+	Status string                 `json:"status"` // "ok" or "failed"
+	Reason string                 `json:"reason,omitempty"`
+	Checks map[string]interface{} `json:"checks"`
 }
 
 // HealthStatus represents health check status
@@ -43,12 +86,13 @@ type HealthResponse struct {
 }
 
 // NewHealthService creates a new HealthService
-func NewHealthService(clock interfaces.Clock, logger *logrus.Logger) *HealthService {
+func NewHealthService(clock interfaces.Clock, logger *logrus.Logger, thresholds ProbeThresholds) *HealthService {
 	return &HealthService{
-		clock:     clock,
-		logger:    logger,
-		startTime: clock.Now(),
-		dbHealthy: false,
+		clock:      clock,
+		logger:     logger,
+		startTime:  clock.Now(),
+		dbHealthy:  false,
+		thresholds: thresholds,
 	}
 }
 
@@ -57,10 +101,33 @@ func (h *HealthService) UpdateDBHealth(healthy bool, err error) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
+	switch {
+	case healthy:
+		h.dbReadyOnce = true
+		h.dbFailingSince = time.Time{}
+	case h.dbFailingSince.IsZero():
+		h.dbFailingSince = h.clock.Now()
+	}
 	h.dbHealthy = healthy
 	h.dbError = err
 }
 
+// SetLoopProgress attaches the source of the polling loop progress used by the liveness probe
+func (h *HealthService) SetLoopProgress(progress func() time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.loopProgress = progress
+}
+
+// SetProbeThresholds replaces the liveness and readiness thresholds (used on config reload)
+func (h *HealthService) SetProbeThresholds(thresholds ProbeThresholds) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.thresholds = thresholds
+}
+
 // IncrementProcessedVMs increments the processed VM count
 func (h *HealthService) IncrementProcessedVMs() {
 	h.mu.Lock()
@@ -77,6 +144,199 @@ func (h *HealthService) IncrementFailedConfigs() {
 	h.failedConfigs++
 }
 
+// UpdateOrphanDeletionSafety records orphaned interfaces held back by deletion safety rules
+func (h *HealthService) UpdateOrphanDeletionSafety(blocked, deferred []string, reason string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.blockedDeletions = blocked
+	h.deferredDeletions = deferred
+	h.blockReason = reason
+}
+
+// UpdateInterfaceResults replaces the per-interface state with the results of the latest cycle
+// Interfaces that are no longer part of the cycle are dropped; the last success time is kept across cycles
+func (h *HealthService) UpdateInterfaceResults(results []entities.InterfaceResult) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.clock.Now()
+	tracked := make(map[int]*InterfaceHealth, len(results))
+	for _, result := range results {
+		state := &InterfaceHealth{
+			InterfaceID:   result.InterfaceID,
+			InterfaceName: result.InterfaceName,
+			MacAddress:    result.MacAddress,
+			LastResult:    result.Result,
+			LastError:     result.Error,
+			LastChecked:   now,
+			Drift:         result.Drift,
+		}
+		if previous, ok := h.interfaces[result.InterfaceID]; ok {
+			state.LastSuccess = previous.LastSuccess
+		}
+		if result.Result == entities.ResultConfigured || result.Result == entities.ResultInSync {
+			success := now
+			state.LastSuccess = &success
+		}
+		tracked[result.InterfaceID] = state
+	}
+	h.interfaces = tracked
+}
+
+// UpdatePlan records the latest reconcile plan computed in dry-run mode
+func (h *HealthService) UpdatePlan(plan entities.ReconcilePlan) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.plan = &plan
+}
+
+// PlanHandler returns an HTTP handler that serves the latest dry-run reconcile plan as JSON
+func (h *HealthService) PlanHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		h.mu.RLock()
+		plan := h.plan
+		h.mu.RUnlock()
+
+		if plan == nil {
+			http.Error(w, "No reconcile plan available (dry-run mode disabled or first cycle not finished)", http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(plan); err != nil {
+			h.logger.WithError(err).Error("failed to encode reconcile plan response")
+		}
+	})
+}
+
+// DoctorHandler returns an HTTP handler that runs the node pre-flight checks on each request
+// and serves the report as JSON, with 503 when any check failed
+func (h *HealthService) DoctorHandler(diagnose func(ctx context.Context) entities.DiagnosticReport) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		report := diagnose(r.Context())
+
+		statusCode := http.StatusOK
+		if !report.Healthy() {
+			statusCode = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		if err := json.NewEncoder(w).Encode(report); err != nil {
+			h.logger.WithError(err).Error("failed to encode doctor report response")
+		}
+	})
+}
+
+// LivenessHandler returns an HTTP handler for the liveness probe
+// Liveness only depends on polling loop progress, so a database outage does not restart the agent
+func (h *HealthService) LivenessHandler() http.Handler {
+	return h.probeHandler(h.checkLiveness)
+}
+
+// ReadinessHandler returns an HTTP handler for the readiness probe
+// Readiness depends on the database, which must have been reachable once and not be failing longer than the grace period
+func (h *HealthService) ReadinessHandler() http.Handler {
+	return h.probeHandler(h.checkReadiness)
+}
+
+// probeHandler serves a probe result as JSON, with 503 when the probe failed
+func (h *HealthService) probeHandler(check func() ProbeResponse) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		response := check()
+
+		statusCode := http.StatusOK
+		if response.Status != probeOK {
+			statusCode = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			h.logger.WithError(err).Error("failed to encode probe response")
+		}
+	})
+}
+
+const (
+	probeOK     = "ok"
+	probeFailed = "failed"
+)
+
+// checkLiveness fails when the polling loop made no progress within the stale threshold
+func (h *HealthService) checkLiveness() ProbeResponse {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	// Until the loop starts, the agent start time counts as the last progress
+	lastProgress := h.startTime
+	if h.loopProgress != nil {
+		if progress := h.loopProgress(); !progress.IsZero() {
+			lastProgress = progress
+		}
+	}
+	sinceProgress := h.clock.Now().Sub(lastProgress)
+
+	response := ProbeResponse{
+		Status: probeOK,
+		Checks: map[string]interface{}{
+			"last_progress":  lastProgress.Format(time.RFC3339),
+			"since_progress": sinceProgress.Round(time.Second).String(),
+			"stale_after":    h.thresholds.LivenessStaleAfter.String(),
+		},
+	}
+	if h.thresholds.LivenessStaleAfter > 0 && sinceProgress > h.thresholds.LivenessStaleAfter {
+		response.Status = probeFailed
+		response.Reason = fmt.Sprintf("polling loop made no progress for %s", sinceProgress.Round(time.Second))
+	}
+	return response
+}
+
+// checkReadiness fails until the database was reachable once, and when it is failing longer than the grace period
+func (h *HealthService) checkReadiness() ProbeResponse {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	response := ProbeResponse{
+		Status: probeOK,
+		Checks: map[string]interface{}{
+			"database": map[string]interface{}{
+				"healthy": h.dbHealthy,
+				"error":   h.formatError(h.dbError),
+			},
+			"db_grace": h.thresholds.ReadinessDBGrace.String(),
+		},
+	}
+
+	switch {
+	case h.dbHealthy:
+	case !h.dbReadyOnce:
+		response.Status = probeFailed
+		response.Reason = "no successful reconcile cycle yet"
+	case h.clock.Now().Sub(h.dbFailingSince) >= h.thresholds.ReadinessDBGrace:
+		response.Status = probeFailed
+		response.Reason = fmt.Sprintf("database unreachable since %s", h.dbFailingSince.Format(time.RFC3339))
+	}
+	return response
+}
+
 // SetNetworkManager sets the network manager type in use
 func (h *HealthService) SetNetworkManager(managerType string) {
 	h.mu.Lock()
@@ -127,13 +387,31 @@ func (h *HealthService) buildHealthResponse() HealthResponse {
 		"network_manager": map[string]interface{}{
 			"type": h.networkManager,
 		},
+		"orphan_deletion": map[string]interface{}{
+			"blocked":      h.blockedDeletions,
+			"deferred":     h.deferredDeletions,
+			"block_reason": h.blockReason,
+		},
+	}
+	if h.plan != nil {
+		components["dry_run"] = map[string]interface{}{
+			"generated_at": h.plan.GeneratedAt.Format(time.RFC3339),
+			"create":       h.plan.Count(entities.PlanActionCreate),
+			"update":       h.plan.Count(entities.PlanActionUpdate),
+			"delete":       h.plan.Count(entities.PlanActionDelete),
+		}
 	}
 
+	interfaceStates := h.interfaceStates()
+	components["interfaces"] = interfaceStates
+
 	// Statistics information
 	statistics := map[string]interface{}{
-		"processed_vms":  h.processedVMs,
-		"failed_configs": h.failedConfigs,
-		"uptime":         h.formatUptime(now.Sub(h.startTime)),
+		"processed_vms":      h.processedVMs,
+		"failed_configs":     h.failedConfigs,
+		"managed_interfaces": len(interfaceStates),
+		"failing_interfaces": len(h.failingInterfaces()),
+		"uptime":             h.formatUptime(now.Sub(h.startTime)),
 	}
 
 	return HealthResponse{
@@ -152,17 +430,42 @@ func (h *HealthService) determineOverallStatus() HealthStatus {
 		return StatusUnhealthy
 	}
 
-	// If failed configurations are 50% or more, status is degraded
-	if h.processedVMs > 0 && h.failedConfigs > 0 {
-		failureRate := float64(h.failedConfigs) / float64(h.processedVMs+h.failedConfigs)
-		if failureRate >= 0.5 {
-			return StatusDegraded
-		}
+	// If orphan deletion is blocked by safety rules, an operator must intervene
+	if len(h.blockedDeletions) > 0 {
+		return StatusDegraded
+	}
+
+	// If any interface failed in the latest cycle, status is degraded until it recovers
+	if len(h.failingInterfaces()) > 0 {
+		return StatusDegraded
 	}
 
 	return StatusHealthy
 }
 
+// interfaceStates returns the per-interface state ordered by DB ID
+func (h *HealthService) interfaceStates() []InterfaceHealth {
+	states := make([]InterfaceHealth, 0, len(h.interfaces))
+	for _, state := range h.interfaces {
+		states = append(states, *state)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].InterfaceID < states[j].InterfaceID
+	})
+	return states
+}
+
+// failingInterfaces returns the IDs of the interfaces that failed in the latest cycle
+func (h *HealthService) failingInterfaces() []int {
+	var failing []int
+	for id, state := range h.interfaces {
+		if state.LastResult == entities.ResultFailed {
+			failing = append(failing, id)
+		}
+	}
+	return failing
+}
+
 // formatError formats an error to string
 func (h *HealthService) formatError(err error) string {
 	if err == nil {
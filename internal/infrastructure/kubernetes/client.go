@@ -0,0 +1,124 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"multinic-agent/internal/domain/errors"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// In-cluster service account paths mounted into every pod
+const (
+	serviceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	serviceAccountCAFile    = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+)
+
+const requestTimeout = 10 * time.Second
+
+// Client is a minimal Kubernetes REST API client authenticated with a service account token
+type Client struct {
+	baseURL   string
+	tokenFile string
+	http      *http.Client
+}
+
+// NewClient creates a Client for the API server at baseURL
+// The bearer token is re-read from tokenFile on every request because projected service account tokens rotate
+func NewClient(baseURL, tokenFile string, httpClient *http.Client) *Client {
+	return &Client{
+		baseURL:   strings.TrimSuffix(baseURL, "/"),
+		tokenFile: tokenFile,
+		http:      httpClient,
+	}
+}
+
+// NewInClusterClient creates a Client using the pod's service account
+func NewInClusterClient() (*Client, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, errors.NewSystemError("not running in a Kubernetes cluster (KUBERNETES_SERVICE_HOST/PORT not set)", nil)
+	}
+
+	ca, err := os.ReadFile(serviceAccountCAFile)
+	if err != nil {
+		return nil, errors.NewSystemError("failed to read service account CA certificate", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, errors.NewSystemError("service account CA certificate contains no certificates", nil)
+	}
+
+	httpClient := &http.Client{
+		Timeout: requestTimeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12},
+		},
+	}
+	return NewClient("https://"+net.JoinHostPort(host, port), serviceAccountTokenFile, httpClient), nil
+}
+
+// nodePath returns the API path of a node object
+func nodePath(nodeName string) string {
+	return "/api/v1/nodes/" + url.PathEscape(nodeName)
+}
+
+// do sends an authenticated request to the API server and decodes the response into out when not nil
+func (c *Client) do(ctx context.Context, method, path, contentType string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return errors.NewSystemError("failed to encode Kubernetes API request", err)
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return errors.NewSystemError("failed to build Kubernetes API request", err)
+	}
+	token, err := os.ReadFile(c.tokenFile)
+	if err != nil {
+		return errors.NewSystemError("failed to read service account token", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return errors.NewNetworkError(fmt.Sprintf("Kubernetes API request %s %s failed", method, path), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		message := fmt.Sprintf("Kubernetes API request %s %s returned %s: %s", method, path, resp.Status, strings.TrimSpace(string(detail)))
+		switch resp.StatusCode {
+		case http.StatusNotFound:
+			return errors.NewNotFoundError(message)
+		case http.StatusConflict:
+			return errors.NewConflictError(message)
+		}
+		return errors.NewSystemError(message, nil)
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return errors.NewSystemError("failed to decode Kubernetes API response", err)
+		}
+	}
+	return nil
+}
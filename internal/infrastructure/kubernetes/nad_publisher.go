@@ -0,0 +1,198 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/errors"
+	"net/http"
+	"net/url"
+
+	"github.com/sirupsen/logrus"
+)
+
+// CNI plugins that can attach pods to a configured host interface
+const (
+	CNITypeMacvlan    = "macvlan"
+	CNITypeIPvlan     = "ipvlan"
+	CNITypeHostDevice = "host-device" // moves the host interface into a single pod
+)
+
+// IPAM modes of published network attachment definitions
+const (
+	IPAMWhereabouts = "whereabouts" // cluster-wide allocation from the subnet CIDR
+	IPAMHostLocal   = "host-local"  // per-node allocation from the subnet CIDR
+	IPAMStatic      = "static"      // addresses are given in the pod's network selection annotation
+	IPAMDHCP        = "dhcp"
+	IPAMNone        = "none"
+)
+
+const (
+	cniVersion = "0.3.1"
+	// managedByLabel marks definitions published by the agent, so that hand-written ones are never overwritten
+	managedByLabel = "app.kubernetes.io/managed-by"
+	managedByValue = "multinic-agent"
+	// CIDRAnnotation and MasterAnnotation record the subnet and host interface a definition was published for,
+	// so that a node whose interface on the same subnet has another name reports a conflict instead of taking it over
+	CIDRAnnotation   = "multinic.io/subnet-cidr"
+	MasterAnnotation = "multinic.io/master"
+)
+
+// NADPublisher publishes Multus NetworkAttachmentDefinitions for configured host interfaces
+type NADPublisher struct {
+	client    *Client
+	namespace string
+	cniType   string
+	ipam      string
+	logger    *logrus.Logger
+}
+
+// NewNADPublisher creates a new NADPublisher writing definitions into namespace
+func NewNADPublisher(client *Client, namespace, cniType, ipam string, logger *logrus.Logger) *NADPublisher {
+	return &NADPublisher{
+		client:    client,
+		namespace: namespace,
+		cniType:   cniType,
+		ipam:      ipam,
+		logger:    logger,
+	}
+}
+
+// networkAttachmentDefinition is the k8s.cni.cncf.io/v1 NetworkAttachmentDefinition object
+type networkAttachmentDefinition struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+	Metadata   struct {
+		Name            string            `json:"name,omitempty"`
+		Namespace       string            `json:"namespace,omitempty"`
+		ResourceVersion string            `json:"resourceVersion,omitempty"`
+		Labels          map[string]string `json:"labels,omitempty"`
+		Annotations     map[string]string `json:"annotations,omitempty"`
+	} `json:"metadata"`
+	Spec struct {
+		Config string `json:"config"`
+	} `json:"spec"`
+}
+
+// Apply creates the definition of an attachment, or updates it when its CNI configuration differs
+// Definitions that were not published by the agent, or that were published for another subnet or
+// host interface (by a node that names its interface on the subnet differently), are left untouched
+func (p *NADPublisher) Apply(ctx context.Context, attachment entities.NetworkAttachment) error {
+	config, err := p.RenderConfig(attachment)
+	if err != nil {
+		return err
+	}
+
+	collection := fmt.Sprintf("/apis/k8s.cni.cncf.io/v1/namespaces/%s/network-attachment-definitions", url.PathEscape(p.namespace))
+	path := collection + "/" + url.PathEscape(attachment.Name)
+
+	var current networkAttachmentDefinition
+	err = p.client.do(ctx, http.MethodGet, path, "", nil, &current)
+	switch {
+	case errors.IsNotFoundError(err):
+		nad := networkAttachmentDefinition{APIVersion: "k8s.cni.cncf.io/v1", Kind: "NetworkAttachmentDefinition"}
+		nad.Metadata.Name = attachment.Name
+		nad.Metadata.Namespace = p.namespace
+		nad.Metadata.Labels = map[string]string{managedByLabel: managedByValue}
+		nad.Metadata.Annotations = attachmentAnnotations(attachment)
+		nad.Spec.Config = config
+		if err := p.client.do(ctx, http.MethodPost, collection, "application/json", nad, nil); err != nil {
+			return err
+		}
+		p.logApplied(attachment, "created")
+		return nil
+	case err != nil:
+		return err
+	}
+
+	if current.Metadata.Labels[managedByLabel] != managedByValue {
+		return errors.NewConflictError(fmt.Sprintf("network attachment definition %s/%s exists and is not managed by the agent", p.namespace, attachment.Name))
+	}
+	annotated := true
+	for key, value := range attachmentAnnotations(attachment) {
+		published, ok := current.Metadata.Annotations[key]
+		if ok && published != value {
+			return errors.NewConflictError(fmt.Sprintf("network attachment definition %s/%s was published with %s=%s, not %s",
+				p.namespace, attachment.Name, key, published, value))
+		}
+		annotated = annotated && ok
+	}
+	if annotated && current.Spec.Config == config {
+		return nil
+	}
+
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"resourceVersion": current.Metadata.ResourceVersion,
+			"annotations":     attachmentAnnotations(attachment),
+		},
+		"spec": map[string]interface{}{"config": config},
+	}
+	if err := p.client.do(ctx, http.MethodPatch, path, "application/merge-patch+json", patch, nil); err != nil {
+		return err
+	}
+	p.logApplied(attachment, "updated")
+	return nil
+}
+
+// RenderConfig returns the CNI configuration of an attachment as stored in the definition
+func (p *NADPublisher) RenderConfig(attachment entities.NetworkAttachment) (string, error) {
+	config := map[string]interface{}{
+		"cniVersion": cniVersion,
+		"name":       attachment.Name,
+		"type":       p.cniType,
+	}
+	switch p.cniType {
+	case CNITypeMacvlan:
+		config["master"] = attachment.InterfaceName
+		config["mode"] = "bridge"
+	case CNITypeIPvlan:
+		config["master"] = attachment.InterfaceName
+		config["mode"] = "l2"
+	case CNITypeHostDevice:
+		config["device"] = attachment.InterfaceName
+	default:
+		return "", errors.NewValidationError(fmt.Sprintf("unsupported CNI type %q", p.cniType), nil)
+	}
+
+	switch p.ipam {
+	case IPAMWhereabouts:
+		config["ipam"] = map[string]interface{}{"type": IPAMWhereabouts, "range": attachment.CIDR}
+	case IPAMHostLocal:
+		config["ipam"] = map[string]interface{}{"type": IPAMHostLocal, "subnet": attachment.CIDR}
+	case IPAMStatic, IPAMDHCP:
+		config["ipam"] = map[string]interface{}{"type": p.ipam}
+	case IPAMNone:
+		config["ipam"] = map[string]interface{}{}
+	default:
+		return "", errors.NewValidationError(fmt.Sprintf("unsupported IPAM mode %q", p.ipam), nil)
+	}
+
+	// Map keys are encoded in sorted order, so the same attachment always renders the same text
+	rendered, err := json.Marshal(config)
+	if err != nil {
+		return "", errors.NewSystemError("failed to encode CNI configuration", err)
+	}
+	return string(rendered), nil
+}
+
+// attachmentAnnotations returns the annotations that identify what a definition was published for
+func attachmentAnnotations(attachment entities.NetworkAttachment) map[string]string {
+	return map[string]string{
+		CIDRAnnotation:   attachment.CIDR,
+		MasterAnnotation: attachment.InterfaceName,
+	}
+}
+
+// logApplied logs a created or updated definition
+func (p *NADPublisher) logApplied(attachment entities.NetworkAttachment, action string) {
+	p.logger.WithFields(logrus.Fields{
+		"namespace":      p.namespace,
+		"name":           attachment.Name,
+		"interface_name": attachment.InterfaceName,
+		"cidr":           attachment.CIDR,
+		"cni_type":       p.cniType,
+		"ipam":           p.ipam,
+	}).Infof("Network attachment definition %s", action)
+}
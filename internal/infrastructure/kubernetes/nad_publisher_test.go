@@ -0,0 +1,165 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNADPublisher_RenderConfig(t *testing.T) {
+	attachment := entities.NetworkAttachment{Name: "multinic0", InterfaceName: "multinic0", CIDR: "10.10.0.0/24"}
+
+	tests := []struct {
+		name    string
+		cniType string
+		ipam    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "macvlan with whereabouts",
+			cniType: CNITypeMacvlan,
+			ipam:    IPAMWhereabouts,
+			want:    `{"cniVersion":"0.3.1","ipam":{"range":"10.10.0.0/24","type":"whereabouts"},"master":"multinic0","mode":"bridge","name":"multinic0","type":"macvlan"}`,
+		},
+		{
+			name:    "ipvlan with host-local",
+			cniType: CNITypeIPvlan,
+			ipam:    IPAMHostLocal,
+			want:    `{"cniVersion":"0.3.1","ipam":{"subnet":"10.10.0.0/24","type":"host-local"},"master":"multinic0","mode":"l2","name":"multinic0","type":"ipvlan"}`,
+		},
+		{
+			name:    "host-device without IPAM",
+			cniType: CNITypeHostDevice,
+			ipam:    IPAMNone,
+			want:    `{"cniVersion":"0.3.1","device":"multinic0","ipam":{},"name":"multinic0","type":"host-device"}`,
+		},
+		{
+			name:    "unsupported CNI type",
+			cniType: "bridge",
+			ipam:    IPAMStatic,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publisher := NewNADPublisher(nil, "default", tt.cniType, tt.ipam, newTestLogger())
+			got, err := publisher.RenderConfig(attachment)
+			if tt.wantErr {
+				assert.True(t, errors.IsValidationError(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNADPublisher_Apply(t *testing.T) {
+	attachment := entities.NetworkAttachment{Name: "multinic-10-10-0-0-24", InterfaceName: "multinic0", CIDR: "10.10.0.0/24"}
+	publisher := NewNADPublisher(nil, "multinic", CNITypeMacvlan, IPAMWhereabouts, newTestLogger())
+	config, err := publisher.RenderConfig(attachment)
+	require.NoError(t, err)
+
+	existing := func(labels, annotations map[string]string, config string) *networkAttachmentDefinition {
+		nad := &networkAttachmentDefinition{}
+		nad.Metadata.ResourceVersion = "7"
+		nad.Metadata.Labels = labels
+		nad.Metadata.Annotations = annotations
+		nad.Spec.Config = config
+		return nad
+	}
+	managed := map[string]string{managedByLabel: managedByValue}
+	published := map[string]string{CIDRAnnotation: "10.10.0.0/24", MasterAnnotation: "multinic0"}
+
+	tests := []struct {
+		name        string
+		existing    *networkAttachmentDefinition
+		wantMethods []string
+		wantErr     bool
+	}{
+		{
+			name:        "missing definition is created",
+			wantMethods: []string{http.MethodGet, http.MethodPost},
+		},
+		{
+			name:        "changed definition is updated",
+			existing:    existing(managed, published, `{"cniVersion":"0.3.1"}`),
+			wantMethods: []string{http.MethodGet, http.MethodPatch},
+		},
+		{
+			name:        "unchanged definition is left alone",
+			existing:    existing(managed, published, config),
+			wantMethods: []string{http.MethodGet},
+		},
+		{
+			name:        "definition without annotations is annotated",
+			existing:    existing(managed, nil, config),
+			wantMethods: []string{http.MethodGet, http.MethodPatch},
+		},
+		{
+			name:        "hand-written definition is not overwritten",
+			existing:    existing(nil, nil, `{"cniVersion":"0.3.1"}`),
+			wantMethods: []string{http.MethodGet},
+			wantErr:     true,
+		},
+		{
+			name:        "definition published for another master is not overwritten",
+			existing:    existing(managed, map[string]string{CIDRAnnotation: "10.10.0.0/24", MasterAnnotation: "multinic1"}, `{"cniVersion":"0.3.1"}`),
+			wantMethods: []string{http.MethodGet},
+			wantErr:     true,
+		},
+		{
+			name:        "definition published for another subnet is not overwritten",
+			existing:    existing(managed, map[string]string{CIDRAnnotation: "10.10.0.0/16", MasterAnnotation: "multinic0"}, config),
+			wantMethods: []string{http.MethodGet},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var methods []string
+			var written networkAttachmentDefinition
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				methods = append(methods, r.Method)
+				switch r.Method {
+				case http.MethodGet:
+					assert.Equal(t, "/apis/k8s.cni.cncf.io/v1/namespaces/multinic/network-attachment-definitions/multinic-10-10-0-0-24", r.URL.Path)
+					if tt.existing == nil {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					_ = json.NewEncoder(w).Encode(tt.existing)
+				default:
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&written))
+					_, _ = w.Write([]byte("{}"))
+				}
+			}))
+			defer server.Close()
+
+			publisher := NewNADPublisher(newTestClient(t, server), "multinic", CNITypeMacvlan, IPAMWhereabouts, newTestLogger())
+			err := publisher.Apply(context.Background(), attachment)
+
+			assert.Equal(t, tt.wantMethods, methods)
+			if tt.wantErr {
+				assert.True(t, errors.IsConflictError(err))
+				return
+			}
+			require.NoError(t, err)
+			if len(methods) > 1 {
+				assert.Equal(t, config, written.Spec.Config)
+				assert.Equal(t, published, written.Metadata.Annotations)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/errors"
+	"multinic-agent/internal/domain/interfaces"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Node object fields maintained by the agent
+const (
+	// NodeConditionType is the node condition reporting whether all managed interfaces are configured
+	NodeConditionType = "MultiNICReady"
+	// InterfacesAnnotation lists the managed interfaces and their addresses as JSON
+	InterfacesAnnotation = "multinic.io/interfaces"
+)
+
+const (
+	// eventNamespace is where node events live, matching the kubelet
+	eventNamespace = "default"
+	// eventComponent is the source component of published events
+	eventComponent = "multinic-agent"
+)
+
+// NodeReporter publishes NIC configuration results on the node object through the Kubernetes REST API
+type NodeReporter struct {
+	client *Client
+	clock  interfaces.Clock
+	logger *logrus.Logger
+}
+
+// NewNodeReporter creates a new NodeReporter
+func NewNodeReporter(client *Client, clock interfaces.Clock, logger *logrus.Logger) *NodeReporter {
+	return &NodeReporter{
+		client: client,
+		clock:  clock,
+		logger: logger,
+	}
+}
+
+// event is the subset of the core/v1 Event object written by the agent
+type event struct {
+	Metadata           objectMeta      `json:"metadata"`
+	InvolvedObject     objectReference `json:"involvedObject"`
+	Reason             string          `json:"reason"`
+	Message            string          `json:"message"`
+	Type               string          `json:"type"`
+	Source             eventSource     `json:"source"`
+	FirstTimestamp     time.Time       `json:"firstTimestamp"`
+	LastTimestamp      time.Time       `json:"lastTimestamp"`
+	Count              int             `json:"count"`
+	ReportingComponent string          `json:"reportingComponent"`
+	ReportingInstance  string          `json:"reportingInstance"`
+}
+
+type objectMeta struct {
+	GenerateName string            `json:"generateName,omitempty"`
+	Namespace    string            `json:"namespace,omitempty"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+}
+
+type objectReference struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	UID        string `json:"uid"`
+}
+
+type eventSource struct {
+	Component string `json:"component"`
+	Host      string `json:"host"`
+}
+
+// nodeCondition is the core/v1 NodeCondition object
+type nodeCondition struct {
+	Type               string    `json:"type"`
+	Status             string    `json:"status"`
+	Reason             string    `json:"reason"`
+	Message            string    `json:"message"`
+	LastHeartbeatTime  time.Time `json:"lastHeartbeatTime"`
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
+}
+
+// node is the subset of the core/v1 Node object read by the agent
+type node struct {
+	Metadata struct {
+		ResourceVersion string `json:"resourceVersion"`
+	} `json:"metadata"`
+	Spec struct {
+		// Taints are kept as raw objects so that fields unknown to the agent survive a rewrite
+		Taints []map[string]interface{} `json:"taints"`
+	} `json:"spec"`
+	Status struct {
+		Conditions []nodeCondition `json:"conditions"`
+	} `json:"status"`
+}
+
+// RecordEvent creates an event on the node object
+func (r *NodeReporter) RecordEvent(ctx context.Context, nodeName string, e entities.NodeEvent) error {
+	now := r.clock.Now().UTC().Truncate(time.Second)
+	body := event{
+		Metadata: objectMeta{GenerateName: nodeName + ".", Namespace: eventNamespace},
+		// The kubelet uses the node name as the UID of node events
+		InvolvedObject:     objectReference{APIVersion: "v1", Kind: "Node", Name: nodeName, UID: nodeName},
+		Reason:             e.Reason,
+		Message:            e.Message,
+		Type:               string(e.Type),
+		Source:             eventSource{Component: eventComponent, Host: nodeName},
+		FirstTimestamp:     now,
+		LastTimestamp:      now,
+		Count:              1,
+		ReportingComponent: eventComponent,
+		ReportingInstance:  nodeName,
+	}
+
+	path := fmt.Sprintf("/api/v1/namespaces/%s/events", eventNamespace)
+	if err := r.client.do(ctx, http.MethodPost, path, "application/json", body, nil); err != nil {
+		return err
+	}
+
+	r.logger.WithFields(logrus.Fields{
+		"node_name": nodeName,
+		"reason":    e.Reason,
+	}).Debug("Node event recorded")
+	return nil
+}
+
+// UpdateNodeStatus sets the MultiNICReady condition and the managed interfaces annotation
+// The condition's transition time is only moved when its status changes
+func (r *NodeReporter) UpdateNodeStatus(ctx context.Context, nodeName string, report entities.NodeNetworkReport) error {
+	nodePath := nodePath(nodeName)
+
+	var current node
+	if err := r.client.do(ctx, http.MethodGet, nodePath, "", nil, &current); err != nil {
+		return err
+	}
+
+	now := r.clock.Now().UTC().Truncate(time.Second)
+	condition := nodeCondition{
+		Type:               NodeConditionType,
+		Status:             "False",
+		Reason:             report.Reason,
+		Message:            report.Message,
+		LastHeartbeatTime:  now,
+		LastTransitionTime: now,
+	}
+	if report.Ready {
+		condition.Status = "True"
+	}
+	for _, existing := range current.Status.Conditions {
+		if existing.Type == NodeConditionType && existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+	}
+
+	// Conditions are merged by type, so other node conditions are left untouched
+	statusPatch := map[string]interface{}{
+		"status": map[string]interface{}{"conditions": []nodeCondition{condition}},
+	}
+	if err := r.client.do(ctx, http.MethodPatch, nodePath+"/status", "application/strategic-merge-patch+json", statusPatch, nil); err != nil {
+		return err
+	}
+
+	managed, err := json.Marshal(report.Interfaces)
+	if err != nil {
+		return errors.NewSystemError("failed to encode managed interfaces annotation", err)
+	}
+	metadataPatch := map[string]interface{}{
+		"metadata": objectMeta{Annotations: map[string]string{InterfacesAnnotation: string(managed)}},
+	}
+	return r.client.do(ctx, http.MethodPatch, nodePath, "application/merge-patch+json", metadataPatch, nil)
+}
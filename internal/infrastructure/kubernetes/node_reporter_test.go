@@ -0,0 +1,161 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"multinic-agent/internal/domain/entities"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fixedClock struct{ now time.Time }
+
+func (c fixedClock) Now() time.Time { return c.now }
+
+// apiRequest is a request received by the fake API server
+type apiRequest struct {
+	Method      string
+	Path        string
+	ContentType string
+	Body        map[string]interface{}
+}
+
+// newFakeAPIServer serves the given node and records every request
+func newFakeAPIServer(t *testing.T, current node) (*httptest.Server, *[]apiRequest) {
+	var requests []apiRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+
+		req := apiRequest{Method: r.Method, Path: r.URL.Path, ContentType: r.Header.Get("Content-Type")}
+		if payload, _ := io.ReadAll(r.Body); len(payload) > 0 {
+			require.NoError(t, json.Unmarshal(payload, &req.Body))
+		}
+		requests = append(requests, req)
+
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(current)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func newTestClient(t *testing.T, server *httptest.Server) *Client {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("test-token\n"), 0600))
+	return NewClient(server.URL, tokenFile, server.Client())
+}
+
+func newTestLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+	return logger
+}
+
+func TestNodeReporter_RecordEvent(t *testing.T) {
+	server, requests := newFakeAPIServer(t, node{})
+	reporter := NewNodeReporter(newTestClient(t, server), fixedClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}, newTestLogger())
+
+	err := reporter.RecordEvent(context.Background(), "worker-1", entities.NodeEvent{
+		Type:    entities.NodeEventWarning,
+		Reason:  entities.EventReasonInterfaceRolledBack,
+		Message: "Rolled back configuration of interface multinic0",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, *requests, 1)
+	req := (*requests)[0]
+	assert.Equal(t, http.MethodPost, req.Method)
+	assert.Equal(t, "/api/v1/namespaces/default/events", req.Path)
+	assert.Equal(t, "Warning", req.Body["type"])
+	assert.Equal(t, entities.EventReasonInterfaceRolledBack, req.Body["reason"])
+	assert.Equal(t, map[string]interface{}{
+		"apiVersion": "v1", "kind": "Node", "name": "worker-1", "uid": "worker-1",
+	}, req.Body["involvedObject"])
+}
+
+func TestNodeReporter_UpdateNodeStatus(t *testing.T) {
+	transition := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		existing       []nodeCondition
+		ready          bool
+		wantStatus     string
+		wantTransition time.Time
+	}{
+		{
+			name:           "new condition",
+			ready:          true,
+			wantStatus:     "True",
+			wantTransition: now,
+		},
+		{
+			name:           "unchanged status keeps the transition time",
+			existing:       []nodeCondition{{Type: NodeConditionType, Status: "False", LastTransitionTime: transition}},
+			ready:          false,
+			wantStatus:     "False",
+			wantTransition: transition,
+		},
+		{
+			name:           "changed status moves the transition time",
+			existing:       []nodeCondition{{Type: NodeConditionType, Status: "False", LastTransitionTime: transition}},
+			ready:          true,
+			wantStatus:     "True",
+			wantTransition: now,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var current node
+			current.Status.Conditions = tt.existing
+			server, requests := newFakeAPIServer(t, current)
+			reporter := NewNodeReporter(newTestClient(t, server), fixedClock{now: now}, newTestLogger())
+
+			err := reporter.UpdateNodeStatus(context.Background(), "worker-1", entities.NodeNetworkReport{
+				Ready:   tt.ready,
+				Reason:  entities.ConditionReasonInterfacesConfigured,
+				Message: "1 interfaces configured",
+				Interfaces: []entities.ManagedInterface{
+					{Name: "multinic0", MacAddress: "fa:16:3e:00:00:01", Address: "10.0.0.1", Result: entities.ResultConfigured},
+				},
+			})
+			require.NoError(t, err)
+			require.Len(t, *requests, 3)
+
+			status := (*requests)[1]
+			assert.Equal(t, http.MethodPatch, status.Method)
+			assert.Equal(t, "/api/v1/nodes/worker-1/status", status.Path)
+			assert.Equal(t, "application/strategic-merge-patch+json", status.ContentType)
+			conditions := status.Body["status"].(map[string]interface{})["conditions"].([]interface{})
+			require.Len(t, conditions, 1)
+			condition := conditions[0].(map[string]interface{})
+			assert.Equal(t, NodeConditionType, condition["type"])
+			assert.Equal(t, tt.wantStatus, condition["status"])
+			assert.Equal(t, tt.wantTransition.Format(time.RFC3339), condition["lastTransitionTime"])
+
+			metadata := (*requests)[2]
+			assert.Equal(t, "/api/v1/nodes/worker-1", metadata.Path)
+			assert.Equal(t, "application/merge-patch+json", metadata.ContentType)
+			annotations := metadata.Body["metadata"].(map[string]interface{})["annotations"].(map[string]interface{})
+			assert.JSONEq(t,
+				`[{"name":"multinic0","mac":"fa:16:3e:00:00:01","address":"10.0.0.1","result":"configured"}]`,
+				annotations[InterfacesAnnotation].(string))
+		})
+	}
+}
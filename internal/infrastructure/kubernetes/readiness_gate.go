@@ -0,0 +1,103 @@
+package kubernetes
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Node object fields used to keep workloads off a node until its secondary networks are ready
+const (
+	// NotReadyTaintKey is the NoSchedule taint kept on the node while any managed interface is not configured
+	NotReadyTaintKey = "multinic.io/not-ready"
+	// ReadyLabel is the label kept on the node only while every managed interface is configured
+	ReadyLabel = "multinic.io/network-ready"
+)
+
+// NodeTaintGate taints the node while its secondary networks are not ready
+type NodeTaintGate struct {
+	client *Client
+	logger *logrus.Logger
+}
+
+// NewNodeTaintGate creates a new NodeTaintGate
+func NewNodeTaintGate(client *Client, logger *logrus.Logger) *NodeTaintGate {
+	return &NodeTaintGate{client: client, logger: logger}
+}
+
+// SetNetworkReady removes the not-ready taint when ready and adds it otherwise
+// The taint list is replaced as a whole, so the patch carries the resource version it was read at
+// and a concurrent node update makes it fail with a conflict instead of dropping another taint
+func (g *NodeTaintGate) SetNetworkReady(ctx context.Context, nodeName string, ready bool) error {
+	var current node
+	if err := g.client.do(ctx, http.MethodGet, nodePath(nodeName), "", nil, &current); err != nil {
+		return err
+	}
+
+	taints := make([]map[string]interface{}, 0, len(current.Spec.Taints)+1)
+	tainted := false
+	for _, taint := range current.Spec.Taints {
+		if taint["key"] == NotReadyTaintKey {
+			tainted = true
+			if ready {
+				continue
+			}
+		}
+		taints = append(taints, taint)
+	}
+	if ready != tainted {
+		return nil // already in the desired state
+	}
+	if !ready {
+		taints = append(taints, map[string]interface{}{"key": NotReadyTaintKey, "effect": "NoSchedule"})
+	}
+
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{"resourceVersion": current.Metadata.ResourceVersion},
+		"spec":     map[string]interface{}{"taints": taints},
+	}
+	if err := g.client.do(ctx, http.MethodPatch, nodePath(nodeName), "application/merge-patch+json", patch, nil); err != nil {
+		return err
+	}
+
+	g.logger.WithFields(logrus.Fields{
+		"node_name": nodeName,
+		"taint":     NotReadyTaintKey,
+		"tainted":   !ready,
+	}).Info("Node network readiness taint updated")
+	return nil
+}
+
+// NodeLabelGate labels the node only while its secondary networks are ready
+type NodeLabelGate struct {
+	client *Client
+	logger *logrus.Logger
+}
+
+// NewNodeLabelGate creates a new NodeLabelGate
+func NewNodeLabelGate(client *Client, logger *logrus.Logger) *NodeLabelGate {
+	return &NodeLabelGate{client: client, logger: logger}
+}
+
+// SetNetworkReady sets the readiness label when ready and removes it otherwise
+func (g *NodeLabelGate) SetNetworkReady(ctx context.Context, nodeName string, ready bool) error {
+	// A null value removes the label in a merge patch
+	var value interface{}
+	if ready {
+		value = "true"
+	}
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{"labels": map[string]interface{}{ReadyLabel: value}},
+	}
+	if err := g.client.do(ctx, http.MethodPatch, nodePath(nodeName), "application/merge-patch+json", patch, nil); err != nil {
+		return err
+	}
+
+	g.logger.WithFields(logrus.Fields{
+		"node_name": nodeName,
+		"label":     ReadyLabel,
+		"ready":     ready,
+	}).Info("Node network readiness label updated")
+	return nil
+}
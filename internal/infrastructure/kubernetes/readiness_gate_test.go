@@ -0,0 +1,87 @@
+package kubernetes
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeTaintGate_SetNetworkReady(t *testing.T) {
+	otherTaint := map[string]interface{}{"key": "dedicated", "value": "storage", "effect": "NoExecute"}
+	notReadyTaint := map[string]interface{}{"key": NotReadyTaintKey, "effect": "NoSchedule"}
+
+	tests := []struct {
+		name       string
+		taints     []map[string]interface{}
+		ready      bool
+		wantPatch  bool
+		wantTaints []interface{}
+	}{
+		{
+			name:       "not ready adds the taint and keeps others",
+			taints:     []map[string]interface{}{otherTaint},
+			ready:      false,
+			wantPatch:  true,
+			wantTaints: []interface{}{map[string]interface{}(otherTaint), map[string]interface{}(notReadyTaint)},
+		},
+		{
+			name:       "ready removes the taint",
+			taints:     []map[string]interface{}{notReadyTaint, otherTaint},
+			ready:      true,
+			wantPatch:  true,
+			wantTaints: []interface{}{map[string]interface{}(otherTaint)},
+		},
+		{
+			name:      "already tainted",
+			taints:    []map[string]interface{}{notReadyTaint},
+			ready:     false,
+			wantPatch: false,
+		},
+		{
+			name:      "already untainted",
+			ready:     true,
+			wantPatch: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var current node
+			current.Metadata.ResourceVersion = "42"
+			current.Spec.Taints = tt.taints
+			server, requests := newFakeAPIServer(t, current)
+			gate := NewNodeTaintGate(newTestClient(t, server), newTestLogger())
+
+			require.NoError(t, gate.SetNetworkReady(context.Background(), "worker-1", tt.ready))
+
+			if !tt.wantPatch {
+				assert.Len(t, *requests, 1)
+				return
+			}
+			require.Len(t, *requests, 2)
+			patch := (*requests)[1]
+			assert.Equal(t, http.MethodPatch, patch.Method)
+			assert.Equal(t, "/api/v1/nodes/worker-1", patch.Path)
+			assert.Equal(t, "42", patch.Body["metadata"].(map[string]interface{})["resourceVersion"])
+			assert.Equal(t, tt.wantTaints, patch.Body["spec"].(map[string]interface{})["taints"])
+		})
+	}
+}
+
+func TestNodeLabelGate_SetNetworkReady(t *testing.T) {
+	server, requests := newFakeAPIServer(t, node{})
+	gate := NewNodeLabelGate(newTestClient(t, server), newTestLogger())
+
+	require.NoError(t, gate.SetNetworkReady(context.Background(), "worker-1", true))
+	require.NoError(t, gate.SetNetworkReady(context.Background(), "worker-1", false))
+
+	require.Len(t, *requests, 2)
+	labels := func(i int) map[string]interface{} {
+		return (*requests)[i].Body["metadata"].(map[string]interface{})["labels"].(map[string]interface{})
+	}
+	assert.Equal(t, map[string]interface{}{ReadyLabel: "true"}, labels(0))
+	assert.Equal(t, map[string]interface{}{ReadyLabel: nil}, labels(1))
+}
@@ -40,6 +40,14 @@ var (
 		},
 	)
 
+	ReconcileCycles = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "multinic_reconcile_cycles_total",
+			Help: "Total number of polling cycles by whether a full reconcile ran",
+		},
+		[]string{"mode"}, // full, skipped
+	)
+
 	PollingBackoffLevel = promauto.NewGauge(
 		prometheus.GaugeOpts{
 			Name: "multinic_polling_backoff_level",
@@ -89,6 +97,47 @@ var (
 		},
 	)
 
+	// 고아 삭제 안전 규칙 메트릭
+	OrphanDeletionsBlocked = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "multinic_orphan_deletions_blocked_total",
+			Help: "Total number of orphaned interface deletions blocked by safety rules",
+		},
+		[]string{"reason"}, // max_deletions, max_percent
+	)
+
+	OrphanDeletionsPending = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "multinic_orphan_deletions_pending",
+			Help: "Number of orphaned interfaces currently held back by safety rules",
+		},
+	)
+
+	// 고아 인터페이스 격리 메트릭
+	QuarantineOperations = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "multinic_quarantine_operations_total",
+			Help: "Total number of quarantine operations on orphaned interfaces",
+		},
+		[]string{"operation"}, // quarantined, restored, purged
+	)
+
+	QuarantinedInterfaces = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "multinic_quarantined_interfaces",
+			Help: "Number of orphaned interfaces currently held in quarantine",
+		},
+	)
+
+	// 삭제된 인터페이스의 커널 링크 정리 메트릭
+	LinkCleanups = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "multinic_link_cleanups_total",
+			Help: "Total number of live link cleanups after interface deletion",
+		},
+		[]string{"result"}, // cleaned, absent, failed
+	)
+
 	// 에러 메트릭
 	ErrorsTotal = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -120,6 +169,11 @@ func RecordPollingCycle(duration float64) {
 	PollingCycleDuration.Observe(duration)
 }
 
+// RecordReconcileCycle은 전체 reconcile 실행 또는 생략을 기록합니다
+func RecordReconcileCycle(mode string) {
+	ReconcileCycles.WithLabelValues(mode).Inc()
+}
+
 // RecordDBQuery는 데이터베이스 쿼리 시간을 기록합니다
 func RecordDBQuery(queryType string, duration float64) {
 	DBQueryDuration.WithLabelValues(queryType).Observe(duration)
@@ -135,6 +189,26 @@ func RecordDrift(driftType string) {
 	ConfigurationDrifts.WithLabelValues(driftType).Inc()
 }
 
+// RecordOrphanDeletionBlocked는 안전 규칙으로 차단된 고아 삭제를 기록합니다
+func RecordOrphanDeletionBlocked(reason string, count int) {
+	OrphanDeletionsBlocked.WithLabelValues(reason).Add(float64(count))
+}
+
+// SetOrphanDeletionsPending은 안전 규칙으로 보류 중인 고아 삭제 수를 설정합니다
+func SetOrphanDeletionsPending(count float64) {
+	OrphanDeletionsPending.Set(count)
+}
+
+// SetQuarantinedInterfaces는 현재 격리 중인 인터페이스 수를 설정합니다
+func SetQuarantinedInterfaces(count float64) {
+	QuarantinedInterfaces.Set(count)
+}
+
+// RecordLinkCleanup은 커널 링크 정리 결과를 기록합니다
+func RecordLinkCleanup(result string) {
+	LinkCleanups.WithLabelValues(result).Inc()
+}
+
 // SetConcurrentTasks는 현재 동시 처리 중인 작업 수를 설정합니다
 func SetConcurrentTasks(count float64) {
 	ConcurrentTasks.Set(count)
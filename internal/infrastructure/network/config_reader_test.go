@@ -0,0 +1,216 @@
+package network
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/interfaces"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// Both backends must parse their own rendered files into the same normalized configuration
+func TestConfigReaders_ReadConfig(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	expected := &entities.InterfaceConfig{
+		Name:       "multinic0",
+		MacAddress: "fa:16:3e:00:be:63",
+		Address:    "192.168.1.10",
+		CIDR:       "192.168.1.0/24",
+		MTU:        1450,
+	}
+
+	t.Run("Netplan", func(t *testing.T) {
+		fs := new(MockFileSystem)
+		adapter := NewNetplanAdapter(new(MockCommandExecutor), fs, logger, "test-agent")
+
+		content := `network:
+  version: 2
+  ethernets:
+    multinic0:
+      match:
+        macaddress: FA:16:3E:00:BE:63
+      set-name: multinic0
+      dhcp4: false
+      addresses: ["192.168.1.10/24"]
+      mtu: 1450`
+		fs.On("ReadFile", "/etc/netplan/90-multinic0.yaml").Return([]byte(content), nil)
+
+		config, err := adapter.ReadConfig(adapter.ConfigPath("multinic0"))
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, config)
+	})
+
+	t.Run("ifcfg", func(t *testing.T) {
+		fs := new(MockFileSystem)
+		executor := new(MockCommandExecutor)
+		executor.On("ExecuteWithTimeout", mock.Anything, 1*time.Second, "test", "-d", "/host").
+			Return([]byte(""), errors.New("not found"))
+		adapter := NewRHELAdapter(executor, fs, logger, "test-agent")
+
+		content := adapter.GenerateIfcfgContentForTest(entities.NetworkInterface{
+			MacAddress: "FA:16:3E:00:BE:63",
+			Address:    "192.168.1.10",
+			CIDR:       "192.168.1.0/24",
+			MTU:        1450,
+		}, "multinic0")
+		fs.On("ReadFile", "/etc/sysconfig/network-scripts/ifcfg-multinic0").Return([]byte(content), nil)
+
+		config, err := adapter.ReadConfig(adapter.ConfigPath("multinic0"))
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, config)
+	})
+}
+
+func TestNetplanAdapter_FindConfigPath(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+	fs := new(MockFileSystem)
+	adapter := NewNetplanAdapter(new(MockCommandExecutor), fs, logger, "test-agent")
+
+	fs.On("Exists", "/etc/netplan/91-multinic1.yaml").Return(false)
+	fs.On("ListFiles", "/etc/netplan").Return([]string{"50-cloud-init.yaml", "90-multinic10.yaml", "80-multinic1.yaml"}, nil)
+
+	assert.Equal(t, "/etc/netplan/80-multinic1.yaml", adapter.FindConfigPath("multinic1"))
+
+	// 설정 가능한 짧은 이름은 다른 파일 이름의 접미사와 일치하지 않아야 함
+	fs.On("Exists", "/etc/netplan/99-data.yaml").Return(false)
+	fs.On("Exists", "/etc/netplan/99-a.yaml").Return(false)
+	fs.On("Exists", "/etc/netplan/99-b.yaml").Return(false)
+	fs.On("ListFiles", "/etc/netplan").Unset()
+	fs.On("ListFiles", "/etc/netplan").Return([]string{"50-cloud-init-data.yaml", "99-tenant-a.yaml", "b.yaml"}, nil)
+	assert.Empty(t, adapter.FindConfigPath("data"))
+	assert.Empty(t, adapter.FindConfigPath("a"))
+	assert.Equal(t, "/etc/netplan/b.yaml", adapter.FindConfigPath("b"))
+}
+
+func TestNetplanAdapter_ConfigPath(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+	adapter := NewNetplanAdapter(new(MockCommandExecutor), new(MockFileSystem), logger, "test-agent")
+
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"multinic0", "/etc/netplan/90-multinic0.yaml"},
+		{"multinic9", "/etc/netplan/99-multinic9.yaml"},
+		{"multinic12", "/etc/netplan/99-multinic12.yaml"},
+		{"nstorage3", "/etc/netplan/93-nstorage3.yaml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, adapter.ConfigPath(tt.name))
+		})
+	}
+}
+
+func TestConfigReaders_ReadConfig_Ownership(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+	executor := new(MockCommandExecutor)
+	executor.On("ExecuteWithTimeout", mock.Anything, 1*time.Second, "test", "-d", "/host").
+		Return([]byte(""), errors.New("not found"))
+	fs := new(MockFileSystem)
+	adapter := NewRHELAdapter(executor, fs, logger, "test-agent")
+
+	iface := entities.NetworkInterface{ID: 7, MacAddress: "FA:16:3E:00:BE:63", MTU: 1450}
+	body := adapter.GenerateIfcfgContentForTest(iface, "multinic0")
+	rendered := withOwnership("test-agent", iface, []byte(body))
+	tampered := strings.Replace(string(rendered), "MTU=1450", "MTU=9000", 1)
+
+	fs.On("ReadFile", "/etc/sysconfig/network-scripts/ifcfg-multinic0").Return(rendered, nil)
+	fs.On("ReadFile", "/etc/sysconfig/network-scripts/ifcfg-multinic1").Return([]byte(tampered), nil)
+	fs.On("ReadFile", "/etc/sysconfig/network-scripts/ifcfg-multinic2").Return([]byte(body), nil)
+
+	config, err := adapter.ReadConfig(adapter.ConfigPath("multinic0"))
+	require.NoError(t, err)
+	require.NotNil(t, config.Ownership)
+	assert.Equal(t, "test-agent", config.Ownership.AgentID)
+	assert.Equal(t, 7, config.Ownership.InterfaceID)
+	assert.Equal(t, "fa:16:3e:00:be:63", config.Ownership.MacAddress)
+	assert.False(t, config.Tampered)
+
+	config, err = adapter.ReadConfig(adapter.ConfigPath("multinic1"))
+	require.NoError(t, err)
+	assert.NotNil(t, config.Ownership)
+	assert.True(t, config.Tampered, "hand-edited file")
+	assert.Equal(t, 9000, config.MTU)
+
+	config, err = adapter.ReadConfig(adapter.ConfigPath("multinic2"))
+	require.NoError(t, err)
+	assert.Nil(t, config.Ownership, "file without ownership header")
+}
+
+// Rendered output must be what ReadConfig parses back as an intact, owned configuration
+func TestConfigRenderers_RenderConfig(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+	executor := new(MockCommandExecutor)
+	executor.On("ExecuteWithTimeout", mock.Anything, 1*time.Second, "test", "-d", "/host").
+		Return([]byte(""), errors.New("not found"))
+
+	iface := entities.NetworkInterface{
+		ID:         3,
+		MacAddress: "FA:16:3E:00:BE:63",
+		Address:    "192.168.1.10",
+		CIDR:       "192.168.1.0/24",
+		MTU:        1450,
+	}
+	name, err := entities.NewInterfaceName("multinic0")
+	require.NoError(t, err)
+
+	type renderReader interface {
+		interfaces.ConfigRenderer
+		interfaces.ConfigReader
+	}
+
+	tests := []struct {
+		name     string
+		adapter  func(fs *MockFileSystem) renderReader
+		wantPath string
+	}{
+		{
+			name:     "Netplan",
+			adapter:  func(fs *MockFileSystem) renderReader { return NewNetplanAdapter(executor, fs, logger, "test-agent") },
+			wantPath: "/etc/netplan/90-multinic0.yaml",
+		},
+		{
+			name:     "ifcfg",
+			adapter:  func(fs *MockFileSystem) renderReader { return NewRHELAdapter(executor, fs, logger, "test-agent") },
+			wantPath: "/etc/sysconfig/network-scripts/ifcfg-multinic0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := new(MockFileSystem)
+			adapter := tt.adapter(fs)
+
+			path, content, err := adapter.RenderConfig(iface, name)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPath, path)
+			fs.AssertNotCalled(t, "WriteFile", mock.Anything, mock.Anything, mock.Anything)
+
+			fs.On("ReadFile", path).Return(content, nil)
+			config, err := adapter.ReadConfig(path)
+			require.NoError(t, err)
+			require.NotNil(t, config.Ownership)
+			assert.Equal(t, 3, config.Ownership.InterfaceID)
+			assert.False(t, config.Tampered)
+			assert.Equal(t, "192.168.1.10", config.Address)
+			assert.Equal(t, 1450, config.MTU)
+		})
+	}
+}
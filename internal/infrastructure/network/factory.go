@@ -13,6 +13,7 @@ type NetworkManagerFactory struct {
 	commandExecutor interfaces.CommandExecutor
 	fileSystem      interfaces.FileSystem
 	logger          *logrus.Logger
+	agentID         string
 }
 
 // NewNetworkManagerFactory creates a new NetworkManagerFactory
@@ -21,12 +22,14 @@ func NewNetworkManagerFactory(
 	executor interfaces.CommandExecutor,
 	fs interfaces.FileSystem,
 	logger *logrus.Logger,
+	agentID string,
 ) *NetworkManagerFactory {
 	return &NetworkManagerFactory{
 		osDetector:      osDetector,
 		commandExecutor: executor,
 		fileSystem:      fs,
 		logger:          logger,
+		agentID:         agentID,
 	}
 }
 
@@ -45,6 +48,7 @@ func (f *NetworkManagerFactory) CreateNetworkConfigurer() (interfaces.NetworkCon
 			f.commandExecutor,
 			f.fileSystem,
 			f.logger,
+			f.agentID,
 		), nil
 
 	case interfaces.OSTypeRHEL:
@@ -52,6 +56,7 @@ func (f *NetworkManagerFactory) CreateNetworkConfigurer() (interfaces.NetworkCon
 			f.commandExecutor,
 			f.fileSystem,
 			f.logger,
+			f.agentID,
 		), nil
 
 	default:
@@ -74,3 +79,32 @@ func (f *NetworkManagerFactory) CreateNetworkRollbacker() (interfaces.NetworkRol
 
 	return nil, errors.NewSystemError("network manager does not support rollback functionality", nil)
 }
+
+// CreateConfigReader creates appropriate ConfigReader based on OS
+func (f *NetworkManagerFactory) CreateConfigReader() (interfaces.ConfigReader, error) {
+	// Same implementation that renders the files also parses them
+	configurer, err := f.CreateNetworkConfigurer()
+	if err != nil {
+		return nil, err
+	}
+
+	if reader, ok := configurer.(interfaces.ConfigReader); ok {
+		return reader, nil
+	}
+
+	return nil, errors.NewSystemError("network manager does not support reading configuration files", nil)
+}
+
+// CreateConfigRenderer creates appropriate ConfigRenderer based on OS
+func (f *NetworkManagerFactory) CreateConfigRenderer() (interfaces.ConfigRenderer, error) {
+	configurer, err := f.CreateNetworkConfigurer()
+	if err != nil {
+		return nil, err
+	}
+
+	if renderer, ok := configurer.(interfaces.ConfigRenderer); ok {
+		return renderer, nil
+	}
+
+	return nil, errors.NewSystemError("network manager does not support rendering configuration files", nil)
+}
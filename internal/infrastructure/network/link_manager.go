@@ -0,0 +1,277 @@
+package network
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/errors"
+	"multinic-agent/internal/domain/interfaces"
+
+	"github.com/sirupsen/logrus"
+)
+
+// IPLinkManager is a LinkManager implementation using the iproute2 `ip` command
+type IPLinkManager struct {
+	commandExecutor interfaces.CommandExecutor
+	logger          *logrus.Logger
+	isContainer     bool // indicates if running in container
+}
+
+// NewIPLinkManager creates a new IPLinkManager
+func NewIPLinkManager(executor interfaces.CommandExecutor, logger *logrus.Logger) *IPLinkManager {
+	// Check if running in container by checking if /host exists
+	isContainer := false
+	if _, err := executor.ExecuteWithTimeout(context.Background(), 1*time.Second, "test", "-d", "/host"); err == nil {
+		isContainer = true
+	}
+
+	return &IPLinkManager{
+		commandExecutor: executor,
+		logger:          logger,
+		isContainer:     isContainer,
+	}
+}
+
+// execCommand is a helper method to execute commands with nsenter if in container
+func (m *IPLinkManager) execCommand(ctx context.Context, command string, args ...string) ([]byte, error) {
+	if m.isContainer {
+		// In container environment, use nsenter to run in host namespace
+		cmdArgs := []string{"--target", "1", "--mount", "--uts", "--ipc", "--net", "--pid", command}
+		cmdArgs = append(cmdArgs, args...)
+		return m.commandExecutor.ExecuteWithTimeout(ctx, 10*time.Second, "nsenter", cmdArgs...)
+	}
+	// Direct execution on host
+	return m.commandExecutor.ExecuteWithTimeout(ctx, 10*time.Second, command, args...)
+}
+
+// SetLinkUp brings the link up
+func (m *IPLinkManager) SetLinkUp(ctx context.Context, name string) error {
+	if _, err := m.execCommand(ctx, "ip", "link", "set", name, "up"); err != nil {
+		return errors.NewNetworkError(fmt.Sprintf("failed to bring up link %s", name), err)
+	}
+
+	m.logger.WithField("interface", name).Debug("Link set up")
+	return nil
+}
+
+// SetLinkDown brings the link down
+func (m *IPLinkManager) SetLinkDown(ctx context.Context, name string) error {
+	if _, err := m.execCommand(ctx, "ip", "link", "set", name, "down"); err != nil {
+		return errors.NewNetworkError(fmt.Sprintf("failed to bring down link %s", name), err)
+	}
+
+	m.logger.WithField("interface", name).Debug("Link set down")
+	return nil
+}
+
+// FlushLink removes all IPv4/IPv6 addresses, routes and policy routing rules bound to the link
+func (m *IPLinkManager) FlushLink(ctx context.Context, name string) error {
+	for _, family := range []string{"-4", "-6"} {
+		if _, err := m.execCommand(ctx, "ip", family, "addr", "flush", "dev", name); err != nil {
+			return errors.NewNetworkError(fmt.Sprintf("failed to flush %s addresses on %s", family, name), err)
+		}
+		if _, err := m.execCommand(ctx, "ip", family, "route", "flush", "dev", name); err != nil {
+			return errors.NewNetworkError(fmt.Sprintf("failed to flush %s routes on %s", family, name), err)
+		}
+		if err := m.flushRules(ctx, family, name); err != nil {
+			return err
+		}
+	}
+
+	m.logger.WithField("interface", name).Debug("Link addresses, routes and rules flushed")
+	return nil
+}
+
+// ipRule is a subset of the `ip -j rule show` output
+type ipRule struct {
+	Priority int    `json:"priority"`
+	Src      string `json:"src"`
+	SrcLen   int    `json:"srclen"`
+	Dst      string `json:"dst"`
+	DstLen   int    `json:"dstlen"`
+	Iif      string `json:"iif"`
+	Oif      string `json:"oif"`
+	Table    string `json:"table"`
+}
+
+// selector returns the `ip rule del` arguments matching exactly this rule
+// Other rules may share the priority, and deleting by priority alone removes whichever the kernel finds first
+func (r ipRule) selector() []string {
+	args := []string{"priority", strconv.Itoa(r.Priority)}
+	if r.Src != "" && r.Src != "all" {
+		args = append(args, "from", prefix(r.Src, r.SrcLen))
+	}
+	if r.Dst != "" && r.Dst != "all" {
+		args = append(args, "to", prefix(r.Dst, r.DstLen))
+	}
+	if r.Iif != "" {
+		args = append(args, "iif", r.Iif)
+	}
+	if r.Oif != "" {
+		args = append(args, "oif", r.Oif)
+	}
+	if r.Table != "" {
+		args = append(args, "table", r.Table)
+	}
+	return args
+}
+
+// prefix formats a rule address with its prefix length (a zero length means a host address)
+func prefix(address string, length int) string {
+	if length == 0 {
+		return address
+	}
+	return address + "/" + strconv.Itoa(length)
+}
+
+// flushRules deletes policy routing rules that reference the link as iif or oif
+func (m *IPLinkManager) flushRules(ctx context.Context, family, name string) error {
+	output, err := m.execCommand(ctx, "ip", "-j", family, "rule", "show")
+	if err != nil {
+		return errors.NewNetworkError(fmt.Sprintf("failed to list %s rules", family), err)
+	}
+
+	var rules []ipRule
+	if len(strings.TrimSpace(string(output))) > 0 {
+		if err := json.Unmarshal(output, &rules); err != nil {
+			return errors.NewNetworkError(fmt.Sprintf("failed to parse %s rules", family), err)
+		}
+	}
+
+	for _, rule := range rules {
+		if rule.Iif != name && rule.Oif != name {
+			continue
+		}
+		args := append([]string{family, "rule", "del"}, rule.selector()...)
+		if _, err := m.execCommand(ctx, "ip", args...); err != nil {
+			return errors.NewNetworkError(fmt.Sprintf("failed to delete rule %d for %s", rule.Priority, name), err)
+		}
+	}
+
+	return nil
+}
+
+// RenameLink renames the link. The link must be down.
+func (m *IPLinkManager) RenameLink(ctx context.Context, name, newName string) error {
+	if _, err := m.execCommand(ctx, "ip", "link", "set", name, "name", newName); err != nil {
+		return errors.NewNetworkError(fmt.Sprintf("failed to rename link %s to %s", name, newName), err)
+	}
+
+	m.logger.WithFields(logrus.Fields{
+		"interface": name,
+		"new_name":  newName,
+	}).Debug("Link renamed")
+	return nil
+}
+
+// kernelNameProperties are udev predictable name properties in systemd's default policy order
+var kernelNameProperties = []string{"ID_NET_NAME_ONBOARD", "ID_NET_NAME_SLOT", "ID_NET_NAME_PATH", "ID_NET_NAME_MAC"}
+
+// GetKernelName returns the predictable interface name that udev would assign to the link
+func (m *IPLinkManager) GetKernelName(ctx context.Context, name string) (string, error) {
+	output, err := m.execCommand(ctx, "udevadm", "info", "--query=property", "--path=/sys/class/net/"+name)
+	if err != nil {
+		return "", errors.NewSystemError(fmt.Sprintf("failed to query udev properties for %s", name), err)
+	}
+
+	properties := make(map[string]string)
+	scanner := bufio.NewScanner(strings.NewReader(string(output)))
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(strings.TrimSpace(scanner.Text()), "=")
+		if ok {
+			properties[key] = value
+		}
+	}
+
+	for _, key := range kernelNameProperties {
+		if value := properties[key]; value != "" {
+			return value, nil
+		}
+	}
+
+	return "", errors.NewNotFoundError(fmt.Sprintf("no kernel name found for %s", name))
+}
+
+// ipAddrLink is a subset of the `ip -j addr show` output
+type ipAddrLink struct {
+	IfName    string   `json:"ifname"`
+	Flags     []string `json:"flags"`
+	MTU       int      `json:"mtu"`
+	OperState string   `json:"operstate"`
+	Address   string   `json:"address"`
+	AddrInfo  []struct {
+		Local     string `json:"local"`
+		PrefixLen int    `json:"prefixlen"`
+	} `json:"addr_info"`
+}
+
+// GetLinkState returns the current state of the link, or a NotFound error if it does not exist
+func (m *IPLinkManager) GetLinkState(ctx context.Context, name string) (*entities.LinkState, error) {
+	output, err := m.execCommand(ctx, "ip", "-j", "addr", "show", "dev", name)
+	if err != nil {
+		if strings.Contains(err.Error(), "does not exist") {
+			return nil, errors.NewNotFoundError(fmt.Sprintf("link %s does not exist", name))
+		}
+		return nil, errors.NewNetworkError(fmt.Sprintf("failed to get link state for %s", name), err)
+	}
+
+	states, err := parseLinkStates(output)
+	if err != nil {
+		return nil, errors.NewNetworkError(fmt.Sprintf("failed to parse link state for %s", name), err)
+	}
+	if len(states) == 0 {
+		return nil, errors.NewNotFoundError(fmt.Sprintf("link %s does not exist", name))
+	}
+
+	return &states[0], nil
+}
+
+// ListLinks returns the state of every link present in the kernel
+func (m *IPLinkManager) ListLinks(ctx context.Context) ([]entities.LinkState, error) {
+	output, err := m.execCommand(ctx, "ip", "-j", "addr", "show")
+	if err != nil {
+		return nil, errors.NewNetworkError("failed to list links", err)
+	}
+
+	states, err := parseLinkStates(output)
+	if err != nil {
+		return nil, errors.NewNetworkError("failed to parse link list", err)
+	}
+
+	return states, nil
+}
+
+// parseLinkStates converts `ip -j addr show` output into link states
+func parseLinkStates(output []byte) ([]entities.LinkState, error) {
+	var links []ipAddrLink
+	if err := json.Unmarshal(output, &links); err != nil {
+		return nil, err
+	}
+
+	states := make([]entities.LinkState, 0, len(links))
+	for _, link := range links {
+		state := entities.LinkState{
+			Name:       link.IfName,
+			MacAddress: link.Address,
+			MTU:        link.MTU,
+			OperState:  link.OperState,
+		}
+		for _, flag := range link.Flags {
+			if flag == "UP" {
+				state.AdminUp = true
+			}
+		}
+		for _, addr := range link.AddrInfo {
+			state.Addresses = append(state.Addresses, fmt.Sprintf("%s/%d", addr.Local, addr.PrefixLen))
+		}
+		states = append(states, state)
+	}
+
+	return states, nil
+}
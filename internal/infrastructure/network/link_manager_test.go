@@ -0,0 +1,102 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	multinicErrors "multinic-agent/internal/domain/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLinkManager(executor *MockCommandExecutor) *IPLinkManager {
+	executor.On("ExecuteWithTimeout", mock.Anything, 1*time.Second, "test", "-d", "/host").
+		Return([]byte(""), errors.New("not found")).Once()
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+	return NewIPLinkManager(executor, logger)
+}
+
+func TestIPLinkManager_GetLinkState(t *testing.T) {
+	executor := new(MockCommandExecutor)
+	manager := newTestLinkManager(executor)
+
+	output := `[{"ifindex":3,"ifname":"multinic0","flags":["BROADCAST","MULTICAST","UP","LOWER_UP"],"mtu":1450,"operstate":"UP","address":"fa:16:3e:00:be:63","addr_info":[{"family":"inet","local":"192.168.1.10","prefixlen":24}]}]`
+	executor.On("ExecuteWithTimeout", mock.Anything, 10*time.Second, "ip", "-j", "addr", "show", "dev", "multinic0").
+		Return([]byte(output), nil)
+	executor.On("ExecuteWithTimeout", mock.Anything, 10*time.Second, "ip", "-j", "addr", "show", "dev", "multinic9").
+		Return([]byte(nil), errors.New(`stderr: Device "multinic9" does not exist.`))
+
+	state, err := manager.GetLinkState(context.Background(), "multinic0")
+	require.NoError(t, err)
+	assert.Equal(t, "multinic0", state.Name)
+	assert.Equal(t, "fa:16:3e:00:be:63", state.MacAddress)
+	assert.Equal(t, 1450, state.MTU)
+	assert.True(t, state.AdminUp)
+	assert.Equal(t, []string{"192.168.1.10/24"}, state.Addresses)
+
+	_, err = manager.GetLinkState(context.Background(), "multinic9")
+	assert.True(t, multinicErrors.IsNotFoundError(err))
+}
+
+func TestIPLinkManager_FlushLink(t *testing.T) {
+	executor := new(MockCommandExecutor)
+	manager := newTestLinkManager(executor)
+
+	for _, family := range []string{"-4", "-6"} {
+		executor.On("ExecuteWithTimeout", mock.Anything, 10*time.Second, "ip", family, "addr", "flush", "dev", "multinic0").Return([]byte(""), nil)
+		executor.On("ExecuteWithTimeout", mock.Anything, 10*time.Second, "ip", family, "route", "flush", "dev", "multinic0").Return([]byte(""), nil)
+	}
+	executor.On("ExecuteWithTimeout", mock.Anything, 10*time.Second, "ip", "-j", "-4", "rule", "show").
+		Return([]byte(`[{"priority":0,"src":"all","table":"local"},{"priority":100,"src":"all","iif":"multinic0","table":"100"},{"priority":101,"src":"all","oif":"eth0","table":"101"}]`), nil)
+	executor.On("ExecuteWithTimeout", mock.Anything, 10*time.Second, "ip", "-j", "-6", "rule", "show").
+		Return([]byte(`[]`), nil)
+	executor.On("ExecuteWithTimeout", mock.Anything, 10*time.Second, "ip", "-4", "rule", "del", "priority", "100", "iif", "multinic0", "table", "100").Return([]byte(""), nil)
+
+	err := manager.FlushLink(context.Background(), "multinic0")
+
+	assert.NoError(t, err)
+	executor.AssertExpectations(t)
+	executor.AssertNotCalled(t, "ExecuteWithTimeout", mock.Anything, 10*time.Second, "ip", "-4", "rule", "del", "priority", "101", "oif", "eth0", "table", "101")
+}
+
+func TestIPLinkManager_FlushLink_SharedPriority(t *testing.T) {
+	executor := new(MockCommandExecutor)
+	manager := newTestLinkManager(executor)
+
+	for _, family := range []string{"-4", "-6"} {
+		executor.On("ExecuteWithTimeout", mock.Anything, 10*time.Second, "ip", family, "addr", "flush", "dev", "multinic0").Return([]byte(""), nil)
+		executor.On("ExecuteWithTimeout", mock.Anything, 10*time.Second, "ip", family, "route", "flush", "dev", "multinic0").Return([]byte(""), nil)
+	}
+	// 같은 우선순위의 다른 도구 규칙이 먼저 나열되어도 이 링크의 규칙만 전체 선택자로 삭제
+	executor.On("ExecuteWithTimeout", mock.Anything, 10*time.Second, "ip", "-j", "-4", "rule", "show").
+		Return([]byte(`[{"priority":1000,"src":"10.0.0.0","srclen":8,"table":"200"},{"priority":1000,"src":"192.168.1.10","oif":"multinic0","table":"100"}]`), nil)
+	executor.On("ExecuteWithTimeout", mock.Anything, 10*time.Second, "ip", "-j", "-6", "rule", "show").
+		Return([]byte(`[]`), nil)
+	executor.On("ExecuteWithTimeout", mock.Anything, 10*time.Second, "ip", "-4", "rule", "del", "priority", "1000", "from", "192.168.1.10", "oif", "multinic0", "table", "100").Return([]byte(""), nil)
+
+	err := manager.FlushLink(context.Background(), "multinic0")
+
+	assert.NoError(t, err)
+	executor.AssertExpectations(t)
+	executor.AssertNotCalled(t, "ExecuteWithTimeout", mock.Anything, 10*time.Second, "ip", "-4", "rule", "del", "priority", "1000")
+	executor.AssertNotCalled(t, "ExecuteWithTimeout", mock.Anything, 10*time.Second, "ip", "-4", "rule", "del", "priority", "1000", "from", "10.0.0.0/8", "table", "200")
+}
+
+func TestIPLinkManager_GetKernelName(t *testing.T) {
+	executor := new(MockCommandExecutor)
+	manager := newTestLinkManager(executor)
+
+	executor.On("ExecuteWithTimeout", mock.Anything, 10*time.Second, "udevadm", "info", "--query=property", "--path=/sys/class/net/multinic0").
+		Return([]byte("INTERFACE=multinic0\nID_NET_NAME_MAC=enxfa163e00be63\nID_NET_NAME_PATH=enp0s5\nID_NET_NAME_SLOT=ens5\n"), nil)
+
+	name, err := manager.GetKernelName(context.Background(), "multinic0")
+
+	require.NoError(t, err)
+	assert.Equal(t, "ens5", name)
+}
@@ -3,6 +3,7 @@ package network
 import (
 	"context"
 	"fmt"
+	"multinic-agent/internal/domain/constants"
 	"multinic-agent/internal/domain/entities"
 	"multinic-agent/internal/domain/errors"
 	"multinic-agent/internal/domain/interfaces"
@@ -20,6 +21,7 @@ type NetplanAdapter struct {
 	commandExecutor interfaces.CommandExecutor
 	fileSystem      interfaces.FileSystem
 	logger          *logrus.Logger
+	agentID         string // recorded in the ownership header of rendered files
 	configDir       string
 }
 
@@ -28,12 +30,14 @@ func NewNetplanAdapter(
 	executor interfaces.CommandExecutor,
 	fs interfaces.FileSystem,
 	logger *logrus.Logger,
+	agentID string,
 ) *NetplanAdapter {
 	return &NetplanAdapter{
 		commandExecutor: executor,
 		fileSystem:      fs,
 		logger:          logger,
-		configDir:       "/etc/netplan",
+		agentID:         agentID,
+		configDir:       constants.NetplanConfigDir,
 	}
 }
 
@@ -44,17 +48,12 @@ func (a *NetplanAdapter) GetConfigDir() string {
 
 // Configure configures a network interface
 func (a *NetplanAdapter) Configure(ctx context.Context, iface entities.NetworkInterface, name entities.InterfaceName) error {
-	// Generate configuration file path
-	index := extractInterfaceIndex(name.String())
-	configPath := filepath.Join(a.configDir, fmt.Sprintf("9%d-%s.yaml", index, name.String()))
-
 	// Backup logic removed - overwrite existing configuration file if it exists
 
 	// Generate Netplan configuration
-	config := a.generateNetplanConfig(iface, name.String())
-	configData, err := yaml.Marshal(config)
+	configPath, configData, err := a.RenderConfig(iface, name)
 	if err != nil {
-		return errors.NewSystemError("failed to marshal Netplan configuration", err)
+		return err
 	}
 
 	// Save configuration file
@@ -88,6 +87,16 @@ func (a *NetplanAdapter) Configure(ctx context.Context, iface entities.NetworkIn
 	return nil
 }
 
+// RenderConfig returns the configuration file path and the Netplan content Configure would write
+func (a *NetplanAdapter) RenderConfig(iface entities.NetworkInterface, name entities.InterfaceName) (string, []byte, error) {
+	config := a.generateNetplanConfig(iface, name.String())
+	configData, err := yaml.Marshal(config)
+	if err != nil {
+		return "", nil, errors.NewSystemError("failed to marshal Netplan configuration", err)
+	}
+	return a.ConfigPath(name.String()), withOwnership(a.agentID, iface, configData), nil
+}
+
 // Validate verifies that the configured interface is working properly
 func (a *NetplanAdapter) Validate(ctx context.Context, name entities.InterfaceName) error {
 	// Check if interface exists
@@ -107,8 +116,7 @@ func (a *NetplanAdapter) Validate(ctx context.Context, name entities.InterfaceNa
 
 // Rollback reverts the interface configuration to the previous state
 func (a *NetplanAdapter) Rollback(ctx context.Context, name string) error {
-	index := extractInterfaceIndex(name)
-	configPath := filepath.Join(a.configDir, fmt.Sprintf("9%d-%s.yaml", index, name))
+	configPath := a.ConfigPath(name)
 
 	// Remove configuration file
 	if a.fileSystem.Exists(configPath) {
@@ -171,9 +179,6 @@ func (a *NetplanAdapter) generateNetplanConfig(iface entities.NetworkInterface,
 
 			ethernetConfig["dhcp4"] = false
 			ethernetConfig["addresses"] = []string{fullAddress}
-			if iface.MTU > 0 {
-				ethernetConfig["mtu"] = iface.MTU
-			}
 		} else {
 			a.logger.WithFields(logrus.Fields{
 				"address": iface.Address,
@@ -182,6 +187,10 @@ func (a *NetplanAdapter) generateNetplanConfig(iface entities.NetworkInterface,
 		}
 	}
 
+	if iface.MTU > 0 {
+		ethernetConfig["mtu"] = iface.MTU
+	}
+
 	config := map[string]interface{}{
 		"network": map[string]interface{}{
 			"version": 2,
@@ -194,14 +203,126 @@ func (a *NetplanAdapter) generateNetplanConfig(iface entities.NetworkInterface,
 	return config
 }
 
-// extractInterfaceIndex extracts the index from interface name
-func extractInterfaceIndex(name string) int {
-	// multinic0 -> 0, multinic1 -> 1 etc
-	if strings.HasPrefix(name, "multinic") {
-		indexStr := strings.TrimPrefix(name, "multinic")
-		if index, err := strconv.Atoi(indexStr); err == nil {
-			return index
+// ConfigPath returns the path of the Netplan file for the interface
+func (a *NetplanAdapter) ConfigPath(name string) string {
+	return filepath.Join(a.configDir, fmt.Sprintf("%s-%s.yaml", netplanFilePriority(name), name))
+}
+
+// FindConfigPath returns the path of an existing Netplan file for the interface, or an empty string
+func (a *NetplanAdapter) FindConfigPath(name string) string {
+	if configPath := a.ConfigPath(name); a.fileSystem.Exists(configPath) {
+		return configPath
+	}
+
+	files, err := a.fileSystem.ListFiles(a.configDir)
+	if err != nil {
+		a.logger.WithError(err).Warn("Failed to scan Netplan directory")
+		return ""
+	}
+
+	// Match "<digits>-<name>.yaml" or "<name>.yaml" exactly, so that multinic1 does not match multinic10
+	// and a short configured name such as "data" does not match 50-cloud-init-data.yaml
+	for _, file := range files {
+		if isNetplanFileFor(file, name) {
+			return filepath.Join(a.configDir, file)
+		}
+	}
+
+	return ""
+}
+
+// isNetplanFileFor reports whether the file name is "<name>.yaml" or "<digits>-<name>.yaml"
+func isNetplanFileFor(file, name string) bool {
+	base, ok := strings.CutSuffix(file, name+".yaml")
+	if !ok {
+		return false
+	}
+	if base == "" {
+		return true
+	}
+	priority, ok := strings.CutSuffix(base, "-")
+	if !ok || priority == "" {
+		return false
+	}
+	for _, c := range priority {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// netplanFile is the subset of the Netplan schema rendered by this adapter
+type netplanFile struct {
+	Network struct {
+		Ethernets map[string]struct {
+			MTU       int      `yaml:"mtu,omitempty"`
+			Addresses []string `yaml:"addresses,omitempty"`
+			Match     struct {
+				MACAddress string `yaml:"macaddress"`
+			} `yaml:"match"`
+			SetName string `yaml:"set-name"`
+		} `yaml:"ethernets"`
+	} `yaml:"network"`
+}
+
+// ReadConfig parses a Netplan file into the normalized interface configuration
+func (a *NetplanAdapter) ReadConfig(path string) (*entities.InterfaceConfig, error) {
+	content, err := a.fileSystem.ReadFile(path)
+	if err != nil {
+		return nil, errors.NewSystemError(fmt.Sprintf("failed to read Netplan file %s", path), err)
+	}
+
+	var file netplanFile
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, errors.NewValidationError(fmt.Sprintf("failed to parse Netplan file %s", path), err)
+	}
+
+	// Files rendered by this adapter contain exactly one ethernet
+	for key, eth := range file.Network.Ethernets {
+		config := &entities.InterfaceConfig{
+			Name:       key,
+			MacAddress: strings.ToLower(eth.Match.MACAddress),
+			MTU:        eth.MTU,
 		}
+		applyOwnership(config, content)
+		if eth.SetName != "" {
+			config.Name = eth.SetName
+		}
+		if len(eth.Addresses) > 0 {
+			address, cidr, err := entities.ParseAddressWithPrefix(eth.Addresses[0])
+			if err != nil {
+				// Keep the raw value so that it is reported as drift
+				address, cidr = eth.Addresses[0], ""
+			}
+			config.Address = address
+			config.CIDR = cidr
+		}
+		return config, nil
+	}
+
+	return nil, errors.NewValidationError(fmt.Sprintf("no ethernet found in Netplan file %s", path), nil)
+}
+
+// netplanFilePriority returns the file name priority for the interface
+// Indexes 0-9 keep the historical "9<index>" priority, larger or missing indexes share "99"
+func netplanFilePriority(name string) string {
+	if index, ok := extractInterfaceIndex(name); ok && index < 10 {
+		return fmt.Sprintf("9%d", index)
+	}
+	return "99"
+}
+
+// extractInterfaceIndex extracts the trailing numeric index from interface name
+func extractInterfaceIndex(name string) (int, bool) {
+	// multinic0 -> 0, storage12 -> 12 etc
+	digits := len(name) - len(strings.TrimRight(name, "0123456789"))
+	if digits == 0 {
+		return 0, false
+	}
+	index, err := strconv.Atoi(name[len(name)-digits:])
+	if err != nil {
+		return 0, false
 	}
-	return 0
+	return index, true
 }
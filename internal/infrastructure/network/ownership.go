@@ -0,0 +1,17 @@
+package network
+
+import (
+	"multinic-agent/internal/domain/entities"
+)
+
+// withOwnership prepends the ownership header that proves the file was rendered by this agent
+func withOwnership(agentID string, iface entities.NetworkInterface, body []byte) []byte {
+	return entities.NewFileOwnership(agentID, iface, body).Render(body)
+}
+
+// applyOwnership records the ownership header of a parsed file in the normalized configuration
+func applyOwnership(config *entities.InterfaceConfig, content []byte) {
+	ownership, intact := entities.ParseFileOwnership(content)
+	config.Ownership = ownership
+	config.Tampered = ownership != nil && !intact
+}
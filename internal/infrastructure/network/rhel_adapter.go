@@ -1,12 +1,15 @@
 package network
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
+	"multinic-agent/internal/domain/constants"
 	"multinic-agent/internal/domain/entities"
 	"multinic-agent/internal/domain/errors"
 	"multinic-agent/internal/domain/interfaces"
@@ -19,7 +22,8 @@ type RHELAdapter struct {
 	commandExecutor interfaces.CommandExecutor
 	fileSystem      interfaces.FileSystem
 	logger          *logrus.Logger
-	isContainer     bool // indicates if running in container
+	agentID         string // recorded in the ownership header of rendered files
+	isContainer     bool   // indicates if running in container
 }
 
 // NewRHELAdapter creates a new RHELAdapter.
@@ -27,6 +31,7 @@ func NewRHELAdapter(
 	executor interfaces.CommandExecutor,
 	fileSystem interfaces.FileSystem,
 	logger *logrus.Logger,
+	agentID string,
 ) *RHELAdapter {
 	// Check if running in container by checking if /host exists
 	isContainer := false
@@ -38,6 +43,7 @@ func NewRHELAdapter(
 		commandExecutor: executor,
 		fileSystem:      fileSystem,
 		logger:          logger,
+		agentID:         agentID,
 		isContainer:     isContainer,
 	}
 }
@@ -46,7 +52,7 @@ func NewRHELAdapter(
 // RHEL uses traditional network-scripts directory for interface configuration
 func (a *RHELAdapter) GetConfigDir() string {
 	// RHEL uses /etc/sysconfig/network-scripts/ for ifcfg files
-	return "/etc/sysconfig/network-scripts"
+	return constants.RHELNetworkScriptsDir
 }
 
 // execCommand is a helper method to execute commands with nsenter if in container
@@ -113,8 +119,10 @@ func (a *RHELAdapter) Configure(ctx context.Context, iface entities.NetworkInter
 	}
 
 	// 3. Generate ifcfg file content
-	configPath := filepath.Join(a.GetConfigDir(), "ifcfg-"+ifaceName)
-	content := a.generateIfcfgContent(iface, ifaceName)
+	configPath, content, err := a.RenderConfig(iface, name)
+	if err != nil {
+		return err
+	}
 
 	a.logger.WithFields(logrus.Fields{
 		"interface":   ifaceName,
@@ -125,11 +133,11 @@ func (a *RHELAdapter) Configure(ctx context.Context, iface entities.NetworkInter
 	// Log the full content in debug mode for troubleshooting
 	a.logger.WithFields(logrus.Fields{
 		"interface": ifaceName,
-		"content":   content,
+		"content":   string(content),
 	}).Debug("Full ifcfg file content")
 
 	// 4. Write the configuration file
-	if err := a.fileSystem.WriteFile(configPath, []byte(content), 0644); err != nil {
+	if err := a.fileSystem.WriteFile(configPath, content, 0644); err != nil {
 		return errors.NewNetworkError(fmt.Sprintf("Failed to write ifcfg file: %s", configPath), err)
 	}
 
@@ -154,6 +162,12 @@ func (a *RHELAdapter) Configure(ctx context.Context, iface entities.NetworkInter
 	return nil
 }
 
+// RenderConfig returns the configuration file path and the ifcfg content Configure would write
+func (a *RHELAdapter) RenderConfig(iface entities.NetworkInterface, name entities.InterfaceName) (string, []byte, error) {
+	configPath := filepath.Join(a.GetConfigDir(), "ifcfg-"+name.String())
+	return configPath, withOwnership(a.agentID, iface, []byte(a.generateIfcfgContent(iface, name.String()))), nil
+}
+
 // Validate verifies that the configured interface exists.
 func (a *RHELAdapter) Validate(ctx context.Context, name entities.InterfaceName) error {
 	ifaceName := name.String()
@@ -184,7 +198,7 @@ func (a *RHELAdapter) Rollback(ctx context.Context, name string) error {
 	a.logger.WithField("interface", name).Info("Starting RHEL interface rollback/deletion")
 
 	// 1. Delete the configuration file
-	configPath := filepath.Join(a.GetConfigDir(), "ifcfg-"+name)
+	configPath := a.ConfigPath(name)
 
 	if err := a.fileSystem.Remove(configPath); err != nil {
 		a.logger.WithError(err).WithField("interface", name).Debug("Error removing ifcfg file (can be ignored)")
@@ -279,6 +293,72 @@ BOOTPROTO=none`, ifaceName, ifaceName)
 	return content
 }
 
+// ConfigPath returns the path of the ifcfg file for the interface
+func (a *RHELAdapter) ConfigPath(name string) string {
+	return filepath.Join(a.GetConfigDir(), "ifcfg-"+name)
+}
+
+// FindConfigPath returns the path of an existing ifcfg file for the interface, or an empty string
+func (a *RHELAdapter) FindConfigPath(name string) string {
+	if configPath := a.ConfigPath(name); a.fileSystem.Exists(configPath) {
+		return configPath
+	}
+	return ""
+}
+
+// ReadConfig parses an ifcfg file into the normalized interface configuration
+func (a *RHELAdapter) ReadConfig(path string) (*entities.InterfaceConfig, error) {
+	content, err := a.fileSystem.ReadFile(path)
+	if err != nil {
+		return nil, errors.NewSystemError(fmt.Sprintf("failed to read ifcfg file %s", path), err)
+	}
+
+	config := &entities.InterfaceConfig{}
+	applyOwnership(config, content)
+	var ipAddress, prefix string
+
+	scanner := bufio.NewScanner(strings.NewReader(string(content)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		value = strings.Trim(strings.TrimSpace(value), "\"'")
+
+		switch strings.TrimSpace(key) {
+		case "DEVICE":
+			config.Name = value
+		case "HWADDR":
+			config.MacAddress = strings.ToLower(value)
+		case "IPADDR":
+			ipAddress = value
+		case "PREFIX":
+			prefix = value
+		case "MTU":
+			if mtu, err := strconv.Atoi(value); err == nil {
+				config.MTU = mtu
+			}
+		}
+	}
+
+	if ipAddress != "" {
+		config.Address = ipAddress
+		if prefix != "" {
+			if address, cidr, err := entities.ParseAddressWithPrefix(ipAddress + "/" + prefix); err == nil {
+				config.Address = address
+				config.CIDR = cidr
+			}
+		}
+	}
+
+	return config, nil
+}
+
 // GenerateIfcfgContentForTest is a test helper method
 func (a *RHELAdapter) GenerateIfcfgContentForTest(iface entities.NetworkInterface, ifaceName string) string {
 	return a.generateIfcfgContent(iface, ifaceName)
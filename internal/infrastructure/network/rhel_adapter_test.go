@@ -77,6 +77,16 @@ func (m *MockFileSystem) Remove(path string) error {
 	return args.Error(0)
 }
 
+func (m *MockFileSystem) Rename(oldPath, newPath string) error {
+	args := m.Called(oldPath, newPath)
+	return args.Error(0)
+}
+
+func (m *MockFileSystem) WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	args := m.Called(path, data, perm)
+	return args.Error(0)
+}
+
 func (m *MockFileSystem) ListFiles(path string) ([]string, error) {
 	args := m.Called(path)
 	return args.Get(0).([]string), args.Error(1)
@@ -335,7 +345,7 @@ lo         loopback  unmanaged  --`
 			mockExecutor := new(MockCommandExecutor)
 			tt.setupMocks(mockExecutor)
 
-			adapter := NewRHELAdapter(mockExecutor, &MockFileSystem{}, logrus.New())
+			adapter := NewRHELAdapter(mockExecutor, &MockFileSystem{}, logrus.New(), "test-agent")
 			// Interface name is already set in test case
 
 			err := adapter.Configure(context.Background(), tt.iface, tt.interfaceName)
@@ -432,7 +442,7 @@ eth0      abcdefgh-abcd-abcd-abcd-abcdefghijkl  ethernet  eth0`
 			mockExecutor := new(MockCommandExecutor)
 			tt.setupMocks(mockExecutor)
 
-			adapter := NewRHELAdapter(mockExecutor, &MockFileSystem{}, logrus.New())
+			adapter := NewRHELAdapter(mockExecutor, &MockFileSystem{}, logrus.New(), "test-agent")
 
 			interfaceName := mustCreateInterfaceName("multinic0")
 			err := adapter.Validate(context.Background(), interfaceName)
@@ -493,7 +503,7 @@ func TestRHELAdapter_Rollback(t *testing.T) {
 			mockFS := new(MockFileSystem)
 			tt.setupMocks(mockExecutor, mockFS)
 
-			adapter := NewRHELAdapter(mockExecutor, mockFS, logrus.New())
+			adapter := NewRHELAdapter(mockExecutor, mockFS, logrus.New(), "test-agent")
 			err := adapter.Rollback(context.Background(), "multinic0")
 
 			if tt.wantErr {
@@ -514,7 +524,7 @@ func TestRHELAdapter_GetConfigDir(t *testing.T) {
 	mockExecutor.On("ExecuteWithTimeout", mock.Anything, 1*time.Second, "test", "-d", "/host").
 		Return([]byte(""), errors.New("not found")).Once()
 
-	adapter := NewRHELAdapter(mockExecutor, &MockFileSystem{}, logrus.New())
+	adapter := NewRHELAdapter(mockExecutor, &MockFileSystem{}, logrus.New(), "test-agent")
 	assert.Equal(t, "/etc/sysconfig/network-scripts", adapter.GetConfigDir())
 }
 
@@ -577,7 +587,7 @@ func TestRHELAdapter_generateIfcfgContent(t *testing.T) {
 			mockExecutor.On("ExecuteWithTimeout", mock.Anything, mock.Anything, "test", "-d", "/host").
 				Return([]byte{}, assert.AnError).Maybe()
 
-			adapter := NewRHELAdapter(mockExecutor, mockFS, logger)
+			adapter := NewRHELAdapter(mockExecutor, mockFS, logger, "test-agent")
 			content := adapter.generateIfcfgContent(tt.iface, tt.ifaceName)
 
 			// Verify all expected fields are present
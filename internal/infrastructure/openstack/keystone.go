@@ -0,0 +1,184 @@
+package openstack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"multinic-agent/internal/domain/errors"
+	"multinic-agent/internal/domain/interfaces"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenRefreshMargin renews a token this long before it expires, so that it does not expire mid-cycle
+const tokenRefreshMargin = time.Minute
+
+// KeystoneCredentials are the Keystone v3 password credentials of the agent's service user
+type KeystoneCredentials struct {
+	AuthURL           string // e.g., "https://keystone.example.com:5000/v3"
+	Username          string
+	Password          string
+	UserDomainName    string
+	ProjectName       string
+	ProjectDomainName string
+	RegionName        string // region of the service catalog endpoint (empty for any region)
+	Interface         string // "public", "internal" or "admin" catalog endpoint
+}
+
+// KeystoneAuthenticator issues project-scoped Keystone tokens and resolves service endpoints from the catalog
+// Tokens are cached until shortly before they expire
+type KeystoneAuthenticator struct {
+	credentials KeystoneCredentials
+	client      *http.Client
+	clock       interfaces.Clock
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+	catalog   []keystoneService
+}
+
+// NewKeystoneAuthenticator creates a new KeystoneAuthenticator
+func NewKeystoneAuthenticator(credentials KeystoneCredentials, client *http.Client, clock interfaces.Clock) *KeystoneAuthenticator {
+	return &KeystoneAuthenticator{
+		credentials: credentials,
+		client:      client,
+		clock:       clock,
+	}
+}
+
+// keystoneService is a service of the token's catalog
+type keystoneService struct {
+	Type      string             `json:"type"`
+	Endpoints []keystoneEndpoint `json:"endpoints"`
+}
+
+// keystoneEndpoint is an endpoint of a catalog service
+type keystoneEndpoint struct {
+	Interface string `json:"interface"`
+	Region    string `json:"region"`
+	RegionID  string `json:"region_id"`
+	URL       string `json:"url"`
+}
+
+// Token returns a valid token, issuing a new one when there is none or it is about to expire
+func (a *KeystoneAuthenticator) Token(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != "" && a.clock.Now().Add(tokenRefreshMargin).Before(a.expiresAt) {
+		return a.token, nil
+	}
+	if err := a.authenticate(ctx); err != nil {
+		return "", err
+	}
+	return a.token, nil
+}
+
+// Invalidate drops the cached token, e.g. after it was rejected because it was revoked
+func (a *KeystoneAuthenticator) Invalidate() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.token = ""
+}
+
+// Endpoint returns the catalog URL of a service type (e.g., "network") for the configured interface and region
+func (a *KeystoneAuthenticator) Endpoint(ctx context.Context, serviceType string) (string, error) {
+	if _, err := a.Token(ctx); err != nil {
+		return "", err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	endpointInterface := a.credentials.Interface
+	if endpointInterface == "" {
+		endpointInterface = "public"
+	}
+	for _, service := range a.catalog {
+		if service.Type != serviceType {
+			continue
+		}
+		for _, endpoint := range service.Endpoints {
+			if endpoint.Interface != endpointInterface {
+				continue
+			}
+			if region := a.credentials.RegionName; region != "" && endpoint.Region != region && endpoint.RegionID != region {
+				continue
+			}
+			return strings.TrimSuffix(endpoint.URL, "/"), nil
+		}
+	}
+	return "", errors.NewNotFoundError(fmt.Sprintf("no %s endpoint for %s service in the Keystone catalog", endpointInterface, serviceType))
+}
+
+// authenticate issues a new token with password authentication (POST /v3/auth/tokens)
+func (a *KeystoneAuthenticator) authenticate(ctx context.Context) error {
+	credentials := a.credentials
+	request := map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods": []string{"password"},
+				"password": map[string]interface{}{
+					"user": map[string]interface{}{
+						"name":     credentials.Username,
+						"password": credentials.Password,
+						"domain":   map[string]string{"name": credentials.UserDomainName},
+					},
+				},
+			},
+			"scope": map[string]interface{}{
+				"project": map[string]interface{}{
+					"name":   credentials.ProjectName,
+					"domain": map[string]string{"name": credentials.ProjectDomainName},
+				},
+			},
+		},
+	}
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return errors.NewSystemError("failed to encode Keystone authentication request", err)
+	}
+
+	url := strings.TrimSuffix(credentials.AuthURL, "/") + "/auth/tokens"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return errors.NewValidationError("invalid Keystone auth URL", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return errors.NewNetworkError("Keystone authentication request failed", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.NewSystemError(fmt.Sprintf("Keystone authentication returned %s: %s", resp.Status, strings.TrimSpace(string(detail))), nil)
+	}
+	token := resp.Header.Get("X-Subject-Token")
+	if token == "" {
+		return errors.NewSystemError("Keystone authentication response has no X-Subject-Token header", nil)
+	}
+
+	var body struct {
+		Token struct {
+			ExpiresAt time.Time         `json:"expires_at"`
+			Catalog   []keystoneService `json:"catalog"`
+		} `json:"token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return errors.NewSystemError("failed to decode Keystone authentication response", err)
+	}
+
+	a.token = token
+	a.expiresAt = body.Token.ExpiresAt
+	a.catalog = body.Token.Catalog
+	return nil
+}
@@ -0,0 +1,429 @@
+package openstack
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/errors"
+	"multinic-agent/internal/domain/interfaces"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// metadataStatusFileVersion is the schema version of the status file
+const metadataStatusFileVersion = 1
+
+// networkData is the subset of the OpenStack network_data.json document used by the agent
+type networkData struct {
+	Links    []networkDataLink    `json:"links"`
+	Networks []networkDataNetwork `json:"networks"`
+}
+
+// networkDataLink is a layer 2 link of the instance
+type networkDataLink struct {
+	ID                 string `json:"id"`
+	Type               string `json:"type"`
+	EthernetMacAddress string `json:"ethernet_mac_address"`
+	MTU                *int   `json:"mtu"`
+}
+
+// networkDataNetwork is a layer 3 network on one of the links
+type networkDataNetwork struct {
+	ID        string             `json:"id"`
+	Type      string             `json:"type"`
+	Link      string             `json:"link"`
+	IPAddress string             `json:"ip_address"`
+	Netmask   string             `json:"netmask"`
+	Routes    []networkDataRoute `json:"routes"`
+}
+
+// networkDataRoute is a static route of a network
+type networkDataRoute struct {
+	Network string `json:"network"`
+	Netmask string `json:"netmask"`
+}
+
+// metadataStatusFile is the on-disk representation of the configured interfaces
+type metadataStatusFile struct {
+	Version    int      `json:"version"`
+	Configured []string `json:"configured"` // MAC addresses of configured interfaces
+}
+
+// MetadataRepository is a NetworkInterfaceRepository reading the secondary interfaces of this node
+// from the OpenStack network_data.json document, so that nodes can be configured without the central DB
+//
+// The document only describes the local instance, so every interface is attached to the local node.
+// Configuration status is not part of the document and is kept in a status file on the host.
+type MetadataRepository struct {
+	fileSystem      interfaces.FileSystem
+	client          *http.Client
+	configDriveFile string // network_data.json on a mounted config drive (tried first, empty to skip)
+	metadataURL     string // network_data.json of the metadata service (used when the config drive is unavailable)
+	statusFile      string
+	nodeName        string
+	logger          *logrus.Logger
+
+	mu sync.Mutex
+}
+
+// NewMetadataRepository creates a new MetadataRepository for the local node
+func NewMetadataRepository(fs interfaces.FileSystem, client *http.Client, configDriveFile, metadataURL, statusFile, nodeName string, logger *logrus.Logger) interfaces.NetworkInterfaceRepository {
+	return &MetadataRepository{
+		fileSystem:      fs,
+		client:          client,
+		configDriveFile: configDriveFile,
+		metadataURL:     metadataURL,
+		statusFile:      statusFile,
+		nodeName:        nodeName,
+		logger:          logger,
+	}
+}
+
+// GetPendingInterfaces retrieves interfaces pending configuration for a specific node
+func (r *MetadataRepository) GetPendingInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error) {
+	return r.interfacesWithStatus(ctx, nodeName, entities.StatusPending)
+}
+
+// GetConfiguredInterfaces retrieves configured interfaces for a specific node
+func (r *MetadataRepository) GetConfiguredInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error) {
+	return r.interfacesWithStatus(ctx, nodeName, entities.StatusConfigured)
+}
+
+// GetActiveInterfaces retrieves active interfaces for a specific node (for deletion detection)
+func (r *MetadataRepository) GetActiveInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error) {
+	return r.GetAllNodeInterfaces(ctx, nodeName)
+}
+
+// GetAllNodeInterfaces retrieves all secondary interfaces of the instance
+// Other nodes have no interfaces in this source, since the document only describes the local instance
+func (r *MetadataRepository) GetAllNodeInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error) {
+	if nodeName != r.nodeName {
+		return []entities.NetworkInterface{}, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.load(ctx)
+}
+
+// GetInterfaceByID retrieves an interface by its ID
+func (r *MetadataRepository) GetInterfaceByID(ctx context.Context, id int) (*entities.NetworkInterface, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ifaces, err := r.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range ifaces {
+		if ifaces[i].ID == id {
+			return &ifaces[i], nil
+		}
+	}
+	return nil, errors.NewNotFoundError(fmt.Sprintf("interface not found: ID=%d", id))
+}
+
+// UpdateInterfaceStatus updates the configuration status of an interface
+// Failed interfaces are stored as pending, the same as netplan_success = 0 in the database
+func (r *MetadataRepository) UpdateInterfaceStatus(ctx context.Context, interfaceID int, status entities.InterfaceStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ifaces, err := r.load(ctx)
+	if err != nil {
+		return err
+	}
+
+	var target *entities.NetworkInterface
+	for i := range ifaces {
+		if ifaces[i].ID == interfaceID {
+			target = &ifaces[i]
+			break
+		}
+	}
+	if target == nil {
+		return errors.NewNotFoundError(fmt.Sprintf("interface not found: ID=%d", interfaceID))
+	}
+
+	// Only MAC addresses still present in the document are kept, so that the file does not grow
+	configured := make(map[string]bool)
+	for _, iface := range ifaces {
+		if iface.Status == entities.StatusConfigured {
+			configured[iface.MacAddress] = true
+		}
+	}
+	if status == entities.StatusConfigured {
+		configured[target.MacAddress] = true
+	} else {
+		delete(configured, target.MacAddress)
+	}
+
+	if err := r.saveConfigured(configured); err != nil {
+		return err
+	}
+
+	r.logger.WithFields(logrus.Fields{
+		"interface_id": interfaceID,
+		"mac_address":  target.MacAddress,
+		"status":       status,
+	}).Info("interface status updated")
+
+	return nil
+}
+
+// GetChangeToken returns a hash of the document, which changes whenever an interface does
+// The status file is written only by the agent itself, so it is left out to keep status updates from triggering another cycle
+// Mapping and status lookups are skipped, but the document is still read from its source
+func (r *MetadataRepository) GetChangeToken(ctx context.Context, nodeName string) (string, error) {
+	if nodeName != r.nodeName {
+		return "", nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	content, err := r.readNetworkData(ctx)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// interfacesWithStatus retrieves the interfaces of a node in the given status
+func (r *MetadataRepository) interfacesWithStatus(ctx context.Context, nodeName string, status entities.InterfaceStatus) ([]entities.NetworkInterface, error) {
+	all, err := r.GetAllNodeInterfaces(ctx, nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	var ifaces []entities.NetworkInterface
+	for _, iface := range all {
+		if iface.Status == status {
+			ifaces = append(ifaces, iface)
+		}
+	}
+	return ifaces, nil
+}
+
+// load reads the document and the status file and maps them into interfaces sorted by MAC address
+func (r *MetadataRepository) load(ctx context.Context) ([]entities.NetworkInterface, error) {
+	content, err := r.readNetworkData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var data networkData
+	if err := json.Unmarshal(content, &data); err != nil {
+		return nil, errors.NewValidationError("failed to parse network_data.json", err)
+	}
+
+	configured, err := r.loadConfigured()
+	if err != nil {
+		return nil, err
+	}
+
+	ifaces := mapNetworkData(data, r.nodeName)
+	for i := range ifaces {
+		if configured[ifaces[i].MacAddress] {
+			ifaces[i].Status = entities.StatusConfigured
+		}
+	}
+	return ifaces, nil
+}
+
+// readNetworkData reads network_data.json from the config drive, falling back to the metadata service
+func (r *MetadataRepository) readNetworkData(ctx context.Context) ([]byte, error) {
+	if r.configDriveFile != "" && r.fileSystem.Exists(r.configDriveFile) {
+		content, err := r.fileSystem.ReadFile(r.configDriveFile)
+		if err != nil {
+			return nil, errors.NewSystemError(fmt.Sprintf("failed to read %s", r.configDriveFile), err)
+		}
+		return content, nil
+	}
+	if r.metadataURL == "" {
+		return nil, errors.NewNotFoundError(fmt.Sprintf("config drive file %s not found and no metadata URL configured", r.configDriveFile))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.metadataURL, nil)
+	if err != nil {
+		return nil, errors.NewValidationError("invalid metadata URL", err)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, errors.NewNetworkError("metadata service request failed", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.NewSystemError(fmt.Sprintf("metadata service returned %s", resp.Status), nil)
+	}
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError("failed to read metadata service response", err)
+	}
+	return content, nil
+}
+
+// loadConfigured reads the MAC addresses of configured interfaces, returning an empty set if the file does not exist yet
+func (r *MetadataRepository) loadConfigured() (map[string]bool, error) {
+	configured := make(map[string]bool)
+	if !r.fileSystem.Exists(r.statusFile) {
+		return configured, nil
+	}
+
+	content, err := r.fileSystem.ReadFile(r.statusFile)
+	if err != nil {
+		return nil, errors.NewSystemError(fmt.Sprintf("failed to read interface status file %s", r.statusFile), err)
+	}
+	var file metadataStatusFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return nil, errors.NewValidationError(fmt.Sprintf("failed to parse interface status file %s", r.statusFile), err)
+	}
+	for _, mac := range file.Configured {
+		configured[strings.ToLower(mac)] = true
+	}
+	return configured, nil
+}
+
+// saveConfigured writes the MAC addresses of configured interfaces
+func (r *MetadataRepository) saveConfigured(configured map[string]bool) error {
+	file := metadataStatusFile{
+		Version:    metadataStatusFileVersion,
+		Configured: make([]string, 0, len(configured)),
+	}
+	for mac := range configured {
+		file.Configured = append(file.Configured, mac)
+	}
+	sort.Strings(file.Configured)
+
+	content, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return errors.NewSystemError("failed to encode interface status file", err)
+	}
+	if err := r.fileSystem.WriteFile(r.statusFile, content, 0644); err != nil {
+		return errors.NewSystemError(fmt.Sprintf("failed to write interface status file %s", r.statusFile), err)
+	}
+	return nil
+}
+
+// mapNetworkData maps the secondary links of a network_data.json document into pending interfaces of nodeName
+//
+// The primary interface is managed by cloud-init and is skipped. It is recognised by its default route,
+// or by a DHCP network, since Nova describes a DHCP primary as ipv4_dhcp (or ipv6_dhcp) without routes.
+// Links without a static IPv4 network are skipped as well: there is nothing to configure and they may be the primary.
+// Bond and VLAN links are skipped too, as they have no MAC address of their own to match a host interface.
+func mapNetworkData(data networkData, nodeName string) []entities.NetworkInterface {
+	networksByLink := make(map[string][]networkDataNetwork)
+	for _, network := range data.Networks {
+		networksByLink[network.Link] = append(networksByLink[network.Link], network)
+	}
+
+	ifaces := make([]entities.NetworkInterface, 0, len(data.Links))
+	for _, link := range data.Links {
+		if link.EthernetMacAddress == "" || link.Type == "bond" || link.Type == "vlan" {
+			continue
+		}
+		networks := networksByLink[link.ID]
+		if hasDefaultRoute(networks) || hasDHCPNetwork(networks) {
+			continue
+		}
+		address, cidr, ok := staticIPv4Address(networks)
+		if !ok {
+			continue
+		}
+
+		mac := strings.ToLower(link.EthernetMacAddress)
+		iface := entities.NetworkInterface{
+			ID:               interfaceID(mac),
+			MacAddress:       mac,
+			AttachedNodeName: nodeName,
+			Address:          address,
+			CIDR:             cidr,
+			Status:           entities.StatusPending,
+		}
+		if link.MTU != nil {
+			iface.MTU = *link.MTU
+		}
+		ifaces = append(ifaces, iface)
+	}
+
+	sort.Slice(ifaces, func(i, j int) bool { return ifaces[i].MacAddress < ifaces[j].MacAddress })
+	return ifaces
+}
+
+// hasDefaultRoute reports whether one of the networks routes 0.0.0.0/0
+func hasDefaultRoute(networks []networkDataNetwork) bool {
+	for _, network := range networks {
+		for _, route := range network.Routes {
+			if route.Network == "0.0.0.0" && (route.Netmask == "0.0.0.0" || route.Netmask == "") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasDHCPNetwork reports whether one of the networks is configured by DHCP
+func hasDHCPNetwork(networks []networkDataNetwork) bool {
+	for _, network := range networks {
+		if network.Type == "ipv4_dhcp" || network.Type == "ipv6_dhcp" {
+			return true
+		}
+	}
+	return false
+}
+
+// staticIPv4Address returns the address and subnet CIDR of the first static IPv4 network
+func staticIPv4Address(networks []networkDataNetwork) (string, string, bool) {
+	for _, network := range networks {
+		if network.Type != "ipv4" {
+			continue
+		}
+		if address, cidr, ok := ipv4Address(network); ok {
+			return address, cidr, true
+		}
+	}
+	return "", "", false
+}
+
+// ipv4Address returns the address and subnet CIDR of a static IPv4 network
+// ip_address is either a plain address with a separate netmask or already in prefix notation
+func ipv4Address(network networkDataNetwork) (string, string, bool) {
+	if strings.Contains(network.IPAddress, "/") {
+		ip, subnet, err := net.ParseCIDR(network.IPAddress)
+		if err != nil || ip.To4() == nil {
+			return "", "", false
+		}
+		return ip.String(), subnet.String(), true
+	}
+
+	ip := net.ParseIP(network.IPAddress).To4()
+	mask := net.ParseIP(network.Netmask).To4()
+	if ip == nil || mask == nil {
+		return "", "", false
+	}
+	subnet := net.IPNet{IP: ip.Mask(net.IPMask(mask)), Mask: net.IPMask(mask)}
+	return ip.String(), subnet.String(), true
+}
+
+// interfaceID derives a stable positive interface ID from the MAC address, since the document has no numeric IDs
+func interfaceID(mac string) int {
+	hash := fnv.New32a()
+	hash.Write([]byte(mac))
+	if id := int(hash.Sum32() & 0x7fffffff); id != 0 {
+		return id
+	}
+	return 1
+}
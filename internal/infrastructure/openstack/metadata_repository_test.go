@@ -0,0 +1,183 @@
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/errors"
+	"multinic-agent/internal/domain/interfaces"
+	"multinic-agent/internal/infrastructure/adapters"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sampleNetworkData has a primary link with the default route, two secondary links and a bond
+const sampleNetworkData = `{
+  "links": [
+    {"id": "tap-primary", "type": "ovs", "ethernet_mac_address": "fa:16:3e:00:00:01", "mtu": 1500},
+    {"id": "tap-storage", "type": "ovs", "ethernet_mac_address": "FA:16:3E:00:00:02", "mtu": 9000},
+    {"id": "tap-backup", "type": "phy", "ethernet_mac_address": "fa:16:3e:00:00:03", "mtu": null},
+    {"id": "bond0", "type": "bond", "ethernet_mac_address": "fa:16:3e:00:00:04", "bond_links": ["tap-backup"]}
+  ],
+  "networks": [
+    {"id": "network0", "type": "ipv4", "link": "tap-primary", "ip_address": "192.168.0.10", "netmask": "255.255.255.0",
+     "routes": [{"network": "0.0.0.0", "netmask": "0.0.0.0", "gateway": "192.168.0.1"}]},
+    {"id": "network1", "type": "ipv4", "link": "tap-storage", "ip_address": "10.10.0.5", "netmask": "255.255.255.0", "routes": []},
+    {"id": "network2", "type": "ipv4", "link": "tap-backup", "ip_address": "10.20.1.7/16"}
+  ],
+  "services": []
+}`
+
+// dhcpPrimaryNetworkData has a DHCP primary link without routes, as Nova describes it for DHCP subnets,
+// a secondary link without any network and a static secondary link
+const dhcpPrimaryNetworkData = `{
+  "links": [
+    {"id": "tap-primary", "type": "ovs", "ethernet_mac_address": "fa:16:3e:00:00:01", "mtu": 1500},
+    {"id": "tap-unaddressed", "type": "ovs", "ethernet_mac_address": "fa:16:3e:00:00:02", "mtu": 1500},
+    {"id": "tap-storage", "type": "ovs", "ethernet_mac_address": "fa:16:3e:00:00:03", "mtu": 9000}
+  ],
+  "networks": [
+    {"id": "network0", "type": "ipv4_dhcp", "link": "tap-primary", "network_id": "6e2d0a4c-4b5f-4c57-9f0e-5a4cf3e5d8b1"},
+    {"id": "network1", "type": "ipv4", "link": "tap-storage", "ip_address": "10.10.0.5", "netmask": "255.255.255.0", "routes": []}
+  ],
+  "services": []
+}`
+
+func newTestLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+	return logger
+}
+
+// newMetadataServer serves network_data.json like the metadata service
+func newMetadataServer(t *testing.T, body string, status int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/openstack/latest/network_data.json" {
+			http.NotFound(w, r)
+			return
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestMetadataRepository_MetadataService(t *testing.T) {
+	server := newMetadataServer(t, sampleNetworkData, http.StatusOK)
+	statusFile := filepath.Join(t.TempDir(), "metadata-status.json")
+	metadataURL := server.URL + "/openstack/latest/network_data.json"
+	repo := NewMetadataRepository(adapters.NewRealFileSystem(), server.Client(), "", metadataURL, statusFile, "worker-1", newTestLogger())
+	ctx := context.Background()
+
+	// 기본 경로 링크와 본딩 링크는 제외되고, 보조 링크만 대기 상태로 조회
+	ifaces, err := repo.GetAllNodeInterfaces(ctx, "worker-1")
+	require.NoError(t, err)
+	require.Len(t, ifaces, 2)
+	assert.Equal(t, entities.NetworkInterface{
+		ID: interfaceID("fa:16:3e:00:00:02"), MacAddress: "fa:16:3e:00:00:02", AttachedNodeName: "worker-1",
+		Status: entities.StatusPending, Address: "10.10.0.5", CIDR: "10.10.0.0/24", MTU: 9000,
+	}, ifaces[0])
+	assert.Equal(t, "10.20.1.7", ifaces[1].Address)
+	assert.Equal(t, "10.20.0.0/16", ifaces[1].CIDR)
+	assert.Zero(t, ifaces[1].MTU)
+
+	// 다른 노드의 인터페이스는 이 소스에 없음
+	others, err := repo.GetAllNodeInterfaces(ctx, "worker-2")
+	require.NoError(t, err)
+	assert.Empty(t, others)
+
+	// 변경 토큰은 문서가 같으면 그대로이고, 에이전트 자신의 상태 갱신으로는 바뀌지 않음
+	source := repo.(interfaces.ChangeTokenSource)
+	token, err := source.GetChangeToken(ctx, "worker-1")
+	require.NoError(t, err)
+	unchanged, err := source.GetChangeToken(ctx, "worker-1")
+	require.NoError(t, err)
+	assert.Equal(t, token, unchanged)
+
+	// 설정 완료 상태는 상태 파일에 저장되어 재시작 후에도 유지
+	require.NoError(t, repo.UpdateInterfaceStatus(ctx, ifaces[0].ID, entities.StatusConfigured))
+	afterStatus, err := source.GetChangeToken(ctx, "worker-1")
+	require.NoError(t, err)
+	assert.Equal(t, token, afterStatus)
+
+	restarted := NewMetadataRepository(adapters.NewRealFileSystem(), server.Client(), "", metadataURL, statusFile, "worker-1", newTestLogger())
+	configured, err := restarted.GetConfiguredInterfaces(ctx, "worker-1")
+	require.NoError(t, err)
+	require.Len(t, configured, 1)
+	assert.Equal(t, "fa:16:3e:00:00:02", configured[0].MacAddress)
+
+	pending, err := restarted.GetPendingInterfaces(ctx, "worker-1")
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, "fa:16:3e:00:00:03", pending[0].MacAddress)
+
+	require.NoError(t, restarted.UpdateInterfaceStatus(ctx, ifaces[0].ID, entities.StatusPending))
+	iface, err := restarted.GetInterfaceByID(ctx, ifaces[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, entities.StatusPending, iface.Status)
+
+	_, err = repo.GetInterfaceByID(ctx, 42)
+	assert.True(t, errors.IsNotFoundError(err))
+	assert.True(t, errors.IsNotFoundError(repo.UpdateInterfaceStatus(ctx, 42, entities.StatusConfigured)))
+}
+
+func TestMetadataRepository_PrefersConfigDrive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("metadata service must not be queried while the config drive is mounted: %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	configDriveFile := filepath.Join(dir, "openstack", "latest", "network_data.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(configDriveFile), 0755))
+	require.NoError(t, os.WriteFile(configDriveFile, []byte(sampleNetworkData), 0644))
+
+	repo := NewMetadataRepository(adapters.NewRealFileSystem(), server.Client(), configDriveFile, server.URL, filepath.Join(dir, "status.json"), "worker-1", newTestLogger())
+	ifaces, err := repo.GetAllNodeInterfaces(context.Background(), "worker-1")
+	require.NoError(t, err)
+	assert.Len(t, ifaces, 2)
+}
+
+func TestMapNetworkData_DHCPPrimary(t *testing.T) {
+	var data networkData
+	require.NoError(t, json.Unmarshal([]byte(dhcpPrimaryNetworkData), &data))
+
+	// 기본 경로가 없어도 DHCP 링크는 주 인터페이스로 보고 제외하며, 정적 IPv4가 없는 링크도 제외
+	ifaces := mapNetworkData(data, "worker-1")
+	require.Len(t, ifaces, 1)
+	assert.Equal(t, "fa:16:3e:00:00:03", ifaces[0].MacAddress)
+	assert.Equal(t, "10.10.0.5", ifaces[0].Address)
+}
+
+func TestMetadataRepository_Errors(t *testing.T) {
+	ctx := context.Background()
+	statusFile := filepath.Join(t.TempDir(), "status.json")
+
+	failing := newMetadataServer(t, "internal error", http.StatusInternalServerError)
+	repo := NewMetadataRepository(adapters.NewRealFileSystem(), failing.Client(), "", failing.URL+"/openstack/latest/network_data.json", statusFile, "worker-1", newTestLogger())
+	_, err := repo.GetAllNodeInterfaces(ctx, "worker-1")
+	assert.True(t, errors.IsSystemError(err))
+
+	malformed := newMetadataServer(t, "{not json", http.StatusOK)
+	repo = NewMetadataRepository(adapters.NewRealFileSystem(), malformed.Client(), "", malformed.URL+"/openstack/latest/network_data.json", statusFile, "worker-1", newTestLogger())
+	_, err = repo.GetAllNodeInterfaces(ctx, "worker-1")
+	assert.True(t, errors.IsValidationError(err))
+
+	// 메타데이터 서비스에 연결할 수 없으면 네트워크 오류
+	unreachable := newMetadataServer(t, sampleNetworkData, http.StatusOK)
+	url := unreachable.URL + "/openstack/latest/network_data.json"
+	unreachable.Close()
+	repo = NewMetadataRepository(adapters.NewRealFileSystem(), &http.Client{}, "", url, statusFile, "worker-1", newTestLogger())
+	_, err = repo.GetAllNodeInterfaces(ctx, "worker-1")
+	assert.True(t, errors.IsNetworkError(err))
+}
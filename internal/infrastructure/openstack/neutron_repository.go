@@ -0,0 +1,384 @@
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"multinic-agent/internal/domain/constants"
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/errors"
+	"multinic-agent/internal/domain/interfaces"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ConfiguredPortTag marks Neutron ports whose interface has been configured on the node
+// It takes the place of netplan_success in the database
+const ConfiguredPortTag = "multinic-configured"
+
+// neutronPort is the subset of a Neutron port used by the agent
+type neutronPort struct {
+	ID         string `json:"id"`
+	MacAddress string `json:"mac_address"`
+	NetworkID  string `json:"network_id"`
+	FixedIPs   []struct {
+		SubnetID  string `json:"subnet_id"`
+		IPAddress string `json:"ip_address"`
+	} `json:"fixed_ips"`
+	Tags []string `json:"tags"`
+}
+
+// neutronNetwork is the subset of a Neutron network used by the agent
+type neutronNetwork struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	MTU  int    `json:"mtu"`
+}
+
+// neutronSubnet is the subset of a Neutron subnet used by the agent
+type neutronSubnet struct {
+	ID   string `json:"id"`
+	CIDR string `json:"cidr"`
+}
+
+// neutronInterface is an interface together with the port it was mapped from
+type neutronInterface struct {
+	iface  entities.NetworkInterface
+	portID string
+}
+
+// NeutronRepository is a NetworkInterfaceRepository reading the ports bound to this node's instance
+// directly from the Neutron API, without the intermediate multi_interface table
+//
+// Ports on the primary networks are skipped, since the primary NIC is managed by cloud-init.
+// Configuration status is stored as the ConfiguredPortTag tag on the port.
+type NeutronRepository struct {
+	auth            *KeystoneAuthenticator
+	client          *http.Client
+	endpoint        string // Neutron endpoint override (empty to use the Keystone catalog)
+	deviceID        string // instance UUID (empty to read the DMI system UUID)
+	primaryNetworks map[string]bool
+	fileSystem      interfaces.FileSystem
+	nodeName        string
+	logger          *logrus.Logger
+
+	mu sync.Mutex
+}
+
+// NewNeutronRepository creates a new NeutronRepository for the local node
+// primaryNetworks are the IDs or names of the networks of the primary NIC
+func NewNeutronRepository(auth *KeystoneAuthenticator, client *http.Client, endpoint, deviceID string, primaryNetworks []string, fs interfaces.FileSystem, nodeName string, logger *logrus.Logger) interfaces.NetworkInterfaceRepository {
+	primary := make(map[string]bool, len(primaryNetworks))
+	for _, network := range primaryNetworks {
+		primary[network] = true
+	}
+	return &NeutronRepository{
+		auth:            auth,
+		client:          client,
+		endpoint:        strings.TrimSuffix(endpoint, "/"),
+		deviceID:        deviceID,
+		primaryNetworks: primary,
+		fileSystem:      fs,
+		nodeName:        nodeName,
+		logger:          logger,
+	}
+}
+
+// GetPendingInterfaces retrieves interfaces pending configuration for a specific node
+func (r *NeutronRepository) GetPendingInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error) {
+	return r.interfacesWithStatus(ctx, nodeName, entities.StatusPending)
+}
+
+// GetConfiguredInterfaces retrieves configured interfaces for a specific node
+func (r *NeutronRepository) GetConfiguredInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error) {
+	return r.interfacesWithStatus(ctx, nodeName, entities.StatusConfigured)
+}
+
+// GetActiveInterfaces retrieves active interfaces for a specific node (for deletion detection)
+func (r *NeutronRepository) GetActiveInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error) {
+	return r.GetAllNodeInterfaces(ctx, nodeName)
+}
+
+// GetAllNodeInterfaces retrieves the secondary ports of the local instance
+// Other nodes have no interfaces in this source, since only the local instance's ports are queried
+func (r *NeutronRepository) GetAllNodeInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error) {
+	if nodeName != r.nodeName {
+		return []entities.NetworkInterface{}, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	mapped, err := r.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ifaces := make([]entities.NetworkInterface, 0, len(mapped))
+	for _, m := range mapped {
+		ifaces = append(ifaces, m.iface)
+	}
+	return ifaces, nil
+}
+
+// GetInterfaceByID retrieves an interface by its ID
+func (r *NeutronRepository) GetInterfaceByID(ctx context.Context, id int) (*entities.NetworkInterface, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	target, err := r.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &target.iface, nil
+}
+
+// UpdateInterfaceStatus adds the configured tag to the port, or removes it for any other status
+func (r *NeutronRepository) UpdateInterfaceStatus(ctx context.Context, interfaceID int, status entities.InterfaceStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	target, err := r.find(ctx, interfaceID)
+	if err != nil {
+		return err
+	}
+
+	configured := status == entities.StatusConfigured
+	if configured == (target.iface.Status == entities.StatusConfigured) {
+		return nil
+	}
+
+	path := fmt.Sprintf("/v2.0/ports/%s/tags/%s", url.PathEscape(target.portID), ConfiguredPortTag)
+	if configured {
+		err = r.do(ctx, http.MethodPut, path, nil)
+	} else {
+		err = r.do(ctx, http.MethodDelete, path, nil)
+		if errors.IsNotFoundError(err) {
+			err = nil
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	r.logger.WithFields(logrus.Fields{
+		"interface_id": interfaceID,
+		"port_id":      target.portID,
+		"status":       status,
+	}).Info("interface status updated")
+
+	return nil
+}
+
+// interfacesWithStatus retrieves the interfaces of a node in the given status
+func (r *NeutronRepository) interfacesWithStatus(ctx context.Context, nodeName string, status entities.InterfaceStatus) ([]entities.NetworkInterface, error) {
+	all, err := r.GetAllNodeInterfaces(ctx, nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	var ifaces []entities.NetworkInterface
+	for _, iface := range all {
+		if iface.Status == status {
+			ifaces = append(ifaces, iface)
+		}
+	}
+	return ifaces, nil
+}
+
+// find retrieves the interface with the given ID together with its port
+func (r *NeutronRepository) find(ctx context.Context, id int) (*neutronInterface, error) {
+	mapped, err := r.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range mapped {
+		if mapped[i].iface.ID == id {
+			return &mapped[i], nil
+		}
+	}
+	return nil, errors.NewNotFoundError(fmt.Sprintf("interface not found: ID=%d", id))
+}
+
+// load lists the instance's ports and resolves their networks and subnets into interfaces sorted by MAC address
+func (r *NeutronRepository) load(ctx context.Context) ([]neutronInterface, error) {
+	deviceID, err := r.resolveDeviceID()
+	if err != nil {
+		return nil, err
+	}
+
+	var ports struct {
+		Ports []neutronPort `json:"ports"`
+	}
+	if err := r.do(ctx, http.MethodGet, "/v2.0/ports?device_id="+url.QueryEscape(deviceID), &ports); err != nil {
+		return nil, err
+	}
+	if len(ports.Ports) == 0 {
+		return []neutronInterface{}, nil
+	}
+
+	networkIDs := make(map[string]bool)
+	subnetIDs := make(map[string]bool)
+	for _, port := range ports.Ports {
+		networkIDs[port.NetworkID] = true
+		for _, fixedIP := range port.FixedIPs {
+			subnetIDs[fixedIP.SubnetID] = true
+		}
+	}
+
+	var networks struct {
+		Networks []neutronNetwork `json:"networks"`
+	}
+	if err := r.do(ctx, http.MethodGet, "/v2.0/networks?"+idQuery(networkIDs), &networks); err != nil {
+		return nil, err
+	}
+	networksByID := make(map[string]neutronNetwork, len(networks.Networks))
+	for _, network := range networks.Networks {
+		networksByID[network.ID] = network
+	}
+
+	var subnets struct {
+		Subnets []neutronSubnet `json:"subnets"`
+	}
+	if len(subnetIDs) > 0 {
+		if err := r.do(ctx, http.MethodGet, "/v2.0/subnets?"+idQuery(subnetIDs), &subnets); err != nil {
+			return nil, err
+		}
+	}
+	subnetsByID := make(map[string]neutronSubnet, len(subnets.Subnets))
+	for _, subnet := range subnets.Subnets {
+		subnetsByID[subnet.ID] = subnet
+	}
+
+	mapped := make([]neutronInterface, 0, len(ports.Ports))
+	for _, port := range ports.Ports {
+		network := networksByID[port.NetworkID]
+		if r.primaryNetworks[port.NetworkID] || (network.Name != "" && r.primaryNetworks[network.Name]) {
+			continue
+		}
+
+		mac := strings.ToLower(port.MacAddress)
+		iface := entities.NetworkInterface{
+			ID:               interfaceID(mac),
+			MacAddress:       mac,
+			AttachedNodeName: r.nodeName,
+			Status:           entities.StatusPending,
+			MTU:              network.MTU,
+			NetworkName:      network.Name,
+		}
+		for _, tag := range port.Tags {
+			if tag == ConfiguredPortTag {
+				iface.Status = entities.StatusConfigured
+			}
+		}
+		// The first IPv4 fixed IP is configured, the same as a multi_interface row has a single address
+		for _, fixedIP := range port.FixedIPs {
+			if ip := net.ParseIP(fixedIP.IPAddress); ip == nil || ip.To4() == nil {
+				continue
+			}
+			iface.Address = fixedIP.IPAddress
+			iface.CIDR = subnetsByID[fixedIP.SubnetID].CIDR
+			break
+		}
+		mapped = append(mapped, neutronInterface{iface: iface, portID: port.ID})
+	}
+
+	sort.Slice(mapped, func(i, j int) bool { return mapped[i].iface.MacAddress < mapped[j].iface.MacAddress })
+	return mapped, nil
+}
+
+// resolveDeviceID returns the configured instance UUID, or reads it from the DMI system UUID
+func (r *NeutronRepository) resolveDeviceID() (string, error) {
+	if r.deviceID != "" {
+		return r.deviceID, nil
+	}
+
+	content, err := r.fileSystem.ReadFile(constants.DMIProductUUIDFile)
+	if err != nil {
+		return "", errors.NewSystemError("failed to read the instance UUID - set the Neutron device ID explicitly", err)
+	}
+	deviceID := strings.ToLower(strings.TrimSpace(string(content)))
+	if deviceID == "" {
+		return "", errors.NewValidationError(fmt.Sprintf("%s is empty - set the Neutron device ID explicitly", constants.DMIProductUUIDFile), nil)
+	}
+	r.deviceID = deviceID
+	return deviceID, nil
+}
+
+// do sends an authenticated request to Neutron and decodes the response into out when not nil
+// A rejected token is renewed once, since Keystone tokens can be revoked before they expire
+func (r *NeutronRepository) do(ctx context.Context, method, path string, out interface{}) error {
+	err := r.doOnce(ctx, method, path, out)
+	if err == errUnauthorized {
+		r.auth.Invalidate()
+		err = r.doOnce(ctx, method, path, out)
+	}
+	if err == errUnauthorized {
+		return errors.NewSystemError(fmt.Sprintf("Neutron request %s %s was rejected as unauthorized", method, path), nil)
+	}
+	return err
+}
+
+// errUnauthorized is returned by doOnce when Neutron rejects the token
+var errUnauthorized = fmt.Errorf("unauthorized")
+
+// doOnce sends a single authenticated request to Neutron
+func (r *NeutronRepository) doOnce(ctx context.Context, method, path string, out interface{}) error {
+	token, err := r.auth.Token(ctx)
+	if err != nil {
+		return err
+	}
+	endpoint := r.endpoint
+	if endpoint == "" {
+		if endpoint, err = r.auth.Endpoint(ctx, "network"); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint+path, nil)
+	if err != nil {
+		return errors.NewSystemError("failed to build Neutron request", err)
+	}
+	req.Header.Set("X-Auth-Token", token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return errors.NewNetworkError(fmt.Sprintf("Neutron request %s %s failed", method, path), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return errUnauthorized
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		message := fmt.Sprintf("Neutron request %s %s returned %s: %s", method, path, resp.Status, strings.TrimSpace(string(detail)))
+		if resp.StatusCode == http.StatusNotFound {
+			return errors.NewNotFoundError(message)
+		}
+		return errors.NewSystemError(message, nil)
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return errors.NewSystemError("failed to decode Neutron response", err)
+		}
+	}
+	return nil
+}
+
+// idQuery returns the query string filtering a Neutron list request by the given IDs
+func idQuery(ids map[string]bool) string {
+	query := url.Values{}
+	for id := range ids {
+		query.Add("id", id)
+	}
+	return query.Encode()
+}
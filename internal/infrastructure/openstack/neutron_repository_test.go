@@ -0,0 +1,239 @@
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/errors"
+	"multinic-agent/internal/infrastructure/adapters"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDeviceID = "6f1c7c1e-0a53-4b6c-9d0e-5c2f3b7a9e10"
+
+// fakeOpenStack is a local stand-in for Keystone and Neutron
+type fakeOpenStack struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu           sync.Mutex
+	issuedTokens int
+	validToken   string
+	ports        []neutronPort
+}
+
+func newFakeOpenStack(t *testing.T, ports []neutronPort) *fakeOpenStack {
+	t.Helper()
+	fake := &fakeOpenStack{t: t, ports: ports}
+	fake.server = httptest.NewServer(http.HandlerFunc(fake.handle))
+	t.Cleanup(fake.server.Close)
+	return fake
+}
+
+// revokeTokens makes Neutron reject all tokens issued so far
+func (f *fakeOpenStack) revokeTokens() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.validToken = "revoked"
+}
+
+func (f *fakeOpenStack) handle(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path == "/identity/v3/auth/tokens" {
+		var body map[string]interface{}
+		require.NoError(f.t, json.NewDecoder(r.Body).Decode(&body))
+		password := body["auth"].(map[string]interface{})["identity"].(map[string]interface{})["password"].(map[string]interface{})
+		if password["user"].(map[string]interface{})["password"] != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		f.issuedTokens++
+		f.validToken = fmt.Sprintf("token-%d", f.issuedTokens)
+		w.Header().Set("X-Subject-Token", f.validToken)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"token": map[string]interface{}{
+				"expires_at": time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+				"catalog": []map[string]interface{}{
+					{"type": "network", "endpoints": []map[string]string{
+						{"interface": "internal", "region": "RegionOne", "url": "http://internal.invalid:9696"},
+						{"interface": "public", "region": "RegionOne", "url": f.server.URL + "/network/"},
+					}},
+				},
+			},
+		})
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, "/network/v2.0/") {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Header.Get("X-Auth-Token") != f.validToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/network/v2.0")
+	switch {
+	case path == "/ports":
+		var ports []neutronPort
+		for _, port := range f.ports {
+			if r.URL.Query().Get("device_id") == testDeviceID {
+				ports = append(ports, port)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"ports": ports})
+	case path == "/networks":
+		// 조회한 포트의 네트워크만 요청
+		var networks []neutronNetwork
+		for _, network := range []neutronNetwork{
+			{ID: "net-primary", Name: "provider", MTU: 1500},
+			{ID: "net-storage", Name: "storage", MTU: 9000},
+			{ID: "net-backup", Name: "backup", MTU: 1450},
+		} {
+			for _, id := range r.URL.Query()["id"] {
+				if id == network.ID {
+					networks = append(networks, network)
+				}
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"networks": networks})
+	case path == "/subnets":
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"subnets": []neutronSubnet{
+			{ID: "sub-primary", CIDR: "192.168.0.0/24"},
+			{ID: "sub-storage-v6", CIDR: "fd00:10::/64"},
+			{ID: "sub-storage", CIDR: "10.10.0.0/24"},
+			{ID: "sub-backup", CIDR: "10.20.0.0/16"},
+		}})
+	case strings.HasPrefix(path, "/ports/") && strings.HasSuffix(path, "/tags/"+ConfiguredPortTag):
+		portID := strings.TrimSuffix(strings.TrimPrefix(path, "/ports/"), "/tags/"+ConfiguredPortTag)
+		for i := range f.ports {
+			if f.ports[i].ID != portID {
+				continue
+			}
+			switch r.Method {
+			case http.MethodPut:
+				f.ports[i].Tags = append(f.ports[i].Tags, ConfiguredPortTag)
+				w.WriteHeader(http.StatusCreated)
+			case http.MethodDelete:
+				f.ports[i].Tags = nil
+				w.WriteHeader(http.StatusNoContent)
+			}
+			return
+		}
+		http.NotFound(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newTestPort(id, mac, networkID string, fixedIPs ...[2]string) neutronPort {
+	port := neutronPort{ID: id, MacAddress: mac, NetworkID: networkID}
+	for _, fixedIP := range fixedIPs {
+		port.FixedIPs = append(port.FixedIPs, struct {
+			SubnetID  string `json:"subnet_id"`
+			IPAddress string `json:"ip_address"`
+		}{SubnetID: fixedIP[0], IPAddress: fixedIP[1]})
+	}
+	return port
+}
+
+func newTestNeutronRepository(fake *fakeOpenStack, password string) *NeutronRepository {
+	auth := NewKeystoneAuthenticator(KeystoneCredentials{
+		AuthURL:           fake.server.URL + "/identity/v3",
+		Username:          "multinic-agent",
+		Password:          password,
+		UserDomainName:    "Default",
+		ProjectName:       "infra",
+		ProjectDomainName: "Default",
+		RegionName:        "RegionOne",
+		Interface:         "public",
+	}, fake.server.Client(), adapters.NewRealClock())
+	repo := NewNeutronRepository(auth, fake.server.Client(), "", testDeviceID, []string{"provider"}, adapters.NewRealFileSystem(), "worker-1", newTestLogger())
+	return repo.(*NeutronRepository)
+}
+
+func TestNeutronRepository_MapsPortsAndTagsStatus(t *testing.T) {
+	fake := newFakeOpenStack(t, []neutronPort{
+		newTestPort("port-primary", "fa:16:3e:00:00:01", "net-primary", [2]string{"sub-primary", "192.168.0.10"}),
+		newTestPort("port-storage", "FA:16:3E:00:00:02", "net-storage", [2]string{"sub-storage-v6", "fd00:10::5"}, [2]string{"sub-storage", "10.10.0.5"}),
+		newTestPort("port-backup", "fa:16:3e:00:00:03", "net-backup", [2]string{"sub-backup", "10.20.1.7"}),
+	})
+	repo := newTestNeutronRepository(fake, "secret")
+	ctx := context.Background()
+
+	// 기본 네트워크의 포트는 제외하고, IPv4 고정 IP와 서브넷, 네트워크 MTU와 이름을 매핑
+	ifaces, err := repo.GetAllNodeInterfaces(ctx, "worker-1")
+	require.NoError(t, err)
+	require.Len(t, ifaces, 2)
+	assert.Equal(t, entities.NetworkInterface{
+		ID: interfaceID("fa:16:3e:00:00:02"), MacAddress: "fa:16:3e:00:00:02", AttachedNodeName: "worker-1",
+		Status: entities.StatusPending, Address: "10.10.0.5", CIDR: "10.10.0.0/24", MTU: 9000, NetworkName: "storage",
+	}, ifaces[0])
+	assert.Equal(t, "backup", ifaces[1].NetworkName)
+
+	others, err := repo.GetAllNodeInterfaces(ctx, "worker-2")
+	require.NoError(t, err)
+	assert.Empty(t, others)
+
+	// 설정 완료 상태는 포트 태그로 기록
+	require.NoError(t, repo.UpdateInterfaceStatus(ctx, ifaces[0].ID, entities.StatusConfigured))
+	assert.Equal(t, []string{ConfiguredPortTag}, fake.ports[1].Tags)
+	configured, err := repo.GetConfiguredInterfaces(ctx, "worker-1")
+	require.NoError(t, err)
+	require.Len(t, configured, 1)
+	assert.Equal(t, "fa:16:3e:00:00:02", configured[0].MacAddress)
+
+	require.NoError(t, repo.UpdateInterfaceStatus(ctx, ifaces[0].ID, entities.StatusPending))
+	assert.Empty(t, fake.ports[1].Tags)
+	pending, err := repo.GetPendingInterfaces(ctx, "worker-1")
+	require.NoError(t, err)
+	assert.Len(t, pending, 2)
+
+	_, err = repo.GetInterfaceByID(ctx, 42)
+	assert.True(t, errors.IsNotFoundError(err))
+
+	// 토큰은 만료 전까지 재사용
+	assert.Equal(t, 1, fake.issuedTokens)
+}
+
+func TestNeutronRepository_RenewsRevokedToken(t *testing.T) {
+	fake := newFakeOpenStack(t, []neutronPort{
+		newTestPort("port-storage", "fa:16:3e:00:00:02", "net-storage", [2]string{"sub-storage", "10.10.0.5"}),
+	})
+	repo := newTestNeutronRepository(fake, "secret")
+	ctx := context.Background()
+
+	_, err := repo.GetAllNodeInterfaces(ctx, "worker-1")
+	require.NoError(t, err)
+
+	// 만료 전에 폐기된 토큰은 한 번 재발급 후 재시도
+	fake.revokeTokens()
+	ifaces, err := repo.GetAllNodeInterfaces(ctx, "worker-1")
+	require.NoError(t, err)
+	assert.Len(t, ifaces, 1)
+	assert.Equal(t, 2, fake.issuedTokens)
+}
+
+func TestNeutronRepository_AuthenticationFailure(t *testing.T) {
+	fake := newFakeOpenStack(t, nil)
+	repo := newTestNeutronRepository(fake, "wrong")
+
+	_, err := repo.GetAllNodeInterfaces(context.Background(), "worker-1")
+	assert.True(t, errors.IsSystemError(err))
+	assert.Contains(t, err.Error(), "Keystone authentication returned 401")
+}
@@ -0,0 +1,132 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/errors"
+	"multinic-agent/internal/domain/interfaces"
+	"multinic-agent/internal/infrastructure/metrics"
+	"path/filepath"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// nameAllocationFileVersion is the schema version of the allocation file
+const nameAllocationFileVersion = 1
+
+// nameAllocationFile is the on-disk representation of the name allocations
+type nameAllocationFile struct {
+	Version     int                    `json:"version"`
+	Allocations []nameAllocationRecord `json:"allocations"`
+}
+
+// nameAllocationRecord is the on-disk representation of a single allocation
+type nameAllocationRecord struct {
+	MacAddress  string     `json:"mac_address"`
+	Name        string     `json:"name"`
+	Pinned      bool       `json:"pinned,omitempty"`
+	AllocatedAt time.Time  `json:"allocated_at"`
+	ReleasedAt  *time.Time `json:"released_at,omitempty"`
+}
+
+// FileNameAllocationStore is a JSON file-based implementation of NameAllocationStore
+// The file lives on a host path so that allocations survive pod restarts and node reboots
+type FileNameAllocationStore struct {
+	fileSystem interfaces.FileSystem
+	path       string
+	logger     *logrus.Logger
+}
+
+// NewFileNameAllocationStore creates a new FileNameAllocationStore
+func NewFileNameAllocationStore(fs interfaces.FileSystem, path string, logger *logrus.Logger) interfaces.NameAllocationStore {
+	return &FileNameAllocationStore{
+		fileSystem: fs,
+		path:       path,
+		logger:     logger,
+	}
+}
+
+// Load reads all allocations from the file, returning an empty list if the file does not exist yet
+func (s *FileNameAllocationStore) Load(ctx context.Context) ([]entities.NameAllocation, error) {
+	if !s.fileSystem.Exists(s.path) {
+		return []entities.NameAllocation{}, nil
+	}
+
+	content, err := s.fileSystem.ReadFile(s.path)
+	if err != nil {
+		return nil, errors.NewSystemError(fmt.Sprintf("failed to read name allocation file %s", s.path), err)
+	}
+
+	var file nameAllocationFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return s.setAsideCorruptFile(err)
+	}
+
+	allocations := make([]entities.NameAllocation, 0, len(file.Allocations))
+	for _, record := range file.Allocations {
+		allocations = append(allocations, entities.NameAllocation{
+			MacAddress:  record.MacAddress,
+			Name:        record.Name,
+			Pinned:      record.Pinned,
+			AllocatedAt: record.AllocatedAt,
+			ReleasedAt:  record.ReleasedAt,
+		})
+	}
+
+	return allocations, nil
+}
+
+// setAsideCorruptFile moves an unparsable allocation file out of the way and reports it
+// Failing every Load would block naming of all interfaces, so allocation restarts from an empty list
+// while the corrupt file is kept next to the original for manual recovery
+func (s *FileNameAllocationStore) setAsideCorruptFile(parseErr error) ([]entities.NameAllocation, error) {
+	backupPath := fmt.Sprintf("%s.corrupt-%d", s.path, time.Now().Unix())
+	if err := s.fileSystem.Rename(s.path, backupPath); err != nil {
+		return nil, errors.NewValidationError(fmt.Sprintf("failed to parse name allocation file %s and could not move it aside", s.path), parseErr)
+	}
+
+	metrics.RecordError("validation")
+	s.logger.WithError(parseErr).WithFields(logrus.Fields{
+		"file":        s.path,
+		"backup_file": backupPath,
+	}).Error("Name allocation file is corrupt - moved it aside and starting with no allocations")
+
+	return []entities.NameAllocation{}, nil
+}
+
+// Save atomically replaces the file with all allocations
+// The content is written to a temporary file in the same directory, synced and renamed over the file,
+// so a crash or full disk never leaves a truncated file behind
+func (s *FileNameAllocationStore) Save(ctx context.Context, allocations []entities.NameAllocation) error {
+	file := nameAllocationFile{
+		Version:     nameAllocationFileVersion,
+		Allocations: make([]nameAllocationRecord, 0, len(allocations)),
+	}
+	for _, allocation := range allocations {
+		file.Allocations = append(file.Allocations, nameAllocationRecord{
+			MacAddress:  allocation.MacAddress,
+			Name:        allocation.Name,
+			Pinned:      allocation.Pinned,
+			AllocatedAt: allocation.AllocatedAt,
+			ReleasedAt:  allocation.ReleasedAt,
+		})
+	}
+
+	content, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return errors.NewSystemError("failed to encode name allocations", err)
+	}
+
+	if err := s.fileSystem.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return errors.NewSystemError("failed to create name allocation directory", err)
+	}
+
+	if err := s.fileSystem.WriteFileAtomic(s.path, content, 0644); err != nil {
+		return errors.NewSystemError(fmt.Sprintf("failed to write name allocation file %s", s.path), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package persistence
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/infrastructure/adapters"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFileNameAllocationStore(t *testing.T) (*FileNameAllocationStore, string) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "names.json")
+	return NewFileNameAllocationStore(adapters.NewRealFileSystem(), path, logger).(*FileNameAllocationStore), dir
+}
+
+func TestFileNameAllocationStore_SaveAndLoad(t *testing.T) {
+	ctx := context.Background()
+	store, dir := newTestFileNameAllocationStore(t)
+
+	allocations := []entities.NameAllocation{
+		{MacAddress: "fa:16:3e:00:00:01", Name: "multinic0", AllocatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	require.NoError(t, store.Save(ctx, allocations))
+
+	loaded, err := store.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, allocations, loaded)
+
+	// 임시 파일은 이름 변경 후 남지 않음
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "names.json", entries[0].Name())
+}
+
+func TestFileNameAllocationStore_CorruptFileDoesNotBlockNaming(t *testing.T) {
+	ctx := context.Background()
+	store, dir := newTestFileNameAllocationStore(t)
+
+	// 쓰기 도중 중단되어 잘린 파일
+	require.NoError(t, os.WriteFile(store.path, []byte(`{"version":1,"allocations":[{"mac_address":"fa:16`), 0644))
+
+	loaded, err := store.Load(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, loaded)
+
+	// 손상된 파일은 복구를 위해 옆으로 옮겨 보관
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.True(t, strings.HasPrefix(entries[0].Name(), "names.json.corrupt-"))
+
+	// 이후 할당은 새 파일에 정상 저장
+	allocations := []entities.NameAllocation{{MacAddress: "fa:16:3e:00:00:02", Name: "multinic0"}}
+	require.NoError(t, store.Save(ctx, allocations))
+	loaded, err = store.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, allocations, loaded)
+}
@@ -0,0 +1,124 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"multinic-agent/internal/domain/entities"
+	"multinic-agent/internal/domain/errors"
+	"multinic-agent/internal/domain/interfaces"
+	"multinic-agent/internal/infrastructure/metrics"
+	"time"
+)
+
+// MySQLNameAllocationStore is a MySQL-based implementation of NameAllocationStore
+// Allocations are kept per node in the multinic_name_allocation table:
+//
+//	CREATE TABLE multinic_name_allocation (
+//	    node_name VARCHAR(255) NOT NULL,
+//	    mac_address VARCHAR(17) NOT NULL,
+//	    name VARCHAR(15) NOT NULL,
+//	    pinned TINYINT(1) NOT NULL DEFAULT 0,
+//	    allocated_at TIMESTAMP NOT NULL,
+//	    released_at TIMESTAMP NULL,
+//	    PRIMARY KEY (node_name, mac_address)
+//	);
+type MySQLNameAllocationStore struct {
+	db       *sql.DB
+	nodeName string
+}
+
+// NewMySQLNameAllocationStore creates a new MySQLNameAllocationStore for the node
+func NewMySQLNameAllocationStore(db *sql.DB, nodeName string) interfaces.NameAllocationStore {
+	return &MySQLNameAllocationStore{
+		db:       db,
+		nodeName: nodeName,
+	}
+}
+
+// Load retrieves all allocations of the node
+func (s *MySQLNameAllocationStore) Load(ctx context.Context) ([]entities.NameAllocation, error) {
+	startTime := time.Now()
+	defer func() {
+		metrics.RecordDBQuery("load_name_allocations", time.Since(startTime).Seconds())
+	}()
+
+	query := `
+		SELECT mac_address, name, pinned, allocated_at, released_at
+		FROM multinic_name_allocation
+		WHERE node_name = ?
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, s.nodeName)
+	if err != nil {
+		metrics.RecordError("system")
+		return nil, errors.NewSystemError("database query failed", err)
+	}
+	defer rows.Close()
+
+	allocations := []entities.NameAllocation{}
+	for rows.Next() {
+		var allocation entities.NameAllocation
+		var pinned int
+		var releasedAt sql.NullTime
+
+		if err := rows.Scan(&allocation.MacAddress, &allocation.Name, &pinned, &allocation.AllocatedAt, &releasedAt); err != nil {
+			return nil, errors.NewSystemError("failed to scan data", err)
+		}
+		allocation.Pinned = pinned == 1
+		if releasedAt.Valid {
+			released := releasedAt.Time
+			allocation.ReleasedAt = &released
+		}
+		allocations = append(allocations, allocation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.NewSystemError("error processing results", err)
+	}
+
+	return allocations, nil
+}
+
+// Save replaces all allocations of the node in a single transaction
+func (s *MySQLNameAllocationStore) Save(ctx context.Context, allocations []entities.NameAllocation) error {
+	startTime := time.Now()
+	defer func() {
+		metrics.RecordDBQuery("save_name_allocations", time.Since(startTime).Seconds())
+	}()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.NewSystemError("failed to begin transaction", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM multinic_name_allocation WHERE node_name = ?`, s.nodeName); err != nil {
+		return errors.NewSystemError("failed to clear name allocations", err)
+	}
+
+	for _, allocation := range allocations {
+		pinned := 0
+		if allocation.Pinned {
+			pinned = 1
+		}
+		var releasedAt sql.NullTime
+		if allocation.ReleasedAt != nil {
+			releasedAt = sql.NullTime{Time: *allocation.ReleasedAt, Valid: true}
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO multinic_name_allocation (node_name, mac_address, name, pinned, allocated_at, released_at)
+			VALUES (?, ?, ?, ?, ?, ?)
+		`, s.nodeName, allocation.MacAddress, allocation.Name, pinned, allocation.AllocatedAt, releasedAt); err != nil {
+			return errors.NewSystemError("failed to insert name allocation", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.NewSystemError("failed to commit name allocations", err)
+	}
+
+	return nil
+}
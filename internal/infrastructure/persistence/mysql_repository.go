@@ -8,6 +8,7 @@ import (
 	"multinic-agent/internal/domain/errors"
 	"multinic-agent/internal/domain/interfaces"
 	"multinic-agent/internal/infrastructure/metrics"
+	"strings"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -16,18 +17,83 @@ import (
 
 // MySQLRepository is a MySQL-based implementation of NetworkInterfaceRepository
 type MySQLRepository struct {
-	db     *sql.DB
-	logger *logrus.Logger
+	db                *sql.DB
+	logger            *logrus.Logger
+	desiredNameColumn string // optional multi_interface column holding the desired interface name
 }
 
 // NewMySQLRepository creates a new MySQLRepository
-func NewMySQLRepository(db *sql.DB, logger *logrus.Logger) interfaces.NetworkInterfaceRepository {
+// desiredNameColumn is the optional multi_interface column with centrally managed interface names (empty to disable)
+func NewMySQLRepository(db *sql.DB, logger *logrus.Logger, desiredNameColumn string) interfaces.NetworkInterfaceRepository {
 	return &MySQLRepository{
-		db:     db,
-		logger: logger,
+		db:                db,
+		logger:            logger,
+		desiredNameColumn: desiredNameColumn,
 	}
 }
 
+// rowScanner is implemented by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// selectInterfaceColumns returns the column list read by scanInterface
+func (r *MySQLRepository) selectInterfaceColumns() string {
+	columns := "mi.id, mi.macaddress, mi.attached_node_name, mi.netplan_success, mi.address, mi.mtu, ms.cidr"
+	if r.desiredNameColumn != "" {
+		columns += ", mi." + r.desiredNameColumn
+	}
+	return columns
+}
+
+// scanInterface scans a row selected with selectInterfaceColumns
+func (r *MySQLRepository) scanInterface(row rowScanner) (entities.NetworkInterface, error) {
+	var iface entities.NetworkInterface
+	var netplanSuccess int
+	var address, cidr, desiredName sql.NullString
+	var mtu sql.NullInt64
+
+	dest := []interface{}{
+		&iface.ID,
+		&iface.MacAddress,
+		&iface.AttachedNodeName,
+		&netplanSuccess,
+		&address,
+		&mtu,
+		&cidr,
+	}
+	if r.desiredNameColumn != "" {
+		dest = append(dest, &desiredName)
+	}
+
+	if err := row.Scan(dest...); err != nil {
+		return entities.NetworkInterface{}, err
+	}
+
+	if address.Valid {
+		iface.Address = address.String
+	}
+	if mtu.Valid {
+		iface.MTU = int(mtu.Int64)
+	}
+	if cidr.Valid {
+		iface.CIDR = cidr.String
+	}
+	if desiredName.Valid {
+		iface.DesiredName = strings.TrimSpace(desiredName.String)
+	}
+
+	// Status mapping
+	switch netplanSuccess {
+	case 1:
+		iface.Status = entities.StatusConfigured
+	default:
+		iface.Status = entities.StatusPending
+	}
+
+	return iface, nil
+}
+
 // GetPendingInterfaces retrieves interfaces pending configuration for a specific node
 func (r *MySQLRepository) GetPendingInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error) {
 	startTime := time.Now()
@@ -35,14 +101,14 @@ func (r *MySQLRepository) GetPendingInterfaces(ctx context.Context, nodeName str
 		metrics.RecordDBQuery("get_pending", time.Since(startTime).Seconds())
 	}()
 
-	query := `
-		SELECT mi.id, mi.macaddress, mi.attached_node_name, mi.netplan_success, mi.address, mi.mtu, ms.cidr
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM multi_interface mi
 		LEFT JOIN multi_subnet ms ON mi.subnet_id = ms.subnet_id
 		WHERE mi.netplan_success = 0 
 		AND mi.attached_node_name = ?
 		LIMIT 10
-	`
+	`, r.selectInterfaceColumns())
 
 	rows, err := r.db.QueryContext(ctx, query, nodeName)
 	if err != nil {
@@ -54,35 +120,13 @@ func (r *MySQLRepository) GetPendingInterfaces(ctx context.Context, nodeName str
 	var interfaces []entities.NetworkInterface
 
 	for rows.Next() {
-		var iface entities.NetworkInterface
-		var netplanSuccess int
-		var address, cidr sql.NullString
-		var mtu sql.NullInt64
-
-		err := rows.Scan(
-			&iface.ID,
-			&iface.MacAddress,
-			&iface.AttachedNodeName,
-			&netplanSuccess,
-			&address,
-			&mtu,
-			&cidr,
-		)
+		iface, err := r.scanInterface(rows)
 		if err != nil {
 			r.logger.WithError(err).Error("failed to scan row")
 			continue
 		}
 
 		iface.Status = entities.StatusPending
-		if address.Valid {
-			iface.Address = address.String
-		}
-		if mtu.Valid {
-			iface.MTU = int(mtu.Int64)
-		}
-		if cidr.Valid {
-			iface.CIDR = cidr.String
-		}
 		interfaces = append(interfaces, iface)
 	}
 
@@ -95,13 +139,13 @@ func (r *MySQLRepository) GetPendingInterfaces(ctx context.Context, nodeName str
 
 // GetConfiguredInterfaces retrieves configured interfaces for a specific node
 func (r *MySQLRepository) GetConfiguredInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error) {
-	query := `
-		SELECT mi.id, mi.macaddress, mi.attached_node_name, mi.netplan_success, mi.address, mi.mtu, ms.cidr
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM multi_interface mi
 		LEFT JOIN multi_subnet ms ON mi.subnet_id = ms.subnet_id
 		WHERE mi.netplan_success = 1
 		AND mi.attached_node_name = ?
-	`
+	`, r.selectInterfaceColumns())
 
 	rows, err := r.db.QueryContext(ctx, query, nodeName)
 	if err != nil {
@@ -112,35 +156,13 @@ func (r *MySQLRepository) GetConfiguredInterfaces(ctx context.Context, nodeName
 	var interfaces []entities.NetworkInterface
 
 	for rows.Next() {
-		var iface entities.NetworkInterface
-		var netplanSuccess int
-		var address, cidr sql.NullString
-		var mtu sql.NullInt64
-
-		err := rows.Scan(
-			&iface.ID,
-			&iface.MacAddress,
-			&iface.AttachedNodeName,
-			&netplanSuccess,
-			&address,
-			&mtu,
-			&cidr,
-		)
+		iface, err := r.scanInterface(rows)
 		if err != nil {
 			r.logger.WithError(err).Error("failed to scan row")
 			continue
 		}
 
 		iface.Status = entities.StatusConfigured
-		if address.Valid {
-			iface.Address = address.String
-		}
-		if mtu.Valid {
-			iface.MTU = int(mtu.Int64)
-		}
-		if cidr.Valid {
-			iface.CIDR = cidr.String
-		}
 		interfaces = append(interfaces, iface)
 	}
 
@@ -163,9 +185,11 @@ func (r *MySQLRepository) UpdateInterfaceStatus(ctx context.Context, interfaceID
 		netplanSuccess = 0
 	}
 
+	// modified_at is kept as is so that the agent's own status writes do not change the change token
+	// (assigning the column to itself also suppresses ON UPDATE CURRENT_TIMESTAMP)
 	query := `
 		UPDATE multi_interface 
-		SET netplan_success = ?, modified_at = NOW()
+		SET netplan_success = ?, modified_at = modified_at
 		WHERE id = ?
 	`
 
@@ -193,27 +217,14 @@ func (r *MySQLRepository) UpdateInterfaceStatus(ctx context.Context, interfaceID
 
 // GetInterfaceByID retrieves an interface by its ID
 func (r *MySQLRepository) GetInterfaceByID(ctx context.Context, id int) (*entities.NetworkInterface, error) {
-	query := `
-		SELECT mi.id, mi.macaddress, mi.attached_node_name, mi.netplan_success, mi.address, mi.mtu, ms.cidr
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM multi_interface mi
 		LEFT JOIN multi_subnet ms ON mi.subnet_id = ms.subnet_id
 		WHERE mi.id = ?
-	`
-
-	var iface entities.NetworkInterface
-	var netplanSuccess int
-	var address, cidr sql.NullString
-	var mtu sql.NullInt64
+	`, r.selectInterfaceColumns())
 
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&iface.ID,
-		&iface.MacAddress,
-		&iface.AttachedNodeName,
-		&netplanSuccess,
-		&address,
-		&mtu,
-		&cidr,
-	)
+	iface, err := r.scanInterface(r.db.QueryRowContext(ctx, query, id))
 
 	if err == sql.ErrNoRows {
 		return nil, errors.NewNotFoundError(fmt.Sprintf("interface not found: ID=%d", id))
@@ -222,35 +233,17 @@ func (r *MySQLRepository) GetInterfaceByID(ctx context.Context, id int) (*entiti
 		return nil, errors.NewSystemError("database query failed", err)
 	}
 
-	if address.Valid {
-		iface.Address = address.String
-	}
-	if mtu.Valid {
-		iface.MTU = int(mtu.Int64)
-	}
-	if cidr.Valid {
-		iface.CIDR = cidr.String
-	}
-
-	// Status mapping
-	switch netplanSuccess {
-	case 1:
-		iface.Status = entities.StatusConfigured
-	default:
-		iface.Status = entities.StatusPending
-	}
-
 	return &iface, nil
 }
 
 // GetActiveInterfaces retrieves active interfaces for a specific node (for deletion detection)
 func (r *MySQLRepository) GetActiveInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error) {
-	query := `
-		SELECT mi.id, mi.macaddress, mi.attached_node_name, mi.netplan_success, mi.address, mi.mtu, ms.cidr
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM multi_interface mi
 		LEFT JOIN multi_subnet ms ON mi.subnet_id = ms.subnet_id
 		WHERE mi.attached_node_name = ?
-	`
+	`, r.selectInterfaceColumns())
 
 	rows, err := r.db.QueryContext(ctx, query, nodeName)
 	if err != nil {
@@ -261,43 +254,12 @@ func (r *MySQLRepository) GetActiveInterfaces(ctx context.Context, nodeName stri
 	var interfaces []entities.NetworkInterface
 
 	for rows.Next() {
-		var iface entities.NetworkInterface
-		var netplanSuccess int
-		var address, cidr sql.NullString
-		var mtu sql.NullInt64
-
-		err := rows.Scan(
-			&iface.ID,
-			&iface.MacAddress,
-			&iface.AttachedNodeName,
-			&netplanSuccess,
-			&address,
-			&mtu,
-			&cidr,
-		)
+		iface, err := r.scanInterface(rows)
 		if err != nil {
 			r.logger.WithError(err).Error("failed to scan row")
 			continue
 		}
 
-		if address.Valid {
-			iface.Address = address.String
-		}
-		if mtu.Valid {
-			iface.MTU = int(mtu.Int64)
-		}
-		if cidr.Valid {
-			iface.CIDR = cidr.String
-		}
-
-		// Status mapping
-		switch netplanSuccess {
-		case 1:
-			iface.Status = entities.StatusConfigured
-		default:
-			iface.Status = entities.StatusPending
-		}
-
 		interfaces = append(interfaces, iface)
 	}
 
@@ -310,12 +272,12 @@ func (r *MySQLRepository) GetActiveInterfaces(ctx context.Context, nodeName stri
 
 // GetAllNodeInterfaces retrieves all interfaces for a specific node (regardless of netplan_success status)
 func (r *MySQLRepository) GetAllNodeInterfaces(ctx context.Context, nodeName string) ([]entities.NetworkInterface, error) {
-	query := `
-		SELECT mi.id, mi.macaddress, mi.attached_node_name, mi.netplan_success, mi.address, mi.mtu, ms.cidr
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM multi_interface mi
 		LEFT JOIN multi_subnet ms ON mi.subnet_id = ms.subnet_id
 		WHERE mi.attached_node_name = ?
-	`
+	`, r.selectInterfaceColumns())
 
 	rows, err := r.db.QueryContext(ctx, query, nodeName)
 	if err != nil {
@@ -326,43 +288,12 @@ func (r *MySQLRepository) GetAllNodeInterfaces(ctx context.Context, nodeName str
 	var interfaces []entities.NetworkInterface
 
 	for rows.Next() {
-		var iface entities.NetworkInterface
-		var netplanSuccess int
-		var address, cidr sql.NullString
-		var mtu sql.NullInt64
-
-		err := rows.Scan(
-			&iface.ID,
-			&iface.MacAddress,
-			&iface.AttachedNodeName,
-			&netplanSuccess,
-			&address,
-			&mtu,
-			&cidr,
-		)
+		iface, err := r.scanInterface(rows)
 		if err != nil {
 			r.logger.WithError(err).Error("failed to scan row")
 			continue
 		}
 
-		if address.Valid {
-			iface.Address = address.String
-		}
-		if mtu.Valid {
-			iface.MTU = int(mtu.Int64)
-		}
-		if cidr.Valid {
-			iface.CIDR = cidr.String
-		}
-
-		// Status mapping
-		switch netplanSuccess {
-		case 1:
-			iface.Status = entities.StatusConfigured
-		default:
-			iface.Status = entities.StatusPending
-		}
-
 		interfaces = append(interfaces, iface)
 	}
 
@@ -374,3 +305,41 @@ func (r *MySQLRepository) GetAllNodeInterfaces(ctx context.Context, nodeName str
 
 	return interfaces, nil
 }
+
+// GetChangeToken returns a token that changes whenever an interface row of the node is added, modified or removed,
+// or the CIDR of the subnet an interface belongs to changes
+// The count catches removed rows and the checksum catches changes within the same modified_at second
+// netplan_success is left out and status updates keep modified_at, so the agent's own status writes do not trigger another cycle
+func (r *MySQLRepository) GetChangeToken(ctx context.Context, nodeName string) (string, error) {
+	startTime := time.Now()
+	defer func() {
+		metrics.RecordDBQuery("change_token", time.Since(startTime).Seconds())
+	}()
+
+	columns := []string{"mi.id", "mi.macaddress", "mi.address", "mi.mtu", "mi.subnet_id", "ms.cidr"}
+	if r.desiredNameColumn != "" {
+		columns = append(columns, "mi."+r.desiredNameColumn)
+	}
+	// CONCAT_WS skips NULL arguments, which would shift the remaining values into other columns
+	// (a row with a NULL address and one with a NULL mtu could then hash the same), so each column is coalesced
+	fields := make([]string, len(columns))
+	for i, column := range columns {
+		fields[i] = fmt.Sprintf("COALESCE(%s, '')", column)
+	}
+	query := fmt.Sprintf(`
+		SELECT COUNT(*), MAX(mi.modified_at), BIT_XOR(CRC32(CONCAT_WS('|', %s)))
+		FROM multi_interface mi
+		LEFT JOIN multi_subnet ms ON mi.subnet_id = ms.subnet_id
+		WHERE mi.attached_node_name = ?
+	`, strings.Join(fields, ", "))
+
+	var count int
+	var modifiedAt sql.NullString
+	var checksum uint64
+	if err := r.db.QueryRowContext(ctx, query, nodeName).Scan(&count, &modifiedAt, &checksum); err != nil {
+		metrics.RecordError("system")
+		return "", errors.NewSystemError("database query failed", err)
+	}
+
+	return fmt.Sprintf("%d/%s/%x", count, modifiedAt.String, checksum), nil
+}
@@ -50,7 +50,7 @@ fi
 
 # 5. 빌드 테스트
 echo -e "\n${YELLOW}5. 빌드 테스트${NC}"
-go build -o /tmp/multinic-agent-test cmd/agent/main.go
+go build -o /tmp/multinic-agent-test ./cmd/agent
 if [ $? -eq 0 ]; then
     echo -e "${GREEN}✓ 빌드 성공${NC}"
     rm /tmp/multinic-agent-test